@@ -16,6 +16,7 @@ package main
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -27,45 +28,51 @@ import (
 	"github.com/attestantio/vouch/services/accountmanager"
 	"github.com/attestantio/vouch/services/blockrelay"
 	mockscheduler "github.com/attestantio/vouch/services/scheduler/mock"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	e2types "github.com/wealdtech/go-eth2-types/v2"
 	majordomo "github.com/wealdtech/go-majordomo"
 )
 
-// proposerConfigCheck checks a proposer configuration.
-func proposerConfigCheck(ctx context.Context, majordomo majordomo.Service) bool {
+// startCommandBlockRelay starts the services required to obtain a block relay for commands.
+func startCommandBlockRelay(ctx context.Context, majordomo majordomo.Service) (accountmanager.Service, blockrelay.Service, error) {
 	if err := e2types.InitBLS(); err != nil {
-		fmt.Fprintf(os.Stderr, "failed to initialise BLS library: %v\n", err)
-		return true
+		return nil, nil, errors.Wrap(err, "failed to initialise BLS library")
 	}
 
 	// Force disable metrics.
 	viper.Set("metrics.prometheus.listen-address", "")
 	consensusClient, chainTime, monitor, err := startBasicServices(ctx)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to start basic services: %v\n", err)
-		return true
+		return nil, nil, errors.Wrap(err, "failed to start basic services")
 	}
 
 	validatorsManager, err := startValidatorsManager(ctx, monitor, consensusClient)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to start validators manager: %v\n", err)
-		return true
+		return nil, nil, errors.Wrap(err, "failed to start validators manager")
 	}
 	accountManager, err := startAccountManager(ctx, monitor, consensusClient, validatorsManager, majordomo, chainTime)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to start account manager: %v\n", err)
-		return true
+		return nil, nil, errors.Wrap(err, "failed to start account manager")
 	}
 	scheduler := mockscheduler.New()
 	signer, err := startSigner(ctx, monitor, consensusClient)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to start signer: %v\n", err)
-		return true
+		return nil, nil, errors.Wrap(err, "failed to start signer")
 	}
 	blockRelaySvc, err := startBlockRelay(ctx, majordomo, monitor, consensusClient, scheduler, chainTime, accountManager, signer)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to start block relay: %v\n", err)
+		return nil, nil, errors.Wrap(err, "failed to start block relay")
+	}
+
+	return accountManager, blockRelaySvc, nil
+}
+
+// proposerConfigCheck checks a proposer configuration.
+func proposerConfigCheck(ctx context.Context, majordomo majordomo.Service) bool {
+	accountManager, blockRelaySvc, err := startCommandBlockRelay(ctx, majordomo)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
 		return true
 	}
 
@@ -98,3 +105,33 @@ func proposerConfigCheck(ctx context.Context, majordomo majordomo.Service) bool
 	fmt.Printf("%s\n", string(data))
 	return true
 }
+
+// feeRecipientsReport reports the fee recipients of all managed validators.
+func feeRecipientsReport(ctx context.Context, majordomo majordomo.Service) bool {
+	_, blockRelaySvc, err := startCommandBlockRelay(ctx, majordomo)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return true
+	}
+
+	reporter, isReporter := blockRelaySvc.(blockrelay.FeeRecipientsReporter)
+	if !isReporter {
+		fmt.Fprintf(os.Stderr, "Block relay does not support fee recipients reports\n")
+		return true
+	}
+
+	report, err := reporter.FeeRecipientsReport(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to obtain fee recipients: %v\n", err)
+		return true
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid fee recipients report: %v\n", err)
+		return true
+	}
+
+	fmt.Printf("%s\n", string(data))
+	return true
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/attestantio/go-block-relay/services/blockauctioneer"
 	eth2client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/services/accountmanager"
 	dirkaccountmanager "github.com/attestantio/vouch/services/accountmanager/dirk"
 	walletaccountmanager "github.com/attestantio/vouch/services/accountmanager/wallet"
@@ -180,6 +181,13 @@ func main2() int {
 		time.Sleep(100 * time.Millisecond)
 	}
 
+	// Allow in-flight sync committee aggregations to complete, up to the end of the slot.
+	stopCtx, cancel := context.WithDeadline(ctx, chainTime.StartOfSlot(slot+1))
+	if err := controller.StopSyncCommitteeAggregations(stopCtx); err != nil {
+		log.Warn().Err(err).Msg("Sync committee aggregations did not complete before shutdown")
+	}
+	cancel()
+
 	log.Info().Msg("Stopping vouch")
 	return 0
 }
@@ -194,6 +202,7 @@ func fetchConfig() error {
 	pflag.String("beacon-node-address", "", "Address on which to contact the beacon node")
 	pflag.Bool("version", false, "show Vouch version and exit")
 	pflag.String("proposer-config-check", "", "show the proposer configuration for the given public key and exit")
+	pflag.Bool("fee-recipients", false, "show the fee recipients for all validators managed by this instance and exit")
 	pflag.Parse()
 	if err := viper.BindPFlags(pflag.CommandLine); err != nil {
 		return errors.Wrap(err, "failed to bind pflags to viper")
@@ -227,10 +236,26 @@ func fetchConfig() error {
 	viper.SetDefault("controller.max-sync-committee-message-delay", 4*time.Second)
 	viper.SetDefault("controller.attestation-aggregation-delay", 8*time.Second)
 	viper.SetDefault("controller.sync-committee-aggregation-delay", 8*time.Second)
+	viper.SetDefault("synccommitteemessenger.empty-root-retries", 2)
+	viper.SetDefault("synccommitteemessenger.empty-root-retry-interval", 250*time.Millisecond)
+	viper.SetDefault("synccommitteeaggregator.submission-deadline", 1.0)
+	viper.SetDefault("validatorsmanager.refresh-batch-size", 1000)
+	viper.SetDefault("validatorsmanager.refresh-concurrency", int64(1))
 	viper.SetDefault("blockrelay.timeout", 1*time.Second)
 	viper.SetDefault("blockrelay.listen-address", "0.0.0.0:18550")
 	viper.SetDefault("blockrelay.fallback-gas-limit", uint64(30000000))
+	viper.SetDefault("blockrelay.max-matching-providers", 3)
+	viper.SetDefault("blockrelay.max-relays", 32)
+	viper.SetDefault("blockrelay.uncompetitive-window", 10)
+	viper.SetDefault("blockrelay.error-rate-window", 20)
+	viper.SetDefault("blockrelay.error-rate-threshold", 0.5)
+	viper.SetDefault("blockrelay.equal-bid-window", 20)
+	viper.SetDefault("blockrelay.equal-bid-threshold", 0.9)
+	viper.SetDefault("blockrelay.just-below-min-value-window", 10)
+	viper.SetDefault("blockrelay.auction-retry-budget", 2*time.Second)
 	viper.SetDefault("accountmanager.dirk.timeout", 30*time.Second)
+	viper.SetDefault("accountmanager.wallet.account-prefetch", 64)
+	viper.SetDefault("strategies.beaconblockproposal.scorer", "heuristic")
 
 	if err := viper.ReadInConfig(); err != nil {
 		switch {
@@ -354,7 +379,7 @@ func startServices(ctx context.Context,
 	var syncCommitteeMessenger synccommitteemessenger.Service
 	var syncCommitteeAggregator synccommitteeaggregator.Service
 	if altairCapable {
-		syncCommitteeSubscriber, syncCommitteeMessenger, syncCommitteeAggregator, err = startAltairServices(ctx, monitor, eth2Client, submitter, signerSvc, accountManager, chainTime)
+		syncCommitteeSubscriber, syncCommitteeMessenger, syncCommitteeAggregator, err = startAltairServices(ctx, monitor, eth2Client, submitter, signerSvc, accountManager, chainTime, scheduler)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -376,6 +401,15 @@ func startServices(ctx context.Context,
 		}
 	}
 
+	var chainHeadFeedback blockrelay.ChainHeadFeedback
+	if viper.GetBool("controller.canonical-block-feedback") {
+		if feedback, isFeedback := blockRelay.(blockrelay.ChainHeadFeedback); isFeedback {
+			chainHeadFeedback = feedback
+		} else {
+			log.Warn().Msg("Block relay does not accept canonical block feedback")
+		}
+	}
+
 	log.Trace().Msg("Starting controller")
 	controller, err := standardcontroller.New(ctx,
 		standardcontroller.WithLogLevel(util.LogLevel("controller")),
@@ -407,6 +441,7 @@ func startServices(ctx context.Context,
 		standardcontroller.WithMaxSyncCommitteeMessageDelay(viper.GetDuration("controller.max-sync-committee-message-delay")),
 		standardcontroller.WithSyncCommitteeAggregationDelay(viper.GetDuration("controller.sync-committee-aggregation-delay")),
 		standardcontroller.WithReorgs(viper.GetBool("controller.reorgs")),
+		standardcontroller.WithChainHeadFeedback(chainHeadFeedback),
 	)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to start controller service")
@@ -550,6 +585,7 @@ func startAltairServices(ctx context.Context,
 	signerSvc signer.Service,
 	accountManager accountmanager.Service,
 	chainTime chaintime.Service,
+	scheduler scheduler.Service,
 ) (
 	synccommitteesubscriber.Service,
 	synccommitteemessenger.Service,
@@ -572,25 +608,70 @@ func startAltairServices(ctx context.Context,
 		return nil, nil, nil, errors.Wrap(err, "failed to select sync committee contribution provider")
 	}
 
+	beaconBlockRootPolicy, err := synccommitteemessenger.ParseBeaconBlockRootPolicy(viper.GetString("synccommitteemessenger.beacon-block-root-policy"))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to parse sync committee beacon block root policy")
+	}
+	signingEpochPolicy, err := synccommitteemessenger.ParseSigningEpochPolicy(viper.GetString("synccommitteemessenger.signing-epoch-policy"))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to parse sync committee signing epoch policy")
+	}
+
+	// Additional beacon nodes are used as fallbacks for the beacon block root.
+	fallbackBeaconBlockRootProviders := make(map[string]eth2client.BeaconBlockRootProvider)
+	if addresses := util.BeaconNodeAddresses("synccommitteeaggregator"); len(addresses) > 1 {
+		for _, address := range addresses {
+			client, err := fetchClient(ctx, address)
+			if err != nil {
+				return nil, nil, nil, errors.Wrap(err, fmt.Sprintf("failed to fetch client %s for sync committee aggregator", address))
+			}
+			fallbackBeaconBlockRootProviders[address] = client.(eth2client.BeaconBlockRootProvider)
+		}
+	}
+
 	log.Trace().Msg("Starting sync committee aggregator")
 	syncCommitteeAggregator, err := standardsynccommitteeaggregator.New(ctx,
 		standardsynccommitteeaggregator.WithLogLevel(util.LogLevel("synccommitteeaggregator")),
 		standardsynccommitteeaggregator.WithMonitor(monitor.(metrics.SyncCommitteeAggregationMonitor)),
 		standardsynccommitteeaggregator.WithSpecProvider(eth2Client.(eth2client.SpecProvider)),
 		standardsynccommitteeaggregator.WithBeaconBlockRootProvider(eth2Client.(eth2client.BeaconBlockRootProvider)),
+		standardsynccommitteeaggregator.WithFallbackBeaconBlockRootProviders(fallbackBeaconBlockRootProviders),
+		standardsynccommitteeaggregator.WithBeaconBlockRootPolicy(beaconBlockRootPolicy),
 		standardsynccommitteeaggregator.WithContributionAndProofSigner(signerSvc.(signer.ContributionAndProofSigner)),
 		standardsynccommitteeaggregator.WithValidatingAccountsProvider(accountManager.(accountmanager.ValidatingAccountsProvider)),
 		standardsynccommitteeaggregator.WithSyncCommitteeContributionProvider(syncCommitteeContributionProvider),
 		standardsynccommitteeaggregator.WithSyncCommitteeContributionsSubmitter(submitterStrategy.(submitter.SyncCommitteeContributionsSubmitter)),
+		standardsynccommitteeaggregator.WithChainTime(chainTime),
+		standardsynccommitteeaggregator.WithSubmissionDeadline(viper.GetFloat64("synccommitteeaggregator.submission-deadline")),
+		standardsynccommitteeaggregator.WithContributionFetchDeadline(viper.GetFloat64("synccommitteeaggregator.contribution-fetch-deadline")),
+		standardsynccommitteeaggregator.WithSignerLatencyThreshold(viper.GetDuration("synccommitteeaggregator.signer-latency-threshold")),
 	)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "failed to start sync committee aggregator service")
 	}
 
+	var syncCommitteesProvider eth2client.SyncCommitteesProvider
+	if viper.GetBool("synccommitteemessenger.check-membership") {
+		provider, isProvider := eth2Client.(eth2client.SyncCommitteesProvider)
+		if !isProvider {
+			return nil, nil, nil, errors.New("client does not provide sync committees for membership checks")
+		}
+		syncCommitteesProvider = provider
+	}
+
+	priorityIndices := make([]phase0.ValidatorIndex, 0)
+	for _, index := range viper.GetIntSlice("synccommitteemessenger.priority-indices") {
+		if index < 0 {
+			return nil, nil, nil, errors.New("sync committee priority indices cannot be negative")
+		}
+		priorityIndices = append(priorityIndices, phase0.ValidatorIndex(index))
+	}
+
 	log.Trace().Msg("Starting sync committee messenger")
 	syncCommitteeMessenger, err := standardsynccommitteemessenger.New(ctx,
 		standardsynccommitteemessenger.WithLogLevel(util.LogLevel("synccommitteemessenger")),
 		standardsynccommitteemessenger.WithProcessConcurrency(viper.GetInt64("process-concurrency")),
+		standardsynccommitteemessenger.WithMaxProcessConcurrency(viper.GetInt64("synccommitteemessenger.max-process-concurrency")),
 		standardsynccommitteemessenger.WithMonitor(monitor.(metrics.SyncCommitteeMessageMonitor)),
 		standardsynccommitteemessenger.WithSpecProvider(eth2Client.(eth2client.SpecProvider)),
 		standardsynccommitteemessenger.WithChainTimeService(chainTime),
@@ -600,7 +681,20 @@ func startAltairServices(ctx context.Context,
 		standardsynccommitteemessenger.WithValidatingAccountsProvider(accountManager.(accountmanager.ValidatingAccountsProvider)),
 		standardsynccommitteemessenger.WithSyncCommitteeRootSigner(signerSvc.(signer.SyncCommitteeRootSigner)),
 		standardsynccommitteemessenger.WithSyncCommitteeSelectionSigner(signerSvc.(signer.SyncCommitteeSelectionSigner)),
+		standardsynccommitteemessenger.WithSyncCommitteeSelectionsSigner(signerSvc.(signer.SyncCommitteeSelectionsSigner)),
 		standardsynccommitteemessenger.WithSyncCommitteeSubscriptionsSubmitter(submitterStrategy.(submitter.SyncCommitteeSubscriptionsSubmitter)),
+		standardsynccommitteemessenger.WithAggregatorSelectionOverride(viper.GetString("synccommitteemessenger.aggregator-selection-override")),
+		standardsynccommitteemessenger.WithBeaconBlockRootPolicy(beaconBlockRootPolicy),
+		standardsynccommitteemessenger.WithSigningEpochPolicy(signingEpochPolicy),
+		standardsynccommitteemessenger.WithSignerSelfCheck(viper.GetBool("synccommitteemessenger.signer-self-check")),
+		standardsynccommitteemessenger.WithEmptyRootRetries(viper.GetInt("synccommitteemessenger.empty-root-retries")),
+		standardsynccommitteemessenger.WithEmptyRootRetryInterval(viper.GetDuration("synccommitteemessenger.empty-root-retry-interval")),
+		standardsynccommitteemessenger.WithSyncCommitteesProvider(syncCommitteesProvider),
+		standardsynccommitteemessenger.WithPriorityIndices(priorityIndices),
+		standardsynccommitteemessenger.WithScheduler(scheduler),
+		standardsynccommitteemessenger.WithAllowDuplicateMessages(viper.GetBool("synccommitteemessenger.allow-duplicate-messages")),
+		standardsynccommitteemessenger.WithSignerLatencyThreshold(viper.GetDuration("synccommitteemessenger.signer-latency-threshold")),
+		standardsynccommitteemessenger.WithSignerDegradedConcurrency(viper.GetInt64("synccommitteemessenger.signer-degraded-concurrency")),
 	)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "failed to start sync committee messenger service")
@@ -898,12 +992,29 @@ func startValidatorsManager(ctx context.Context, monitor metrics.Service, eth2Cl
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain far future epoch")
 	}
+	// Multiple beacon nodes share the load of refreshing validators, if
+	// explicitly configured.  This bypasses the health and sync checks of the
+	// multi-node client so does not inherit the top-level beacon node addresses.
+	refreshValidatorsProviders := make(map[string]eth2client.ValidatorsProvider)
+	if addresses := viper.GetStringSlice("validatorsmanager.refresh-beacon-node-addresses"); len(addresses) > 1 {
+		for _, address := range addresses {
+			client, err := fetchClient(ctx, address)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to fetch client %s for validators manager", address))
+			}
+			refreshValidatorsProviders[address] = client.(eth2client.ValidatorsProvider)
+		}
+	}
+
 	validatorsManager, err := standardvalidatorsmanager.New(ctx,
 		standardvalidatorsmanager.WithLogLevel(util.LogLevel("validatorsmanager")),
 		standardvalidatorsmanager.WithMonitor(monitor.(metrics.ValidatorsManagerMonitor)),
 		standardvalidatorsmanager.WithClientMonitor(monitor.(metrics.ClientMonitor)),
 		standardvalidatorsmanager.WithValidatorsProvider(eth2Client.(eth2client.ValidatorsProvider)),
+		standardvalidatorsmanager.WithRefreshValidatorsProviders(refreshValidatorsProviders),
 		standardvalidatorsmanager.WithFarFutureEpoch(farFutureEpoch),
+		standardvalidatorsmanager.WithRefreshBatchSize(viper.GetInt("validatorsmanager.refresh-batch-size")),
+		standardvalidatorsmanager.WithRefreshConcurrency(viper.GetInt64("validatorsmanager.refresh-concurrency")),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to start standard validators manager service")
@@ -960,6 +1071,9 @@ func startAccountManager(ctx context.Context, monitor metrics.Service, eth2Clien
 			dirkaccountmanager.WithDomainProvider(eth2Client.(eth2client.DomainProvider)),
 			dirkaccountmanager.WithFarFutureEpochProvider(eth2Client.(eth2client.FarFutureEpochProvider)),
 			dirkaccountmanager.WithCurrentEpochProvider(chainTime),
+			dirkaccountmanager.WithMaxValidatorStateAge(viper.GetDuration("accountmanager.max-validator-state-age")),
+			dirkaccountmanager.WithRefuseStaleValidators(viper.GetBool("accountmanager.refuse-stale-validators")),
+			dirkaccountmanager.WithAllowUnexpectedFarFutureEpoch(viper.GetBool("accountmanager.allow-unexpected-far-future-epoch")),
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to start dirk account manager service")
@@ -982,6 +1096,13 @@ func startAccountManager(ctx context.Context, monitor metrics.Service, eth2Clien
 		if len(passphrases) == 0 {
 			return nil, errors.New("no passphrases for wallet supplied")
 		}
+		activeIndices := make([]phase0.ValidatorIndex, 0)
+		for _, index := range viper.GetIntSlice("accountmanager.wallet.active-indices") {
+			if index < 0 {
+				return nil, errors.New("active indices cannot be negative")
+			}
+			activeIndices = append(activeIndices, phase0.ValidatorIndex(index))
+		}
 		accountManager, err = walletaccountmanager.New(ctx,
 			walletaccountmanager.WithLogLevel(util.LogLevel("accountmanager.wallet")),
 			walletaccountmanager.WithMonitor(monitor.(metrics.AccountManagerMonitor)),
@@ -994,6 +1115,15 @@ func startAccountManager(ctx context.Context, monitor metrics.Service, eth2Clien
 			walletaccountmanager.WithFarFutureEpochProvider(eth2Client.(eth2client.FarFutureEpochProvider)),
 			walletaccountmanager.WithDomainProvider(eth2Client.(eth2client.DomainProvider)),
 			walletaccountmanager.WithCurrentEpochProvider(chainTime),
+			walletaccountmanager.WithMaxValidatorStateAge(viper.GetDuration("accountmanager.max-validator-state-age")),
+			walletaccountmanager.WithRefuseStaleValidators(viper.GetBool("accountmanager.refuse-stale-validators")),
+			walletaccountmanager.WithAllowUnexpectedFarFutureEpoch(viper.GetBool("accountmanager.allow-unexpected-far-future-epoch")),
+			walletaccountmanager.WithMaxAccountsPerWallet(viper.GetInt("accountmanager.wallet.max-accounts-per-wallet")),
+			walletaccountmanager.WithMaxAccounts(viper.GetInt("accountmanager.wallet.max-accounts")),
+			walletaccountmanager.WithAccountPrefetch(viper.GetInt("accountmanager.wallet.account-prefetch")),
+			walletaccountmanager.WithAccountAliases(viper.GetStringMapString("accountmanager.wallet.aliases")),
+			walletaccountmanager.WithActiveIndices(activeIndices),
+			walletaccountmanager.WithValidatorsProvider(eth2Client.(eth2client.ValidatorsProvider)),
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to start wallet account manager service")
@@ -1141,6 +1271,22 @@ func selectBeaconBlockProposalProvider(ctx context.Context,
 			}
 			beaconBlockProposalProviders[address] = client.(eth2client.BeaconBlockProposalProvider)
 		}
+		var beaconCommitteesProvider eth2client.BeaconCommitteesProvider
+		if viper.GetBool("strategies.beaconblockproposal.validate-attestations") {
+			provider, isProvider := eth2Client.(eth2client.BeaconCommitteesProvider)
+			if !isProvider {
+				return nil, errors.New("client does not provide beacon committees for attestation validation")
+			}
+			beaconCommitteesProvider = provider
+		}
+		var validatorsProvider eth2client.ValidatorsProvider
+		if viper.GetBool("strategies.beaconblockproposal.weight-slashings-by-balance") {
+			provider, isProvider := eth2Client.(eth2client.ValidatorsProvider)
+			if !isProvider {
+				return nil, errors.New("client does not provide validators for weighting slashings by balance")
+			}
+			validatorsProvider = provider
+		}
 		beaconBlockProposalProvider, err = bestbeaconblockproposalstrategy.New(ctx,
 			bestbeaconblockproposalstrategy.WithClientMonitor(monitor.(metrics.ClientMonitor)),
 			bestbeaconblockproposalstrategy.WithProcessConcurrency(util.ProcessConcurrency("strategies.beaconblockproposal.best")),
@@ -1152,6 +1298,11 @@ func selectBeaconBlockProposalProvider(ctx context.Context,
 			bestbeaconblockproposalstrategy.WithSignedBeaconBlockProvider(eth2Client.(eth2client.SignedBeaconBlockProvider)),
 			bestbeaconblockproposalstrategy.WithTimeout(util.Timeout("strategies.beaconblockproposal.best")),
 			bestbeaconblockproposalstrategy.WithBlockRootToSlotCache(cacheSvc.(cache.BlockRootToSlotProvider)),
+			bestbeaconblockproposalstrategy.WithBeaconCommitteesProvider(beaconCommitteesProvider),
+			bestbeaconblockproposalstrategy.WithValidatorsProvider(validatorsProvider),
+			bestbeaconblockproposalstrategy.WithMonitor(monitor),
+			bestbeaconblockproposalstrategy.WithMinScore(viper.GetFloat64("strategies.beaconblockproposal.min-score")),
+			bestbeaconblockproposalstrategy.WithScorer(bestbeaconblockproposalstrategy.Scorer(viper.GetString("strategies.beaconblockproposal.scorer"))),
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to start best beacon block proposal strategy")
@@ -1432,6 +1583,10 @@ func runCommands(ctx context.Context,
 		return proposerConfigCheck(ctx, majordomo)
 	}
 
+	if viper.GetBool("fee-recipients") {
+		return feeRecipientsReport(ctx, majordomo)
+	}
+
 	return false
 }
 
@@ -1540,6 +1695,31 @@ func startBlockRelay(ctx context.Context,
 		standardblockrelay.WithTimeout(util.Timeout("blockrelay")),
 		standardblockrelay.WithSecondaryValidatorRegistrationsSubmitters(secondaryValidatorRegistrationsSubmitters),
 		standardblockrelay.WithLogResults(viper.GetBool("blockrelay.log-results")),
+		standardblockrelay.WithMaxMatchingProviders(viper.GetInt("blockrelay.max-matching-providers")),
+		standardblockrelay.WithMaxRelays(viper.GetInt("blockrelay.max-relays")),
+		standardblockrelay.WithWeightedSelectionMargin(viper.GetFloat64("blockrelay.weighted-selection-margin")),
+		standardblockrelay.WithAuditLog(viper.GetString("blockrelay.audit-log")),
+		standardblockrelay.WithLateBidWindow(viper.GetDuration("blockrelay.late-bid-window")),
+		standardblockrelay.WithRejectEmptyBlocks(viper.GetBool("blockrelay.reject-empty-blocks")),
+		standardblockrelay.WithBaseFeeFloorGas(viper.GetUint64("blockrelay.base-fee-floor-gas")),
+		standardblockrelay.WithRecordBelowMinValueBids(viper.GetBool("blockrelay.record-below-min-value-bids")),
+		standardblockrelay.WithZeroValueAsNoBid(viper.GetBool("blockrelay.zero-value-as-no-bid")),
+		standardblockrelay.WithPreferTargetGasLimit(viper.GetBool("blockrelay.prefer-target-gas-limit")),
+		standardblockrelay.WithFirstAcceptableBid(viper.GetBool("blockrelay.first-acceptable-bid")),
+		standardblockrelay.WithAuctionHistorySize(viper.GetInt("blockrelay.auction-history-size")),
+		standardblockrelay.WithAuctionRetries(viper.GetInt("blockrelay.auction-retries")),
+		standardblockrelay.WithAuctionRetryBudget(viper.GetDuration("blockrelay.auction-retry-budget")),
+		standardblockrelay.WithBuilderClientIdleTimeout(viper.GetDuration("builderclient.idle-timeout")),
+		standardblockrelay.WithRequireRelays(viper.GetBool("blockrelay.require-relays")),
+		standardblockrelay.WithUncompetitiveWindow(viper.GetInt("blockrelay.uncompetitive-window")),
+		standardblockrelay.WithErrorRateWindow(viper.GetInt("blockrelay.error-rate-window")),
+		standardblockrelay.WithErrorRateThreshold(viper.GetFloat64("blockrelay.error-rate-threshold")),
+		standardblockrelay.WithEqualBidWindow(viper.GetInt("blockrelay.equal-bid-window")),
+		standardblockrelay.WithEqualBidThreshold(viper.GetFloat64("blockrelay.equal-bid-threshold")),
+		standardblockrelay.WithJustBelowMinValueMargin(viper.GetFloat64("blockrelay.just-below-min-value-margin")),
+		standardblockrelay.WithJustBelowMinValueWindow(viper.GetInt("blockrelay.just-below-min-value-window")),
+		standardblockrelay.WithSalvageValidations(viper.GetStringSlice("blockrelay.salvage-validations")),
+		standardblockrelay.WithMetricRelays(viper.GetStringSlice("blockrelay.metric-relays")),
 		standardblockrelay.WithSpecProvider(eth2Client.(eth2client.SpecProvider)),
 		standardblockrelay.WithDomainProvider(eth2Client.(eth2client.DomainProvider)),
 	)
@@ -1547,5 +1727,19 @@ func startBlockRelay(ctx context.Context,
 		return nil, errors.Wrap(err, "failed to start block relay")
 	}
 
+	if viper.GetInt("blockrelay.auction-history-size") > 0 {
+		// Serve recent auction decisions alongside metrics.
+		registrar, isRegistrar := monitor.(metrics.HandlerRegistrar)
+		standardBlockRelay, isStandardBlockRelay := blockRelay.(*standardblockrelay.Service)
+		switch {
+		case !isRegistrar:
+			log.Warn().Msg("Metrics service does not serve HTTP; auction history not available")
+		case !isStandardBlockRelay:
+			log.Warn().Msg("Block relay does not provide auction history")
+		default:
+			registrar.Handle("/blockrelay/auctions", standardBlockRelay.AuctionHistoryHandler())
+		}
+	}
+
 	return blockRelay, nil
 }
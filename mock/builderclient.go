@@ -21,7 +21,9 @@ import (
 )
 
 type BuilderClient struct {
-	MockPubkey *phase0.BLSPubKey
+	MockAddress string
+	MockPubkey  *phase0.BLSPubKey
+	MockBid     *builderspec.VersionedSignedBuilderBid
 }
 
 // Name returns the name of the builder implementation.
@@ -30,7 +32,10 @@ func (*BuilderClient) Name() string {
 }
 
 // Address returns the address of the builder.
-func (*BuilderClient) Address() string {
+func (m *BuilderClient) Address() string {
+	if m.MockAddress != "" {
+		return m.MockAddress
+	}
 	return "mock:12345"
 }
 
@@ -40,7 +45,7 @@ func (m *BuilderClient) Pubkey() *phase0.BLSPubKey {
 }
 
 // BuilderBidProvider obtains a builder bid.
-func (*BuilderClient) BuilderBid(_ context.Context,
+func (m *BuilderClient) BuilderBid(_ context.Context,
 	_ phase0.Slot,
 	_ phase0.Hash32,
 	_ phase0.BLSPubKey,
@@ -48,5 +53,5 @@ func (*BuilderClient) BuilderBid(_ context.Context,
 	*builderspec.VersionedSignedBuilderBid,
 	error,
 ) {
-	return nil, nil
+	return m.MockBid, nil
 }
@@ -1184,6 +1184,7 @@ func (*SpecProvider) Spec(_ context.Context) (map[string]interface{}, error) {
 		"DOMAIN_VOLUNTARY_EXIT":                    phase0.DomainType{0x04, 0x00, 0x00, 0x00},
 		"DOMAIN_APPLICATION_BUILDER":               phase0.DomainType{0x00, 0x00, 0x00, 0x01},
 		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD":         uint64(256),
+		"GENESIS_FORK_VERSION":                     phase0.Version{0x00, 0x00, 0x00, 0x00},
 		"SECONDS_PER_SLOT":                         12 * time.Second,
 		"SLOTS_PER_EPOCH":                          uint64(32),
 		"SYNC_COMMITTEE_SIZE":                      uint64(512),
@@ -1610,3 +1611,34 @@ func (m *SleepySyncCommitteeContributionProvider) SyncCommitteeContribution(ctx
 	time.Sleep(m.wait)
 	return m.next.SyncCommitteeContribution(ctx, slot, subcommitteeIndex, beaconBlockRoot)
 }
+
+// MismatchedSyncCommitteeContributionProvider is a mock for eth2client.SyncCommitteeContributionProvider
+// that returns contributions for a different slot and beacon block root from those requested.
+type MismatchedSyncCommitteeContributionProvider struct {
+	slotOffset phase0.Slot
+	root       *phase0.Root
+	next       eth2client.SyncCommitteeContributionProvider
+}
+
+// NewMismatchedSyncCommitteeContributionProvider returns a mock sync committee contribution provider.
+// If root is nil the requested beacon block root is returned.
+func NewMismatchedSyncCommitteeContributionProvider(slotOffset phase0.Slot, root *phase0.Root) eth2client.SyncCommitteeContributionProvider {
+	return &MismatchedSyncCommitteeContributionProvider{
+		slotOffset: slotOffset,
+		root:       root,
+		next:       NewSyncCommitteeContributionProvider(),
+	}
+}
+
+// SyncCommitteeContribution is a mock.
+func (m *MismatchedSyncCommitteeContributionProvider) SyncCommitteeContribution(ctx context.Context, slot phase0.Slot, subcommitteeIndex uint64, beaconBlockRoot phase0.Root) (*altair.SyncCommitteeContribution, error) {
+	contribution, err := m.next.SyncCommitteeContribution(ctx, slot, subcommitteeIndex, beaconBlockRoot)
+	if err != nil {
+		return nil, err
+	}
+	contribution.Slot += m.slotOffset
+	if m.root != nil {
+		contribution.BeaconBlockRoot = *m.root
+	}
+	return contribution, nil
+}
@@ -0,0 +1,57 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dirk
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// accountKeysByName returns the public keys of the supplied accounts, keyed
+// by their full account name.
+func accountKeysByName(walletName string,
+	accounts map[phase0.BLSPubKey]e2wtypes.Account,
+) map[string]phase0.BLSPubKey {
+	res := make(map[string]phase0.BLSPubKey, len(accounts))
+	for pubKey, account := range accounts {
+		res[fmt.Sprintf("%s/%s", walletName, account.Name())] = pubKey
+	}
+
+	return res
+}
+
+// updateAccountKeys records the public keys of accounts by name, warning about
+// any account whose public key differs from that previously seen.  This can
+// happen when the participants of a distributed account change, altering its
+// composite public key, and will cause signing requests for the previous key
+// to fail.
+// This assumes that the service mutex is held.
+func (s *Service) updateAccountKeys(accountKeys map[string]phase0.BLSPubKey) {
+	if s.accountKeys == nil {
+		s.accountKeys = make(map[string]phase0.BLSPubKey, len(accountKeys))
+	}
+	for name, pubKey := range accountKeys {
+		previous, exists := s.accountKeys[name]
+		if exists && previous != pubKey {
+			log.Warn().
+				Str("account", name).
+				Stringer("previous_pubkey", previous).
+				Stringer("pubkey", pubKey).
+				Msg("Public key for account has changed; requests using the previous key will fail")
+		}
+		s.accountKeys[name] = pubKey
+	}
+}
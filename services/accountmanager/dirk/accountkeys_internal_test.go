@@ -0,0 +1,53 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dirk
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateAccountKeys(t *testing.T) {
+	key1 := phase0.BLSPubKey{0x01}
+	key2 := phase0.BLSPubKey{0x02}
+
+	capture := logger.NewModuleLogCapture(t, &log)
+
+	s := &Service{}
+
+	// First sighting should not warn.
+	s.updateAccountKeys(map[string]phase0.BLSPubKey{"wallet/account1": key1})
+	require.False(t, capture.HasLog(map[string]interface{}{
+		"message": "Public key for account has changed; requests using the previous key will fail",
+	}))
+
+	// Same key should not warn.
+	s.updateAccountKeys(map[string]phase0.BLSPubKey{"wallet/account1": key1})
+	require.False(t, capture.HasLog(map[string]interface{}{
+		"message": "Public key for account has changed; requests using the previous key will fail",
+	}))
+
+	// Changed key should warn.
+	s.updateAccountKeys(map[string]phase0.BLSPubKey{"wallet/account1": key2})
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"message":         "Public key for account has changed; requests using the previous key will fail",
+		"account":         "wallet/account1",
+		"previous_pubkey": key1.String(),
+		"pubkey":          key2.String(),
+	}))
+	require.Equal(t, key2, s.accountKeys["wallet/account1"])
+}
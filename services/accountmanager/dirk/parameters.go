@@ -27,20 +27,23 @@ import (
 )
 
 type parameters struct {
-	logLevel               zerolog.Level
-	monitor                metrics.AccountManagerMonitor
-	timeout                time.Duration
-	clientMonitor          metrics.ClientMonitor
-	processConcurrency     int64
-	endpoints              []string
-	accountPaths           []string
-	clientCert             []byte
-	clientKey              []byte
-	caCert                 []byte
-	domainProvider         eth2client.DomainProvider
-	validatorsManager      validatorsmanager.Service
-	farFutureEpochProvider eth2client.FarFutureEpochProvider
-	currentEpochProvider   chaintime.Service
+	logLevel                      zerolog.Level
+	monitor                       metrics.AccountManagerMonitor
+	timeout                       time.Duration
+	clientMonitor                 metrics.ClientMonitor
+	processConcurrency            int64
+	endpoints                     []string
+	accountPaths                  []string
+	clientCert                    []byte
+	clientKey                     []byte
+	caCert                        []byte
+	domainProvider                eth2client.DomainProvider
+	validatorsManager             validatorsmanager.Service
+	farFutureEpochProvider        eth2client.FarFutureEpochProvider
+	currentEpochProvider          chaintime.Service
+	maxValidatorStateAge          time.Duration
+	refuseStaleValidators         bool
+	allowUnexpectedFarFutureEpoch bool
 }
 
 // Parameter is the interface for service parameters.
@@ -152,6 +155,30 @@ func WithCurrentEpochProvider(provider chaintime.Service) Parameter {
 	})
 }
 
+// WithMaxValidatorStateAge sets the maximum age of validator state before it is
+// considered stale.  0 disables the check.
+func WithMaxValidatorStateAge(age time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxValidatorStateAge = age
+	})
+}
+
+// WithRefuseStaleValidators refuses to return validating accounts if the
+// validator state is stale.
+func WithRefuseStaleValidators(refuse bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.refuseStaleValidators = refuse
+	})
+}
+
+// WithAllowUnexpectedFarFutureEpoch allows the service to start with a far
+// future epoch other than that defined by the specification, with a warning.
+func WithAllowUnexpectedFarFutureEpoch(allow bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.allowUnexpectedFarFutureEpoch = allow
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -202,6 +229,9 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.currentEpochProvider == nil {
 		return nil, errors.New("no current epoch provider specified")
 	}
+	if parameters.maxValidatorStateAge < 0 {
+		return nil, errors.New("max validator state age cannot be negative")
+	}
 
 	return &parameters, nil
 }
@@ -19,14 +19,18 @@ import (
 	"crypto/x509"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	eth2client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/accountmanager"
+	"github.com/attestantio/vouch/services/accountmanager/utils"
 	"github.com/attestantio/vouch/services/chaintime"
 	"github.com/attestantio/vouch/services/metrics"
 	"github.com/attestantio/vouch/services/validatorsmanager"
@@ -54,12 +58,20 @@ type Service struct {
 	accountPaths         []string
 	credentials          credentials.TransportCredentials
 	accounts             map[phase0.BLSPubKey]e2wtypes.Account
+	accountKeys          map[string]phase0.BLSPubKey
 	validatorsManager    validatorsmanager.Service
 	domainProvider       eth2client.DomainProvider
 	farFutureEpoch       phase0.Epoch
 	currentEpochProvider chaintime.Service
-	wallets              map[string]e2wtypes.Wallet
-	walletsMutex         sync.RWMutex
+	// lastValidatorsRefresh is the time of the last successful refresh of
+	// validator state, in Unix nanoseconds.
+	lastValidatorsRefresh atomic.Int64
+	maxValidatorStateAge  time.Duration
+	refuseStaleValidators bool
+	wallets               map[string]e2wtypes.Wallet
+	walletsMutex          sync.RWMutex
+	// slashedAccounts alerts when accounts have been slashed.
+	slashedAccounts *utils.SlashedAccounts
 }
 
 // module-wide log.
@@ -110,20 +122,26 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain far future epoch")
 	}
+	if err := utils.CheckFarFutureEpoch(log, farFutureEpoch, parameters.allowUnexpectedFarFutureEpoch); err != nil {
+		return nil, err
+	}
 
 	s := &Service{
-		monitor:              parameters.monitor,
-		clientMonitor:        parameters.clientMonitor,
-		timeout:              parameters.timeout,
-		processConcurrency:   parameters.processConcurrency,
-		endpoints:            endpoints,
-		accountPaths:         parameters.accountPaths,
-		credentials:          credentials,
-		domainProvider:       parameters.domainProvider,
-		validatorsManager:    parameters.validatorsManager,
-		farFutureEpoch:       farFutureEpoch,
-		currentEpochProvider: parameters.currentEpochProvider,
-		wallets:              make(map[string]e2wtypes.Wallet),
+		monitor:               parameters.monitor,
+		clientMonitor:         parameters.clientMonitor,
+		timeout:               parameters.timeout,
+		processConcurrency:    parameters.processConcurrency,
+		endpoints:             endpoints,
+		accountPaths:          parameters.accountPaths,
+		credentials:           credentials,
+		domainProvider:        parameters.domainProvider,
+		validatorsManager:     parameters.validatorsManager,
+		farFutureEpoch:        farFutureEpoch,
+		currentEpochProvider:  parameters.currentEpochProvider,
+		maxValidatorStateAge:  parameters.maxValidatorStateAge,
+		refuseStaleValidators: parameters.refuseStaleValidators,
+		wallets:               make(map[string]e2wtypes.Wallet),
+		slashedAccounts:       utils.NewSlashedAccounts(parameters.monitor),
 	}
 	log.Trace().Int64("process_concurrency", s.processConcurrency).Msg("Set process concurrency")
 
@@ -185,6 +203,7 @@ func (s *Service) refreshAccounts(ctx context.Context) {
 	// Fetch accounts for each wallet in parallel.
 	started := time.Now()
 	accounts := make(map[phase0.BLSPubKey]e2wtypes.Account)
+	accountKeys := make(map[string]phase0.BLSPubKey)
 	var accountsMu sync.Mutex
 	sem := semaphore.NewWeighted(s.processConcurrency)
 	var wg sync.WaitGroup
@@ -201,10 +220,14 @@ func (s *Service) refreshAccounts(ctx context.Context) {
 			log.Trace().Dur("elapsed", time.Since(started)).Msg("Obtained semaphore")
 			walletAccounts := s.fetchAccountsForWallet(ctx, wallets[i], verificationRegexes)
 			log.Trace().Dur("elapsed", time.Since(started)).Int("accounts", len(walletAccounts)).Msg("Obtained accounts")
+			walletAccountKeys := accountKeysByName(wallets[i].Name(), walletAccounts)
 			mu.Lock()
 			for k, v := range walletAccounts {
 				accounts[k] = v
 			}
+			for k, v := range walletAccountKeys {
+				accountKeys[k] = v
+			}
 			mu.Unlock()
 			log.Trace().Dur("elapsed", time.Since(started)).Int("accounts", len(walletAccounts)).Msg("Imported accounts")
 		}(ctx, sem, &wg, i, &accountsMu)
@@ -218,6 +241,7 @@ func (s *Service) refreshAccounts(ctx context.Context) {
 		log.Warn().Msg("No accounts obtained; retaining old list")
 		return
 	}
+	s.updateAccountKeys(accountKeys)
 	s.accounts = accounts
 	s.mutex.Unlock()
 }
@@ -263,6 +287,8 @@ func (s *Service) refreshValidators(ctx context.Context) error {
 	if err := s.validatorsManager.RefreshValidatorsFromBeaconNode(ctx, accountPubKeys); err != nil {
 		return errors.Wrap(err, "failed to refresh validators")
 	}
+	s.lastValidatorsRefresh.Store(time.Now().UnixNano())
+
 	return nil
 }
 
@@ -298,6 +324,10 @@ func (s *Service) ValidatingAccountsForEpoch(ctx context.Context, epoch phase0.E
 	))
 	defer span.End()
 
+	if err := s.checkValidatorStateAge(); err != nil {
+		return nil, err
+	}
+
 	// stateCount is used to update metrics.
 	stateCount := map[api.ValidatorState]uint64{
 		api.ValidatorStateUnknown:            0,
@@ -325,6 +355,9 @@ func (s *Service) ValidatingAccountsForEpoch(ctx context.Context, epoch phase0.E
 	for index, validator := range validators {
 		state := api.ValidatorToState(validator, epoch, s.farFutureEpoch)
 		stateCount[state]++
+		if account, exists := s.accounts[validator.PublicKey]; exists {
+			s.checkSlashed(index, validator, state, account)
+		}
 		if state == api.ValidatorStateActiveOngoing || state == api.ValidatorStateActiveExiting {
 			account := s.accounts[validator.PublicKey]
 			log.Trace().
@@ -356,6 +389,48 @@ func (s *Service) ValidatingAccountsForEpochByIndex(ctx context.Context, epoch p
 	))
 	defer span.End()
 
+	if err := s.checkValidatorStateAge(); err != nil {
+		return nil, err
+	}
+
+	validatingAccounts, _ := s.validatingAccountsForEpochByIndex(ctx, epoch, indices)
+
+	return validatingAccounts, nil
+}
+
+// ValidatingAccountsForEpochByIndexWithMissing obtains the specified validating accounts for a given epoch,
+// along with the requested indices that are unknown or inactive.
+func (s *Service) ValidatingAccountsForEpochByIndexWithMissing(ctx context.Context,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	map[phase0.ValidatorIndex]e2wtypes.Account,
+	*accountmanager.MissingIndices,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.vouch.services.accountmanager.dirk").Start(ctx, "ValidatingAccountsForEpochByIndexWithMissing", trace.WithAttributes(
+		attribute.Int64("epoch", int64(epoch)),
+	))
+	defer span.End()
+
+	if err := s.checkValidatorStateAge(); err != nil {
+		return nil, nil, err
+	}
+
+	validatingAccounts, missing := s.validatingAccountsForEpochByIndex(ctx, epoch, indices)
+
+	return validatingAccounts, missing, nil
+}
+
+// validatingAccountsForEpochByIndex obtains the specified validating accounts for a given epoch,
+// along with the requested indices that are unknown or inactive.
+func (s *Service) validatingAccountsForEpochByIndex(ctx context.Context,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	map[phase0.ValidatorIndex]e2wtypes.Account,
+	*accountmanager.MissingIndices,
+) {
 	s.mutex.RLock()
 	pubKeys := make([]phase0.BLSPubKey, 0, len(s.accounts))
 	for pubKey := range s.accounts {
@@ -367,21 +442,43 @@ func (s *Service) ValidatingAccountsForEpochByIndex(ctx context.Context, epoch p
 	for _, index := range indices {
 		indexPresenceMap[index] = true
 	}
+	missing := &accountmanager.MissingIndices{
+		Unknown:  make([]phase0.ValidatorIndex, 0),
+		Inactive: make([]phase0.ValidatorIndex, 0),
+	}
 	validators := s.validatorsManager.ValidatorsByPubKey(ctx, pubKeys)
 	validatingAccounts := make(map[phase0.ValidatorIndex]e2wtypes.Account)
 	for index, validator := range validators {
 		if _, present := indexPresenceMap[index]; !present {
 			continue
 		}
+		// Clear the flag to show that the index is known.
+		indexPresenceMap[index] = false
 		state := api.ValidatorToState(validator, epoch, s.farFutureEpoch)
 		if state == api.ValidatorStateActiveOngoing || state == api.ValidatorStateActiveExiting {
 			s.mutex.RLock()
 			validatingAccounts[index] = s.accounts[validator.PublicKey]
 			s.mutex.RUnlock()
+		} else {
+			missing.Inactive = append(missing.Inactive, index)
+		}
+	}
+	for index, unknown := range indexPresenceMap {
+		if unknown {
+			missing.Unknown = append(missing.Unknown, index)
 		}
 	}
+	sortIndices(missing.Unknown)
+	sortIndices(missing.Inactive)
 
-	return validatingAccounts, nil
+	return validatingAccounts, missing
+}
+
+// sortIndices sorts validator indices in ascending order.
+func sortIndices(indices []phase0.ValidatorIndex) {
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
 }
 
 // accountPathsToVerificationRegexes turns account paths in to regexes to allow verification.
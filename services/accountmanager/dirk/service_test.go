@@ -371,6 +371,44 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no current epoch provider specified",
 		},
+		{
+			name: "FarFutureEpochUnexpected",
+			params: []dirk.Parameter{
+				dirk.WithLogLevel(zerolog.Disabled),
+				dirk.WithMonitor(nullmetrics.New(ctx)),
+				dirk.WithClientMonitor(nullmetrics.New(ctx)),
+				dirk.WithProcessConcurrency(1),
+				dirk.WithEndpoints([]string{"localhost:12345", "localhost:12346"}),
+				dirk.WithAccountPaths([]string{"wallet1", "wallet2"}),
+				dirk.WithClientCert([]byte(resources.ClientTest01Crt)),
+				dirk.WithClientKey([]byte(resources.ClientTest01Key)),
+				dirk.WithCACert([]byte(resources.CACrt)),
+				dirk.WithValidatorsManager(validatorsManager),
+				dirk.WithDomainProvider(domainProvider),
+				dirk.WithFarFutureEpochProvider(mock.NewFarFutureEpochProvider(1000)),
+				dirk.WithCurrentEpochProvider(chainTime),
+			},
+			err: "far future epoch 1000 is not the expected 18446744073709551615",
+		},
+		{
+			name: "FarFutureEpochUnexpectedAllowed",
+			params: []dirk.Parameter{
+				dirk.WithLogLevel(zerolog.Disabled),
+				dirk.WithMonitor(nullmetrics.New(ctx)),
+				dirk.WithClientMonitor(nullmetrics.New(ctx)),
+				dirk.WithProcessConcurrency(1),
+				dirk.WithEndpoints([]string{"localhost:12345", "localhost:12346"}),
+				dirk.WithAccountPaths([]string{"wallet1", "wallet2"}),
+				dirk.WithClientCert([]byte(resources.ClientTest01Crt)),
+				dirk.WithClientKey([]byte(resources.ClientTest01Key)),
+				dirk.WithCACert([]byte(resources.CACrt)),
+				dirk.WithValidatorsManager(validatorsManager),
+				dirk.WithDomainProvider(domainProvider),
+				dirk.WithFarFutureEpochProvider(mock.NewFarFutureEpochProvider(1000)),
+				dirk.WithCurrentEpochProvider(chainTime),
+				dirk.WithAllowUnexpectedFarFutureEpoch(true),
+			},
+		},
 		{
 			name: "Good",
 			params: []dirk.Parameter{
@@ -63,6 +63,34 @@ func (s *validatingAccountsProvider) ValidatingAccountsForEpochByIndex(_ context
 	return accounts, nil
 }
 
+// ValidatingAccountsForEpochByIndexWithMissing obtains the specified validating accounts for a given epoch,
+// along with the requested indices that are unknown.
+func (s *validatingAccountsProvider) ValidatingAccountsForEpochByIndexWithMissing(ctx context.Context,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	map[phase0.ValidatorIndex]e2wtypes.Account,
+	*accountmanager.MissingIndices,
+	error,
+) {
+	accounts, err := s.ValidatingAccountsForEpochByIndex(ctx, epoch, indices)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	missing := &accountmanager.MissingIndices{
+		Unknown:  make([]phase0.ValidatorIndex, 0),
+		Inactive: make([]phase0.ValidatorIndex, 0),
+	}
+	for _, index := range indices {
+		if _, exists := accounts[index]; !exists {
+			missing.Unknown = append(missing.Unknown, index)
+		}
+	}
+
+	return accounts, missing, nil
+}
+
 type accountsProvider struct{}
 
 // NewAccountsProvider is a mock.
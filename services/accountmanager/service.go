@@ -17,6 +17,7 @@ package accountmanager
 import (
 	"context"
 
+	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
 )
@@ -39,6 +40,56 @@ type ValidatingAccountsProvider interface {
 	)
 }
 
+// ValidatingAccountsWithMissingProvider provides validating accounts along with
+// information about requested indices that could not be supplied.
+type ValidatingAccountsWithMissingProvider interface {
+	// ValidatingAccountsForEpochByIndexWithMissing obtains the specified validating accounts
+	// for a given epoch, along with the requested indices that are not managed by this
+	// provider and those that are managed but not active at the epoch.
+	ValidatingAccountsForEpochByIndexWithMissing(ctx context.Context,
+		epoch phase0.Epoch,
+		indices []phase0.ValidatorIndex,
+	) (
+		map[phase0.ValidatorIndex]e2wtypes.Account,
+		*MissingIndices,
+		error,
+	)
+}
+
+// MissingIndices are requested validator indices for which no validating account was returned.
+type MissingIndices struct {
+	// Unknown are the indices that do not correspond to a validator for an account managed by the provider.
+	Unknown []phase0.ValidatorIndex
+	// Inactive are the indices of managed accounts whose validators are not active at the epoch.
+	Inactive []phase0.ValidatorIndex
+}
+
+// PendingAccountsProvider provides accounts whose validators are pending activation.
+type PendingAccountsProvider interface {
+	// PendingAccountsForEpoch obtains the accounts whose validators are pending
+	// activation at a given epoch, in activation queue order.
+	PendingAccountsForEpoch(ctx context.Context, epoch phase0.Epoch) ([]*PendingAccount, error)
+}
+
+// PendingAccount is an account whose validator is pending activation.
+type PendingAccount struct {
+	Account e2wtypes.Account
+	Index   phase0.ValidatorIndex
+	// State is either pending initialized or pending queued.
+	State api.ValidatorState
+	// ActivationEligibilityEpoch is the epoch at which the validator became
+	// eligible for activation, or the far future epoch if it is not yet eligible.
+	ActivationEligibilityEpoch phase0.Epoch
+	// ActivationEpoch is the epoch at which the validator will be activated, or
+	// the far future epoch if it has not yet been dequeued for activation.
+	ActivationEpoch phase0.Epoch
+	// QueuePosition is the position of the validator in the activation queue
+	// amongst the pending accounts, starting at 0.  The position in the full
+	// activation queue requires the state of every validator on the chain, so
+	// is not available.
+	QueuePosition int
+}
+
 // Refresher refreshes account information from the remote source.
 type Refresher interface {
 	// Refresh refreshes the accounts from the remote source, and account validator state from
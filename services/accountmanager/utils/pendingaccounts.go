@@ -0,0 +1,63 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"sort"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/accountmanager"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// PendingAccounts returns the accounts whose validators are pending activation
+// at a given epoch, in activation queue order.  The activation queue is ordered
+// by activation eligibility epoch, and then by validator index.
+func PendingAccounts(accounts map[phase0.BLSPubKey]e2wtypes.Account,
+	validators map[phase0.ValidatorIndex]*phase0.Validator,
+	epoch phase0.Epoch,
+	farFutureEpoch phase0.Epoch,
+) []*accountmanager.PendingAccount {
+	pendingAccounts := make([]*accountmanager.PendingAccount, 0)
+	for index, validator := range validators {
+		state := api.ValidatorToState(validator, epoch, farFutureEpoch)
+		if state != api.ValidatorStatePendingInitialized && state != api.ValidatorStatePendingQueued {
+			continue
+		}
+		account, exists := accounts[validator.PublicKey]
+		if !exists {
+			continue
+		}
+		pendingAccounts = append(pendingAccounts, &accountmanager.PendingAccount{
+			Account:                    account,
+			Index:                      index,
+			State:                      state,
+			ActivationEligibilityEpoch: validator.ActivationEligibilityEpoch,
+			ActivationEpoch:            validator.ActivationEpoch,
+		})
+	}
+
+	sort.Slice(pendingAccounts, func(i, j int) bool {
+		if pendingAccounts[i].ActivationEligibilityEpoch != pendingAccounts[j].ActivationEligibilityEpoch {
+			return pendingAccounts[i].ActivationEligibilityEpoch < pendingAccounts[j].ActivationEligibilityEpoch
+		}
+		return pendingAccounts[i].Index < pendingAccounts[j].Index
+	})
+	for i := range pendingAccounts {
+		pendingAccounts[i].QueuePosition = i
+	}
+
+	return pendingAccounts
+}
@@ -0,0 +1,126 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/accountmanager/utils"
+	"github.com/stretchr/testify/require"
+	e2types "github.com/wealdtech/go-eth2-types/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	nd "github.com/wealdtech/go-eth2-wallet-nd/v2"
+	scratch "github.com/wealdtech/go-eth2-wallet-store-scratch"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// testAccounts creates accounts keyed by their public keys.
+func testAccounts(ctx context.Context, t *testing.T, count int) (map[phase0.BLSPubKey]e2wtypes.Account, []phase0.BLSPubKey) {
+	t.Helper()
+	require.NoError(t, e2types.InitBLS())
+
+	wallet, err := nd.CreateWallet(ctx, "Test wallet", scratch.New(), keystorev4.New())
+	require.NoError(t, err)
+	require.NoError(t, wallet.(e2wtypes.WalletLocker).Unlock(ctx, nil))
+
+	accounts := make(map[phase0.BLSPubKey]e2wtypes.Account)
+	pubKeys := make([]phase0.BLSPubKey, 0, count)
+	for i := 0; i < count; i++ {
+		key, err := e2types.GenerateBLSPrivateKey()
+		require.NoError(t, err)
+		account, err := wallet.(e2wtypes.WalletAccountImporter).ImportAccount(ctx,
+			fmt.Sprintf("Account %d", i),
+			key.Marshal(),
+			[]byte("pass"),
+		)
+		require.NoError(t, err)
+		var pubKey phase0.BLSPubKey
+		copy(pubKey[:], account.PublicKey().Marshal())
+		accounts[pubKey] = account
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	return accounts, pubKeys
+}
+
+func TestPendingAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	farFutureEpoch := phase0.Epoch(0xffffffffffffffff)
+	accounts, pubKeys := testAccounts(ctx, t, 4)
+
+	// Validator 1 is active, validator 2 is awaiting eligibility, and
+	// validators 3 and 4 are queued with 4 having become eligible first.
+	// Validator 5 is pending but not one of ours.
+	validators := map[phase0.ValidatorIndex]*phase0.Validator{
+		1: {
+			PublicKey:                  pubKeys[0],
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		},
+		2: {
+			PublicKey:                  pubKeys[1],
+			ActivationEligibilityEpoch: farFutureEpoch,
+			ActivationEpoch:            farFutureEpoch,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		},
+		3: {
+			PublicKey:                  pubKeys[2],
+			ActivationEligibilityEpoch: 6,
+			ActivationEpoch:            100,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		},
+		4: {
+			PublicKey:                  pubKeys[3],
+			ActivationEligibilityEpoch: 5,
+			ActivationEpoch:            farFutureEpoch,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		},
+		5: {
+			PublicKey:                  phase0.BLSPubKey{0x01},
+			ActivationEligibilityEpoch: 1,
+			ActivationEpoch:            farFutureEpoch,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		},
+	}
+
+	require.Empty(t, utils.PendingAccounts(accounts, nil, 10, farFutureEpoch))
+
+	res := utils.PendingAccounts(accounts, validators, 10, farFutureEpoch)
+	require.Len(t, res, 3)
+
+	require.Equal(t, phase0.ValidatorIndex(4), res[0].Index)
+	require.Equal(t, api.ValidatorStatePendingQueued, res[0].State)
+	require.Equal(t, 0, res[0].QueuePosition)
+	require.Equal(t, accounts[pubKeys[3]], res[0].Account)
+
+	require.Equal(t, phase0.ValidatorIndex(3), res[1].Index)
+	require.Equal(t, api.ValidatorStatePendingQueued, res[1].State)
+	require.Equal(t, phase0.Epoch(100), res[1].ActivationEpoch)
+	require.Equal(t, 1, res[1].QueuePosition)
+
+	require.Equal(t, phase0.ValidatorIndex(2), res[2].Index)
+	require.Equal(t, api.ValidatorStatePendingInitialized, res[2].State)
+	require.Equal(t, 2, res[2].QueuePosition)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"sync"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/metrics"
+	"github.com/rs/zerolog"
+)
+
+// SlashedAccounts alerts when managed accounts have been slashed.  Slashed
+// validators are never returned as validating, but the operator will want to
+// know about the slashing immediately, so the alert is raised the first time
+// that each slashed account is seen.
+type SlashedAccounts struct {
+	monitor  metrics.AccountManagerMonitor
+	mu       sync.Mutex
+	reported map[phase0.BLSPubKey]struct{}
+}
+
+// NewSlashedAccounts creates a new slashed account tracker.
+func NewSlashedAccounts(monitor metrics.AccountManagerMonitor) *SlashedAccounts {
+	return &SlashedAccounts{
+		monitor:  monitor,
+		reported: make(map[phase0.BLSPubKey]struct{}),
+	}
+}
+
+// Check alerts if the account for a validator has been slashed and has not
+// already been reported.  The alias is optional.
+func (s *SlashedAccounts) Check(log zerolog.Logger,
+	index phase0.ValidatorIndex,
+	validator *phase0.Validator,
+	state api.ValidatorState,
+	name string,
+	alias string,
+) {
+	if state != api.ValidatorStateActiveSlashed && state != api.ValidatorStateExitedSlashed {
+		return
+	}
+
+	s.mu.Lock()
+	_, reported := s.reported[validator.PublicKey]
+	s.reported[validator.PublicKey] = struct{}{}
+	s.mu.Unlock()
+	if reported {
+		return
+	}
+
+	e := log.Error().Str("name", name)
+	if alias != "" {
+		e = e.Str("alias", alias)
+	}
+	e.Str("public_key", fmt.Sprintf("%#x", validator.PublicKey)).
+		Uint64("index", uint64(index)).
+		Str("state", state.String()).
+		Msg("Validator has been slashed; investigate immediately")
+	s.monitor.AccountSlashed()
+}
@@ -0,0 +1,75 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/accountmanager/utils"
+	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// slashedMonitor counts the slashed accounts reported.
+type slashedMonitor struct {
+	*nullmetrics.Service
+	slashed int
+}
+
+func (m *slashedMonitor) AccountSlashed() {
+	m.slashed++
+}
+
+func TestSlashedAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	log := zerolog.Nop()
+	capture := logger.NewModuleLogCapture(t, &log)
+	monitor := &slashedMonitor{Service: nullmetrics.New(ctx)}
+	slashedAccounts := utils.NewSlashedAccounts(monitor)
+
+	active := &phase0.Validator{PublicKey: phase0.BLSPubKey{0x01}}
+	activeSlashed := &phase0.Validator{PublicKey: phase0.BLSPubKey{0x02}, Slashed: true}
+	exitedSlashed := &phase0.Validator{PublicKey: phase0.BLSPubKey{0x03}, Slashed: true}
+
+	slashedAccounts.Check(log, 1, active, api.ValidatorStateActiveOngoing, "Wallet/Account 1", "")
+	slashedAccounts.Check(log, 2, activeSlashed, api.ValidatorStateActiveSlashed, "Wallet/Account 2", "")
+	slashedAccounts.Check(log, 3, exitedSlashed, api.ValidatorStateExitedSlashed, "Wallet/Account 3", "Validator three")
+	require.Equal(t, 2, monitor.slashed)
+	require.False(t, capture.HasLog(map[string]interface{}{
+		"message": "Validator has been slashed; investigate immediately",
+		"index":   uint64(1),
+	}))
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"message": "Validator has been slashed; investigate immediately",
+		"index":   uint64(2),
+		"name":    "Wallet/Account 2",
+		"state":   "active_slashed",
+	}))
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"message": "Validator has been slashed; investigate immediately",
+		"index":   uint64(3),
+		"alias":   "Validator three",
+	}))
+
+	// The alert is only raised once for each account.
+	slashedAccounts.Check(log, 2, activeSlashed, api.ValidatorStateActiveSlashed, "Wallet/Account 2", "")
+	slashedAccounts.Check(log, 3, exitedSlashed, api.ValidatorStateExitedSlashed, "Wallet/Account 3", "Validator three")
+	require.Equal(t, 2, monitor.slashed)
+}
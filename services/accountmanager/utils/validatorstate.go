@@ -0,0 +1,75 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// expectedFarFutureEpoch is the far future epoch defined by the specification.
+const expectedFarFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// CheckFarFutureEpoch checks that the far future epoch obtained from the beacon
+// node is that defined by the specification.  Validator states are calculated
+// using the far future epoch, so an incorrect value would misclassify every
+// validator.  An error is returned if it is not, unless an unexpected value is
+// allowed, in which case a warning is logged.
+func CheckFarFutureEpoch(log zerolog.Logger, farFutureEpoch phase0.Epoch, allowUnexpected bool) error {
+	if farFutureEpoch == expectedFarFutureEpoch {
+		return nil
+	}
+
+	if !allowUnexpected {
+		return errors.Errorf("far future epoch %d is not the expected %d", farFutureEpoch, expectedFarFutureEpoch)
+	}
+	log.Warn().
+		Uint64("far_future_epoch", uint64(farFutureEpoch)).
+		Uint64("expected_far_future_epoch", uint64(expectedFarFutureEpoch)).
+		Msg("Far future epoch is not the expected value; validator states may be incorrect")
+
+	return nil
+}
+
+// CheckValidatorStateAge checks that validator state last refreshed at the
+// given time is recent enough to be trusted.  A warning is logged if it is
+// not, and an error returned if stale state is refused.  A maximum age of 0
+// disables the check.
+func CheckValidatorStateAge(log zerolog.Logger,
+	lastRefresh time.Time,
+	maxAge time.Duration,
+	refuseStale bool,
+) error {
+	if maxAge == 0 {
+		return nil
+	}
+
+	age := time.Since(lastRefresh)
+	if age <= maxAge {
+		return nil
+	}
+
+	log.Warn().
+		Dur("age", age).
+		Dur("max_age", maxAge).
+		Msg("Validator state has not been refreshed recently; validating accounts may be incorrect")
+	if refuseStale {
+		return errors.New("validator state is stale")
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/accountmanager/utils"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFarFutureEpoch(t *testing.T) {
+	tests := []struct {
+		name            string
+		farFutureEpoch  phase0.Epoch
+		allowUnexpected bool
+		err             string
+		warned          bool
+	}{
+		{
+			name:           "Expected",
+			farFutureEpoch: 0xffffffffffffffff,
+		},
+		{
+			name:           "Implausible",
+			farFutureEpoch: 1000,
+			err:            "far future epoch 1000 is not the expected 18446744073709551615",
+		},
+		{
+			name:            "ImplausibleAllowed",
+			farFutureEpoch:  1000,
+			allowUnexpected: true,
+			warned:          true,
+		},
+		{
+			name:           "OffByOne",
+			farFutureEpoch: 0xfffffffffffffffe,
+			err:            "far future epoch 18446744073709551614 is not the expected 18446744073709551615",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			log := zerolog.Nop()
+			capture := logger.NewModuleLogCapture(t, &log)
+
+			err := utils.CheckFarFutureEpoch(log, test.farFutureEpoch, test.allowUnexpected)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.warned, capture.HasLog(map[string]interface{}{
+				"message": "Far future epoch is not the expected value; validator states may be incorrect",
+			}))
+		})
+	}
+}
+
+func TestCheckValidatorStateAge(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxAge      time.Duration
+		refuse      bool
+		lastRefresh time.Time
+		err         string
+		warned      bool
+	}{
+		{
+			name:        "Disabled",
+			lastRefresh: time.Now().Add(-time.Hour),
+		},
+		{
+			name:        "Fresh",
+			maxAge:      time.Minute,
+			refuse:      true,
+			lastRefresh: time.Now(),
+		},
+		{
+			name:        "StaleWarn",
+			maxAge:      time.Minute,
+			lastRefresh: time.Now().Add(-time.Hour),
+			warned:      true,
+		},
+		{
+			name:        "StaleRefuse",
+			maxAge:      time.Minute,
+			refuse:      true,
+			lastRefresh: time.Now().Add(-time.Hour),
+			err:         "validator state is stale",
+			warned:      true,
+		},
+		{
+			name:        "NeverRefreshed",
+			maxAge:      time.Minute,
+			refuse:      true,
+			lastRefresh: time.Unix(0, 0),
+			err:         "validator state is stale",
+			warned:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			log := zerolog.Nop()
+			capture := logger.NewModuleLogCapture(t, &log)
+
+			err := utils.CheckValidatorStateAge(log, test.lastRefresh, test.maxAge, test.refuse)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.warned, capture.HasLog(map[string]interface{}{
+				"message": "Validator state has not been refreshed recently; validating accounts may be incorrect",
+			}))
+		})
+	}
+}
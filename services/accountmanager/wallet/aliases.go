@@ -0,0 +1,94 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// accountAliases maps accounts to friendly aliases for display purposes.
+type accountAliases struct {
+	byPubKey map[phase0.BLSPubKey]string
+	// byName is keyed by lower-cased account name, as configuration keys are
+	// not case-sensitive.
+	byName map[string]string
+}
+
+// newAccountAliases creates account aliases from a map of public key or
+// account name to alias.
+func newAccountAliases(aliases map[string]string) (*accountAliases, error) {
+	res := &accountAliases{
+		byPubKey: make(map[phase0.BLSPubKey]string),
+		byName:   make(map[string]string),
+	}
+	for key, alias := range aliases {
+		if alias == "" {
+			return nil, errors.Errorf("empty alias for %s", key)
+		}
+		if pubKey, isPubKey := aliasPubKey(key); isPubKey {
+			res.byPubKey[pubKey] = alias
+			continue
+		}
+		if !strings.Contains(key, "/") {
+			return nil, errors.Errorf("alias key %s is neither a public key nor a wallet/account name", key)
+		}
+		res.byName[strings.ToLower(key)] = alias
+	}
+
+	return res, nil
+}
+
+// aliasPubKey returns the public key for an alias key, if it is one.
+func aliasPubKey(key string) (phase0.BLSPubKey, bool) {
+	var pubKey phase0.BLSPubKey
+	data, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+	if err != nil || len(data) != len(pubKey) {
+		return pubKey, false
+	}
+	copy(pubKey[:], data)
+
+	return pubKey, true
+}
+
+// alias returns the alias for the account with the given name and public key,
+// or the name itself if the account has no alias.  Aliases by public key take
+// precedence over aliases by name.
+func (a *accountAliases) alias(name string, pubKey phase0.BLSPubKey) string {
+	if a == nil {
+		return name
+	}
+	if alias, exists := a.byPubKey[pubKey]; exists {
+		return alias
+	}
+	if alias, exists := a.byName[strings.ToLower(name)]; exists {
+		return alias
+	}
+
+	return name
+}
+
+// accountName returns the full wallet/account name of an account, if available.
+func accountName(account e2wtypes.Account) string {
+	if provider, isProvider := account.(e2wtypes.AccountWalletProvider); isProvider {
+		return fmt.Sprintf("%s/%s", provider.Wallet().Name(), account.Name())
+	}
+
+	return account.Name()
+}
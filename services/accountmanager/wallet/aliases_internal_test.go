@@ -0,0 +1,157 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	e2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+func TestNewAccountAliases(t *testing.T) {
+	tests := []struct {
+		name    string
+		aliases map[string]string
+		err     string
+	}{
+		{
+			name: "Nil",
+		},
+		{
+			name: "PubKey",
+			aliases: map[string]string{
+				"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c": "alias",
+			},
+		},
+		{
+			name: "PubKeyNoPrefix",
+			aliases: map[string]string{
+				"a99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c": "alias",
+			},
+		},
+		{
+			name: "Name",
+			aliases: map[string]string{
+				"Wallet/Account": "alias",
+			},
+		},
+		{
+			name: "EmptyAlias",
+			aliases: map[string]string{
+				"Wallet/Account": "",
+			},
+			err: "empty alias for Wallet/Account",
+		},
+		{
+			name: "InvalidKey",
+			aliases: map[string]string{
+				"0xa99a": "alias",
+			},
+			err: "alias key 0xa99a is neither a public key nor a wallet/account name",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := newAccountAliases(test.aliases)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAccountAlias(t *testing.T) {
+	pubKey := phase0.BLSPubKey{0xa9, 0x9a}
+	otherPubKey := phase0.BLSPubKey{0xb8, 0x1b}
+
+	aliases, err := newAccountAliases(map[string]string{
+		fmt.Sprintf("%#x", pubKey): "by-pubkey",
+		"wallet/account 1":         "by-name",
+		"Wallet/Account 2":         "by-name-2",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		aliases *accountAliases
+		account string
+		pubKey  phase0.BLSPubKey
+		alias   string
+	}{
+		{
+			name:    "NoAliases",
+			account: "Wallet/Account 1",
+			pubKey:  pubKey,
+			alias:   "Wallet/Account 1",
+		},
+		{
+			name:    "PubKey",
+			aliases: aliases,
+			account: "Wallet/Account 3",
+			pubKey:  pubKey,
+			alias:   "by-pubkey",
+		},
+		{
+			name:    "PubKeyPrecedence",
+			aliases: aliases,
+			account: "Wallet/Account 1",
+			pubKey:  pubKey,
+			alias:   "by-pubkey",
+		},
+		{
+			name:    "Name",
+			aliases: aliases,
+			account: "Wallet/Account 1",
+			pubKey:  otherPubKey,
+			alias:   "by-name",
+		},
+		{
+			name:    "NameCase",
+			aliases: aliases,
+			account: "wallet/account 2",
+			pubKey:  otherPubKey,
+			alias:   "by-name-2",
+		},
+		{
+			name:    "Fallback",
+			aliases: aliases,
+			account: "Wallet/Account 3",
+			pubKey:  otherPubKey,
+			alias:   "Wallet/Account 3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.alias, test.aliases.alias(test.account, test.pubKey))
+		})
+	}
+}
+
+func TestAccountName(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, e2types.InitBLS())
+
+	wallet := setupTestWallet(ctx, t, 1)
+	for account := range wallet.Accounts(ctx) {
+		require.Equal(t, "Test wallet/Account 0", accountName(account))
+	}
+}
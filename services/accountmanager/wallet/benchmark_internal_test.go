@@ -0,0 +1,124 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/google/uuid"
+	e2types "github.com/wealdtech/go-eth2-types/v2"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// slowAccount is an account that takes time to unlock.
+type slowAccount struct {
+	id        uuid.UUID
+	name      string
+	pubKey    e2types.PublicKey
+	unlockDur time.Duration
+}
+
+func (a *slowAccount) ID() uuid.UUID                            { return a.id }
+func (a *slowAccount) Name() string                             { return a.name }
+func (a *slowAccount) PublicKey() e2types.PublicKey             { return a.pubKey }
+func (a *slowAccount) IsUnlocked(context.Context) (bool, error) { return true, nil }
+func (a *slowAccount) Lock(context.Context) error               { return nil }
+func (a *slowAccount) Unlock(_ context.Context, _ []byte) error {
+	time.Sleep(a.unlockDur)
+
+	return nil
+}
+
+// slowWallet is a wallet whose store takes time to provide each account.
+type slowWallet struct {
+	accounts []e2wtypes.Account
+	fetchDur time.Duration
+}
+
+func (w *slowWallet) ID() uuid.UUID { return uuid.Nil }
+func (w *slowWallet) Type() string  { return "slow" }
+func (w *slowWallet) Name() string  { return "Slow wallet" }
+func (w *slowWallet) Version() uint { return 1 }
+func (w *slowWallet) Accounts(ctx context.Context) <-chan e2wtypes.Account {
+	ch := make(chan e2wtypes.Account)
+	go func() {
+		defer close(ch)
+		for _, account := range w.accounts {
+			time.Sleep(w.fetchDur)
+			select {
+			case ch <- account:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+func newSlowWallet(b *testing.B, accounts int, fetchDur time.Duration, unlockDur time.Duration) *slowWallet {
+	b.Helper()
+
+	wallet := &slowWallet{
+		accounts: make([]e2wtypes.Account, 0, accounts),
+		fetchDur: fetchDur,
+	}
+	for i := 0; i < accounts; i++ {
+		key, err := e2types.GenerateBLSPrivateKey()
+		if err != nil {
+			b.Fatal(err)
+		}
+		wallet.accounts = append(wallet.accounts, &slowAccount{
+			id:        uuid.New(),
+			name:      fmt.Sprintf("Test account %d", i),
+			pubKey:    key.PublicKey(),
+			unlockDur: unlockDur,
+		})
+	}
+
+	return wallet
+}
+
+func BenchmarkFetchAccountsForWallet(b *testing.B) {
+	ctx := context.Background()
+	if err := e2types.InitBLS(); err != nil {
+		b.Fatal(err)
+	}
+
+	wallet := newSlowWallet(b, 64, time.Millisecond, 5*time.Millisecond)
+	verificationRegexes := []*regexp.Regexp{regexp.MustCompile("^Slow wallet/")}
+
+	for _, prefetch := range []int{0, 16, 64} {
+		b.Run(fmt.Sprintf("Prefetch%d", prefetch), func(b *testing.B) {
+			s := &Service{
+				processConcurrency: 8,
+				passphrases:        [][]byte{[]byte("pass")},
+				accountPrefetch:    prefetch,
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				accounts := make(map[phase0.BLSPubKey]e2wtypes.Account)
+				s.fetchAccountsForWallet(ctx, wallet, accounts, verificationRegexes, 0, nil)
+				if len(accounts) != len(wallet.accounts) {
+					b.Fatalf("expected %d accounts, obtained %d", len(wallet.accounts), len(accounts))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	nd "github.com/wealdtech/go-eth2-wallet-nd/v2"
+	scratch "github.com/wealdtech/go-eth2-wallet-store-scratch"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// blockedStore is a store that does not retrieve wallets until released.
+type blockedStore struct {
+	e2wtypes.Store
+	release chan struct{}
+}
+
+func (s *blockedStore) RetrieveWallet(walletName string) ([]byte, error) {
+	if s.release != nil {
+		<-s.release
+	}
+	return s.Store.RetrieveWallet(walletName)
+}
+
+func TestFindWallet(t *testing.T) {
+	ctx := context.Background()
+
+	slow := &blockedStore{
+		Store: scratch.New(),
+	}
+	slowWallet, err := nd.CreateWallet(ctx, "Test wallet", slow, keystorev4.New())
+	require.NoError(t, err)
+	// The slow store is blocked until the test completes, so the wallet can
+	// only be found if the fast store is not waiting on it.
+	slow.release = make(chan struct{})
+	t.Cleanup(func() { close(slow.release) })
+	fast := scratch.New()
+	fastWallet, err := nd.CreateWallet(ctx, "Test wallet", fast, keystorev4.New())
+	require.NoError(t, err)
+	require.NotEqual(t, slowWallet.ID(), fastWallet.ID())
+
+	tests := []struct {
+		name   string
+		stores []e2wtypes.Store
+		wallet string
+		id     string
+	}{
+		{
+			name:   "NoStores",
+			wallet: "Test wallet",
+		},
+		{
+			name:   "FastWins",
+			stores: []e2wtypes.Store{slow, fast},
+			wallet: "Test wallet",
+			id:     fastWallet.ID().String(),
+		},
+		{
+			name:   "NotFound",
+			stores: []e2wtypes.Store{fast, scratch.New()},
+			wallet: "Unknown wallet",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				stores: test.stores,
+			}
+			wallet := s.findWallet(ctx, test.wallet)
+			if test.id == "" {
+				require.Nil(t, wallet)
+				return
+			}
+			require.NotNil(t, wallet)
+			require.Equal(t, test.id, wallet.ID().String())
+		})
+	}
+}
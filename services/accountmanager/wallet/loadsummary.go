@@ -0,0 +1,61 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"sort"
+	"sync/atomic"
+)
+
+// walletLoadSummary summarises the outcome of loading the accounts in a wallet.
+type walletLoadSummary struct {
+	wallet string
+	// total is the number of accounts read from the wallet.
+	total int
+	// filteredOut is the number of accounts that did not match the account paths.
+	filteredOut int
+	// deferred is the number of accounts for inactive validators whose
+	// unlocking has been deferred.
+	deferred int
+	// loaded is the number of accounts unlocked.
+	loaded atomic.Int64
+	// unlockFailed is the number of accounts that could not be unlocked.
+	unlockFailed atomic.Int64
+}
+
+// reportWalletLoadSummaries logs and monitors the load summaries for wallets.
+func (s *Service) reportWalletLoadSummaries(summaries []*walletLoadSummary) {
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].wallet < summaries[j].wallet
+	})
+
+	for _, summary := range summaries {
+		loaded := summary.loaded.Load()
+		unlockFailed := summary.unlockFailed.Load()
+		log.Info().
+			Str("wallet", summary.wallet).
+			Int("total", summary.total).
+			Int64("loaded", loaded).
+			Int("deferred", summary.deferred).
+			Int64("unlock_failed", unlockFailed).
+			Int("filtered_out", summary.filteredOut).
+			Msg("Loaded accounts from wallet")
+
+		s.monitor.WalletAccounts(summary.wallet, "total", uint64(summary.total))
+		s.monitor.WalletAccounts(summary.wallet, "loaded", uint64(loaded))
+		s.monitor.WalletAccounts(summary.wallet, "deferred", uint64(summary.deferred))
+		s.monitor.WalletAccounts(summary.wallet, "unlock_failed", uint64(unlockFailed))
+		s.monitor.WalletAccounts(summary.wallet, "filtered_out", uint64(summary.filteredOut))
+	}
+}
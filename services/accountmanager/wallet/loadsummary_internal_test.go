@@ -0,0 +1,114 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/stretchr/testify/require"
+	e2types "github.com/wealdtech/go-eth2-types/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	nd "github.com/wealdtech/go-eth2-wallet-nd/v2"
+	scratch "github.com/wealdtech/go-eth2-wallet-store-scratch"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// walletAccountsMonitor records the wallet accounts reported.
+type walletAccountsMonitor struct {
+	*nullmetrics.Service
+	counts map[string]uint64
+}
+
+func (m *walletAccountsMonitor) WalletAccounts(wallet string, state string, count uint64) {
+	m.counts[fmt.Sprintf("%s/%s", wallet, state)] = count
+}
+
+func TestWalletLoadSummary(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, e2types.InitBLS())
+
+	// Accounts 0-3 can be unlocked, accounts 4-5 cannot.
+	wallet, err := nd.CreateWallet(ctx, "Test wallet", scratch.New(), keystorev4.New())
+	require.NoError(t, err)
+	require.NoError(t, wallet.(e2wtypes.WalletLocker).Unlock(ctx, nil))
+	for i := 0; i < 6; i++ {
+		passphrase := []byte("pass")
+		if i >= 4 {
+			passphrase = []byte("unknown")
+		}
+		key, err := e2types.GenerateBLSPrivateKey()
+		require.NoError(t, err)
+		_, err = wallet.(e2wtypes.WalletAccountImporter).ImportAccount(ctx,
+			fmt.Sprintf("Account %d", i),
+			key.Marshal(),
+			passphrase,
+		)
+		require.NoError(t, err)
+	}
+
+	// Account 0 is filtered out, and account 1 is for an inactive validator.
+	verificationRegexes := []*regexp.Regexp{regexp.MustCompile("^Test wallet/Account [1-5]$")}
+	activePubKeys := make(map[phase0.BLSPubKey]struct{})
+	for account := range wallet.Accounts(ctx) {
+		if account.Name() != "Account 1" {
+			activePubKeys[accountPubKey(account)] = struct{}{}
+		}
+	}
+
+	capture := logger.NewModuleLogCapture(t, &log)
+	monitor := &walletAccountsMonitor{
+		Service: nullmetrics.New(ctx),
+		counts:  make(map[string]uint64),
+	}
+	s := &Service{
+		monitor:            monitor,
+		processConcurrency: 2,
+		passphrases:        [][]byte{[]byte("pass")},
+	}
+	accounts := make(map[phase0.BLSPubKey]e2wtypes.Account)
+	deferred, summary := s.fetchAccountsForWallet(ctx, wallet, accounts, verificationRegexes, 0, activePubKeys)
+	require.Len(t, deferred, 1)
+	require.Len(t, accounts, 2)
+
+	require.Equal(t, "Test wallet", summary.wallet)
+	require.Equal(t, 6, summary.total)
+	require.Equal(t, 1, summary.filteredOut)
+	require.Equal(t, 1, summary.deferred)
+	require.Equal(t, int64(2), summary.loaded.Load())
+	require.Equal(t, int64(2), summary.unlockFailed.Load())
+
+	s.reportWalletLoadSummaries([]*walletLoadSummary{summary})
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"message":       "Loaded accounts from wallet",
+		"wallet":        "Test wallet",
+		"total":         uint64(6),
+		"loaded":        uint64(2),
+		"deferred":      uint64(1),
+		"unlock_failed": uint64(2),
+		"filtered_out":  uint64(1),
+	}))
+	require.Equal(t, map[string]uint64{
+		"Test wallet/total":         6,
+		"Test wallet/loaded":        2,
+		"Test wallet/deferred":      1,
+		"Test wallet/unlock_failed": 2,
+		"Test wallet/filtered_out":  1,
+	}, monitor.counts)
+}
@@ -14,7 +14,10 @@
 package wallet
 
 import (
+	"time"
+
 	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/services/chaintime"
 	"github.com/attestantio/vouch/services/metrics"
 	"github.com/attestantio/vouch/services/validatorsmanager"
@@ -23,17 +26,26 @@ import (
 )
 
 type parameters struct {
-	logLevel               zerolog.Level
-	monitor                metrics.AccountManagerMonitor
-	processConcurrency     int64
-	locations              []string
-	accountPaths           []string
-	passphrases            [][]byte
-	validatorsManager      validatorsmanager.Service
-	slotsPerEpochProvider  eth2client.SlotsPerEpochProvider
-	domainProvider         eth2client.DomainProvider
-	farFutureEpochProvider eth2client.FarFutureEpochProvider
-	currentEpochProvider   chaintime.Service
+	logLevel                      zerolog.Level
+	monitor                       metrics.AccountManagerMonitor
+	processConcurrency            int64
+	locations                     []string
+	accountPaths                  []string
+	passphrases                   [][]byte
+	validatorsManager             validatorsmanager.Service
+	slotsPerEpochProvider         eth2client.SlotsPerEpochProvider
+	domainProvider                eth2client.DomainProvider
+	farFutureEpochProvider        eth2client.FarFutureEpochProvider
+	currentEpochProvider          chaintime.Service
+	maxValidatorStateAge          time.Duration
+	refuseStaleValidators         bool
+	allowUnexpectedFarFutureEpoch bool
+	maxAccountsPerWallet          int
+	maxAccounts                   int
+	activeIndices                 []phase0.ValidatorIndex
+	validatorsProvider            eth2client.ValidatorsProvider
+	accountPrefetch               int
+	accountAliases                map[string]string
 }
 
 // Parameter is the interface for service parameters.
@@ -124,10 +136,82 @@ func WithCurrentEpochProvider(provider chaintime.Service) Parameter {
 	})
 }
 
+// WithMaxAccountsPerWallet sets the maximum number of accounts to load from each wallet.
+// A value of 0 means no limit.
+func WithMaxAccountsPerWallet(maxAccounts int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxAccountsPerWallet = maxAccounts
+	})
+}
+
+// WithMaxAccounts sets the maximum number of accounts to load across all wallets.
+// A value of 0 means no limit.
+func WithMaxAccounts(maxAccounts int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxAccounts = maxAccounts
+	})
+}
+
+// WithActiveIndices sets the indices of validators believed to be active.
+// If supplied, only accounts for these validators are unlocked at startup,
+// with the remaining accounts unlocked in the background.
+func WithActiveIndices(indices []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.activeIndices = indices
+	})
+}
+
+// WithValidatorsProvider sets the validators provider, used to map active indices to public keys.
+func WithValidatorsProvider(provider eth2client.ValidatorsProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorsProvider = provider
+	})
+}
+
+// WithMaxValidatorStateAge sets the maximum age of validator state before it is
+// considered stale.  0 disables the check.
+func WithMaxValidatorStateAge(age time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxValidatorStateAge = age
+	})
+}
+
+// WithRefuseStaleValidators refuses to return validating accounts if the
+// validator state is stale.
+func WithRefuseStaleValidators(refuse bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.refuseStaleValidators = refuse
+	})
+}
+
+// WithAccountPrefetch sets the number of accounts that can be read ahead from a wallet
+// whilst earlier accounts are being unlocked.
+func WithAccountPrefetch(prefetch int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.accountPrefetch = prefetch
+	})
+}
+
+// WithAccountAliases sets friendly aliases for accounts, keyed by public key or wallet/account name.
+func WithAccountAliases(aliases map[string]string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.accountAliases = aliases
+	})
+}
+
+// WithAllowUnexpectedFarFutureEpoch allows the service to start with a far
+// future epoch other than that defined by the specification, with a warning.
+func WithAllowUnexpectedFarFutureEpoch(allow bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.allowUnexpectedFarFutureEpoch = allow
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
+		logLevel:        zerolog.GlobalLevel(),
+		accountPrefetch: 64,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -162,6 +246,21 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.currentEpochProvider == nil {
 		return nil, errors.New("no current epoch provider specified")
 	}
+	if parameters.maxAccountsPerWallet < 0 {
+		return nil, errors.New("max accounts per wallet cannot be negative")
+	}
+	if parameters.maxAccounts < 0 {
+		return nil, errors.New("max accounts cannot be negative")
+	}
+	if len(parameters.activeIndices) > 0 && parameters.validatorsProvider == nil {
+		return nil, errors.New("no validators provider specified")
+	}
+	if parameters.maxValidatorStateAge < 0 {
+		return nil, errors.New("max validator state age cannot be negative")
+	}
+	if parameters.accountPrefetch < 0 {
+		return nil, errors.New("account prefetch cannot be negative")
+	}
 
 	return &parameters, nil
 }
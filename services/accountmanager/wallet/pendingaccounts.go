@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/accountmanager"
+	"github.com/attestantio/vouch/services/accountmanager/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PendingAccountsForEpoch obtains the accounts whose validators are pending
+// activation at a given epoch, in activation queue order.
+func (s *Service) PendingAccountsForEpoch(ctx context.Context, epoch phase0.Epoch) ([]*accountmanager.PendingAccount, error) {
+	ctx, span := otel.Tracer("attestantio.vouch.services.accountmanager.wallet").Start(ctx, "PendingAccountsForEpoch", trace.WithAttributes(
+		attribute.Int64("epoch", int64(epoch)),
+	))
+	defer span.End()
+
+	if err := s.checkValidatorStateAge(); err != nil {
+		return nil, err
+	}
+
+	accounts := s.knownAccounts()
+	pubKeys := make([]phase0.BLSPubKey, 0, len(accounts))
+	for pubKey := range accounts {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	validators := s.validatorsManager.ValidatorsByPubKey(ctx, pubKeys)
+
+	return utils.PendingAccounts(accounts, validators, epoch, s.farFutureEpoch), nil
+}
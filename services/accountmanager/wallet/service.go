@@ -17,12 +17,17 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	eth2client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/accountmanager"
+	"github.com/attestantio/vouch/services/accountmanager/utils"
 	"github.com/attestantio/vouch/services/chaintime"
 	"github.com/attestantio/vouch/services/metrics"
 	"github.com/attestantio/vouch/services/validatorsmanager"
@@ -53,6 +58,23 @@ type Service struct {
 	domainProvider       eth2client.DomainProvider
 	farFutureEpoch       phase0.Epoch
 	currentEpochProvider chaintime.Service
+	// lastValidatorsRefresh is the time of the last successful refresh of
+	// validator state, in Unix nanoseconds.
+	lastValidatorsRefresh atomic.Int64
+	maxValidatorStateAge  time.Duration
+	refuseStaleValidators bool
+	maxAccountsPerWallet  int
+	maxAccounts           int
+	accountPrefetch       int
+	aliases               *accountAliases
+	// slashedAccounts alerts when accounts have been slashed.
+	slashedAccounts *utils.SlashedAccounts
+}
+
+// walletAccount is an account along with its full name.
+type walletAccount struct {
+	name    string
+	account e2wtypes.Account
 }
 
 // module-wide log.
@@ -93,28 +115,109 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain far future epoch")
 	}
+	if err := utils.CheckFarFutureEpoch(log, farFutureEpoch, parameters.allowUnexpectedFarFutureEpoch); err != nil {
+		return nil, err
+	}
+
+	aliases, err := newAccountAliases(parameters.accountAliases)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid account aliases")
+	}
 
 	s := &Service{
-		monitor:              parameters.monitor,
-		processConcurrency:   parameters.processConcurrency,
-		stores:               stores,
-		accountPaths:         parameters.accountPaths,
-		passphrases:          parameters.passphrases,
-		validatorsManager:    parameters.validatorsManager,
-		slotsPerEpoch:        phase0.Slot(slotsPerEpoch),
-		domainProvider:       parameters.domainProvider,
-		farFutureEpoch:       farFutureEpoch,
-		currentEpochProvider: parameters.currentEpochProvider,
-	}
-
-	s.refreshAccounts(ctx)
+		monitor:               parameters.monitor,
+		processConcurrency:    parameters.processConcurrency,
+		stores:                stores,
+		accountPaths:          parameters.accountPaths,
+		passphrases:           parameters.passphrases,
+		validatorsManager:     parameters.validatorsManager,
+		slotsPerEpoch:         phase0.Slot(slotsPerEpoch),
+		domainProvider:        parameters.domainProvider,
+		farFutureEpoch:        farFutureEpoch,
+		currentEpochProvider:  parameters.currentEpochProvider,
+		maxValidatorStateAge:  parameters.maxValidatorStateAge,
+		refuseStaleValidators: parameters.refuseStaleValidators,
+		maxAccountsPerWallet:  parameters.maxAccountsPerWallet,
+		maxAccounts:           parameters.maxAccounts,
+		aliases:               aliases,
+		accountPrefetch:       parameters.accountPrefetch,
+		slashedAccounts:       utils.NewSlashedAccounts(parameters.monitor),
+	}
+
+	var activePubKeys map[phase0.BLSPubKey]struct{}
+	if len(parameters.activeIndices) > 0 {
+		activePubKeys, err = fetchActivePubKeys(ctx, parameters.validatorsProvider, parameters.activeIndices)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to obtain public keys for active indices; loading all accounts")
+			activePubKeys = nil
+		}
+	}
+
+	deferred, _ := s.refreshAccounts(ctx, activePubKeys)
 	if err := s.refreshValidators(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to fetch validator states")
 	}
+	if len(deferred) > 0 {
+		log.Info().Int("accounts", len(deferred)).Msg("Unlocking accounts for inactive validators in the background")
+		go s.loadDeferredAccounts(ctx, deferred)
+	}
 
 	return s, nil
 }
 
+// fetchActivePubKeys fetches the public keys of the validators with the given indices.
+func fetchActivePubKeys(ctx context.Context,
+	validatorsProvider eth2client.ValidatorsProvider,
+	indices []phase0.ValidatorIndex,
+) (
+	map[phase0.BLSPubKey]struct{},
+	error,
+) {
+	validators, err := validatorsProvider.Validators(ctx, "head", indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain validators")
+	}
+	if len(validators) == 0 {
+		return nil, errors.New("no validators found for active indices")
+	}
+
+	pubKeys := make(map[phase0.BLSPubKey]struct{}, len(validators))
+	for _, validator := range validators {
+		if validator == nil || validator.Validator == nil {
+			continue
+		}
+		pubKeys[validator.Validator.PublicKey] = struct{}{}
+	}
+	log.Trace().Int("active_pubkeys", len(pubKeys)).Msg("Obtained public keys for active indices")
+
+	return pubKeys, nil
+}
+
+// loadDeferredAccounts unlocks accounts that were deferred at startup and adds them to the known accounts.
+func (s *Service) loadDeferredAccounts(ctx context.Context, deferred []*walletAccount) {
+	ctx, span := otel.Tracer("attestantio.vouch.services.accountmanager.wallet").Start(ctx, "loadDeferredAccounts")
+	defer span.End()
+
+	accounts := make(map[phase0.BLSPubKey]e2wtypes.Account)
+	s.unlockAccounts(ctx, deferred, accounts)
+
+	s.mutex.Lock()
+	merged := make(map[phase0.BLSPubKey]e2wtypes.Account, len(s.accounts)+len(accounts))
+	for pubKey, account := range s.accounts {
+		merged[pubKey] = account
+	}
+	for pubKey, account := range accounts {
+		merged[pubKey] = account
+	}
+	s.accounts = merged
+	s.mutex.Unlock()
+
+	if err := s.refreshValidators(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to refresh validators after unlocking deferred accounts")
+	}
+	log.Info().Int("accounts", len(accounts)).Msg("Unlocked deferred accounts")
+}
+
 // Refresh refreshes the accounts from local store, and account validator state from
 // the validators provider.
 // This is a relatively expensive operation, so should not be run in the validating path.
@@ -122,14 +225,21 @@ func (s *Service) Refresh(ctx context.Context) {
 	ctx, span := otel.Tracer("attestantio.vouch.services.accountmanager.wallet").Start(ctx, "Refresh")
 	defer span.End()
 
-	s.refreshAccounts(ctx)
+	s.refreshAccounts(ctx, nil)
 	if err := s.refreshValidators(ctx); err != nil {
 		log.Error().Err(err).Msg("Failed to refresh validators")
 	}
 }
 
-// refreshAccounts refreshes the accounts from local store.
-func (s *Service) refreshAccounts(ctx context.Context) {
+// refreshAccounts refreshes the accounts from local store, returning a load summary for each wallet.
+// If active public keys are supplied then only matching accounts are unlocked, and the
+// remaining accounts are returned for later unlocking.
+func (s *Service) refreshAccounts(ctx context.Context,
+	activePubKeys map[phase0.BLSPubKey]struct{},
+) (
+	[]*walletAccount,
+	[]*walletLoadSummary,
+) {
 	ctx, span := otel.Tracer("attestantio.vouch.services.accountmanager.wallet").Start(ctx, "refreshAccounts")
 	defer span.End()
 
@@ -138,22 +248,12 @@ func (s *Service) refreshAccounts(ctx context.Context) {
 	for _, path := range s.accountPaths {
 		pathBits := strings.Split(path, "/")
 
-		// Try each store in turn.
-		found := false
-		for _, store := range s.stores {
-			log.Trace().Str("store", store.Name()).Str("wallet", pathBits[0]).Msg("Checking for wallet in store")
-			wallet, err := e2wallet.OpenWallet(pathBits[0], e2wallet.WithStore(store))
-			if err == nil {
-				log.Trace().Str("store", store.Name()).Str("wallet", pathBits[0]).Msg("Found wallet in store")
-				wallets[wallet.Name()] = wallet
-				found = true
-				break
-			}
-			log.Trace().Str("store", store.Name()).Str("wallet", pathBits[0]).Err(err).Msg("Failed to find wallet in store")
-		}
-		if !found {
+		wallet := s.findWallet(ctx, pathBits[0])
+		if wallet == nil {
 			log.Warn().Str("wallet", pathBits[0]).Msg("Failed to find wallet in any store")
+			continue
 		}
+		wallets[wallet.Name()] = wallet
 	}
 	if e := log.Trace(); e.Enabled() {
 		walletNames := make([]string, 0, len(wallets))
@@ -166,14 +266,71 @@ func (s *Service) refreshAccounts(ctx context.Context) {
 	verificationRegexes := accountPathsToVerificationRegexes(s.accountPaths)
 	// Fetch accounts for each wallet.
 	accounts := make(map[phase0.BLSPubKey]e2wtypes.Account)
+	deferred := make([]*walletAccount, 0)
+	summaries := make([]*walletLoadSummary, 0, len(wallets))
 	for _, wallet := range wallets {
-		s.fetchAccountsForWallet(ctx, wallet, accounts, verificationRegexes)
+		limit := s.maxAccountsPerWallet
+		if s.maxAccounts > 0 {
+			remaining := s.maxAccounts - len(accounts) - len(deferred)
+			if remaining <= 0 {
+				log.Warn().Int("max_accounts", s.maxAccounts).Str("wallet", wallet.Name()).Msg("Maximum number of accounts loaded; not loading accounts from wallet")
+				continue
+			}
+			if limit == 0 || remaining < limit {
+				limit = remaining
+			}
+		}
+		walletDeferred, summary := s.fetchAccountsForWallet(ctx, wallet, accounts, verificationRegexes, limit, activePubKeys)
+		deferred = append(deferred, walletDeferred...)
+		summaries = append(summaries, summary)
 	}
-	log.Trace().Int("accounts", len(accounts)).Msg("Obtained accounts")
+	log.Trace().Int("accounts", len(accounts)).Int("deferred", len(deferred)).Msg("Obtained accounts")
 
 	s.mutex.Lock()
 	s.accounts = accounts
 	s.mutex.Unlock()
+
+	s.reportWalletLoadSummaries(summaries)
+
+	return deferred, summaries
+}
+
+// findWallet looks for the named wallet in all stores concurrently, returning
+// the first that is found.  Lookups in other stores are abandoned once a wallet
+// has been found, so a slow store does not delay wallets found in faster stores.
+// Returns nil if the wallet is not found in any store.
+func (s *Service) findWallet(ctx context.Context, name string) e2wtypes.Wallet {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered so that abandoned lookups can complete without blocking.
+	walletCh := make(chan e2wtypes.Wallet, len(s.stores))
+	for _, store := range s.stores {
+		go func(store e2wtypes.Store) {
+			log.Trace().Str("store", store.Name()).Str("wallet", name).Msg("Checking for wallet in store")
+			wallet, err := e2wallet.OpenWallet(name, e2wallet.WithStore(store))
+			if err != nil {
+				log.Trace().Str("store", store.Name()).Str("wallet", name).Err(err).Msg("Failed to find wallet in store")
+				walletCh <- nil
+				return
+			}
+			log.Trace().Str("store", store.Name()).Str("wallet", name).Msg("Found wallet in store")
+			walletCh <- wallet
+		}(store)
+	}
+
+	for range s.stores {
+		select {
+		case wallet := <-walletCh:
+			if wallet != nil {
+				return wallet
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	return nil
 }
 
 // refreshValidators refreshes the validator information for our known accounts.
@@ -181,13 +338,16 @@ func (s *Service) refreshValidators(ctx context.Context) error {
 	ctx, span := otel.Tracer("attestantio.vouch.services.accountmanager.wallet").Start(ctx, "refreshValidators")
 	defer span.End()
 
-	accountPubKeys := make([]phase0.BLSPubKey, 0, len(s.accounts))
-	for pubKey := range s.accounts {
+	accounts := s.knownAccounts()
+	accountPubKeys := make([]phase0.BLSPubKey, 0, len(accounts))
+	for pubKey := range accounts {
 		accountPubKeys = append(accountPubKeys, pubKey)
 	}
 	if err := s.validatorsManager.RefreshValidatorsFromBeaconNode(ctx, accountPubKeys); err != nil {
 		return errors.Wrap(err, "failed to refresh validators")
 	}
+	s.lastValidatorsRefresh.Store(time.Now().UnixNano())
+
 	return nil
 }
 
@@ -198,6 +358,10 @@ func (s *Service) ValidatingAccountsForEpoch(ctx context.Context, epoch phase0.E
 	))
 	defer span.End()
 
+	if err := s.checkValidatorStateAge(); err != nil {
+		return nil, err
+	}
+
 	// stateCount is used to update metrics.
 	stateCount := map[api.ValidatorState]uint64{
 		api.ValidatorStateUnknown:            0,
@@ -212,9 +376,10 @@ func (s *Service) ValidatingAccountsForEpoch(ctx context.Context, epoch phase0.E
 		api.ValidatorStateWithdrawalDone:     0,
 	}
 
+	accounts := s.knownAccounts()
 	validatingAccounts := make(map[phase0.ValidatorIndex]e2wtypes.Account)
-	pubKeys := make([]phase0.BLSPubKey, 0, len(s.accounts))
-	for pubKey := range s.accounts {
+	pubKeys := make([]phase0.BLSPubKey, 0, len(accounts))
+	for pubKey := range accounts {
 		pubKeys = append(pubKeys, pubKey)
 	}
 
@@ -222,10 +387,15 @@ func (s *Service) ValidatingAccountsForEpoch(ctx context.Context, epoch phase0.E
 	for index, validator := range validators {
 		state := api.ValidatorToState(validator, epoch, s.farFutureEpoch)
 		stateCount[state]++
+		if account, exists := accounts[validator.PublicKey]; exists {
+			s.checkSlashed(index, validator, state, account)
+		}
 		if state == api.ValidatorStateActiveOngoing || state == api.ValidatorStateActiveExiting {
-			account := s.accounts[validator.PublicKey]
+			account := accounts[validator.PublicKey]
+			name := accountName(account)
 			log.Trace().
-				Str("name", account.Name()).
+				Str("name", name).
+				Str("alias", s.aliases.alias(name, validator.PublicKey)).
 				Str("public_key", fmt.Sprintf("%x", account.PublicKey().Marshal())).
 				Uint64("index", uint64(index)).
 				Str("state", state.String()).
@@ -236,7 +406,7 @@ func (s *Service) ValidatingAccountsForEpoch(ctx context.Context, epoch phase0.E
 
 	// Update metrics if this is the current epoch.
 	if epoch == s.currentEpochProvider.CurrentEpoch() {
-		stateCount[api.ValidatorStateUnknown] += uint64(len(s.accounts) - len(validators))
+		stateCount[api.ValidatorStateUnknown] += uint64(len(accounts) - len(validators))
 		for state, count := range stateCount {
 			s.monitor.Accounts(strings.ToLower(state.String()), count)
 		}
@@ -252,9 +422,51 @@ func (s *Service) ValidatingAccountsForEpochByIndex(ctx context.Context, epoch p
 	))
 	defer span.End()
 
-	validatingAccounts := make(map[phase0.ValidatorIndex]e2wtypes.Account)
-	pubKeys := make([]phase0.BLSPubKey, 0, len(s.accounts))
-	for pubKey := range s.accounts {
+	if err := s.checkValidatorStateAge(); err != nil {
+		return nil, err
+	}
+
+	validatingAccounts, _ := s.validatingAccountsForEpochByIndex(ctx, epoch, indices)
+
+	return validatingAccounts, nil
+}
+
+// ValidatingAccountsForEpochByIndexWithMissing obtains the specified validating accounts for a given epoch,
+// along with the requested indices that are unknown or inactive.
+func (s *Service) ValidatingAccountsForEpochByIndexWithMissing(ctx context.Context,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	map[phase0.ValidatorIndex]e2wtypes.Account,
+	*accountmanager.MissingIndices,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.vouch.services.accountmanager.wallet").Start(ctx, "ValidatingAccountsForEpochByIndexWithMissing", trace.WithAttributes(
+		attribute.Int64("epoch", int64(epoch)),
+	))
+	defer span.End()
+
+	if err := s.checkValidatorStateAge(); err != nil {
+		return nil, nil, err
+	}
+
+	validatingAccounts, missing := s.validatingAccountsForEpochByIndex(ctx, epoch, indices)
+
+	return validatingAccounts, missing, nil
+}
+
+// validatingAccountsForEpochByIndex obtains the specified validating accounts for a given epoch,
+// along with the requested indices that are unknown or inactive.
+func (s *Service) validatingAccountsForEpochByIndex(ctx context.Context,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	map[phase0.ValidatorIndex]e2wtypes.Account,
+	*accountmanager.MissingIndices,
+) {
+	accounts := s.knownAccounts()
+	pubKeys := make([]phase0.BLSPubKey, 0, len(accounts))
+	for pubKey := range accounts {
 		pubKeys = append(pubKeys, pubKey)
 	}
 
@@ -262,18 +474,41 @@ func (s *Service) ValidatingAccountsForEpochByIndex(ctx context.Context, epoch p
 	for _, index := range indices {
 		indexPresenceMap[index] = true
 	}
+	missing := &accountmanager.MissingIndices{
+		Unknown:  make([]phase0.ValidatorIndex, 0),
+		Inactive: make([]phase0.ValidatorIndex, 0),
+	}
 	validators := s.validatorsManager.ValidatorsByPubKey(ctx, pubKeys)
+	validatingAccounts := make(map[phase0.ValidatorIndex]e2wtypes.Account)
 	for index, validator := range validators {
 		if _, present := indexPresenceMap[index]; !present {
 			continue
 		}
+		// Clear the flag to show that the index is known.
+		indexPresenceMap[index] = false
 		state := api.ValidatorToState(validator, epoch, s.farFutureEpoch)
 		if state == api.ValidatorStateActiveOngoing || state == api.ValidatorStateActiveExiting {
-			validatingAccounts[index] = s.accounts[validator.PublicKey]
+			validatingAccounts[index] = accounts[validator.PublicKey]
+		} else {
+			missing.Inactive = append(missing.Inactive, index)
+		}
+	}
+	for index, unknown := range indexPresenceMap {
+		if unknown {
+			missing.Unknown = append(missing.Unknown, index)
 		}
 	}
+	sortIndices(missing.Unknown)
+	sortIndices(missing.Inactive)
 
-	return validatingAccounts, nil
+	return validatingAccounts, missing
+}
+
+// sortIndices sorts validator indices in ascending order.
+func sortIndices(indices []phase0.ValidatorIndex) {
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
 }
 
 // accountPathsToVerificationRegexes turns account paths in to regexes to allow verification.
@@ -306,68 +541,172 @@ func accountPathsToVerificationRegexes(paths []string) []*regexp.Regexp {
 	return regexes
 }
 
-func (s *Service) fetchAccountsForWallet(ctx context.Context, wallet e2wtypes.Wallet, accounts map[phase0.BLSPubKey]e2wtypes.Account, verificationRegexes []*regexp.Regexp) {
+// fetchAccountsForWallet fetches and unlocks the accounts in a wallet that match the verification regexes.
+// If limit is greater than 0 then no more than that number of accounts will be loaded from the wallet.
+// If active public keys are supplied then only matching accounts are unlocked, and the remaining
+// accounts are returned for later unlocking.
+// A summary of the outcome of loading the wallet is also returned.
+func (s *Service) fetchAccountsForWallet(ctx context.Context,
+	wallet e2wtypes.Wallet,
+	accounts map[phase0.BLSPubKey]e2wtypes.Account,
+	verificationRegexes []*regexp.Regexp,
+	limit int,
+	activePubKeys map[phase0.BLSPubKey]struct{},
+) (
+	[]*walletAccount,
+	*walletLoadSummary,
+) {
 	ctx, span := otel.Tracer("attestantio.vouch.services.accountmanager.wallet").Start(ctx, "fetchAccountsForWallet", trace.WithAttributes(
 		attribute.String("wallet", wallet.Name()),
 	))
 	defer span.End()
 
+	// Read accounts from the wallet in to a bounded buffer, so that a slow
+	// store does not leave the unlock workers idle.
+	scanCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	prefetched := make(chan e2wtypes.Account, s.accountPrefetch)
+	go func() {
+		defer close(prefetched)
+		for account := range wallet.Accounts(scanCtx) {
+			select {
+			case prefetched <- account:
+			case <-scanCtx.Done():
+				return
+			}
+		}
+	}()
+
+	var mu sync.Mutex
+	sem := semaphore.NewWeighted(s.processConcurrency)
+	var wg sync.WaitGroup
+	selected := 0
+	deferred := make([]*walletAccount, 0)
+	summary := &walletLoadSummary{wallet: wallet.Name()}
+	for account := range prefetched {
+		summary.total++
+		// Ensure the name matches one of our account paths.
+		name := fmt.Sprintf("%s/%s", wallet.Name(), account.Name())
+		verified := false
+		for _, verificationRegex := range verificationRegexes {
+			if verificationRegex.Match([]byte(name)) {
+				verified = true
+				break
+			}
+		}
+		if !verified {
+			log.Debug().Str("account", name).Msg("Received unwanted account from server; ignoring")
+			summary.filteredOut++
+			continue
+		}
+		if limit > 0 && selected+len(deferred) >= limit {
+			log.Warn().Str("wallet", wallet.Name()).Int("limit", limit).Msg("Maximum number of accounts loaded from wallet; not loading further accounts.  Please check that Vouch is using the intended wallet")
+			break
+		}
+
+		if activePubKeys != nil {
+			if _, exists := activePubKeys[accountPubKey(account)]; !exists {
+				log.Trace().Str("account", name).Msg("Account not for an active validator; deferring")
+				deferred = append(deferred, &walletAccount{name: name, account: account})
+				continue
+			}
+		}
+		selected++
+
+		// Unlock the account whilst further accounts are read.
+		if err := sem.Acquire(ctx, 1); err != nil {
+			log.Error().Err(err).Msg("Failed to acquire semaphore")
+			break
+		}
+		wg.Add(1)
+		go func(name string, account e2wtypes.Account) {
+			defer wg.Done()
+			defer sem.Release(1)
+			if s.unlockAccount(ctx, name, account, accounts, &mu) {
+				summary.loaded.Add(1)
+			} else {
+				summary.unlockFailed.Add(1)
+			}
+		}(name, account)
+	}
+	wg.Wait()
+	summary.deferred = len(deferred)
+
+	return deferred, summary
+}
+
+// unlockAccounts unlocks the supplied accounts, adding those successfully unlocked to the accounts map.
+func (s *Service) unlockAccounts(ctx context.Context,
+	walletAccounts []*walletAccount,
+	accounts map[phase0.BLSPubKey]e2wtypes.Account,
+) {
 	var mu sync.Mutex
 	sem := semaphore.NewWeighted(s.processConcurrency)
 	var wg sync.WaitGroup
-	for account := range wallet.Accounts(ctx) {
+	for _, walletAccount := range walletAccounts {
 		wg.Add(1)
-		go func(ctx context.Context, sem *semaphore.Weighted, wg *sync.WaitGroup, wallet e2wtypes.Wallet, account e2wtypes.Account, accounts map[phase0.BLSPubKey]e2wtypes.Account, mu *sync.Mutex) {
+		go func(ctx context.Context, sem *semaphore.Weighted, wg *sync.WaitGroup, name string, account e2wtypes.Account, accounts map[phase0.BLSPubKey]e2wtypes.Account, mu *sync.Mutex) {
 			defer wg.Done()
 			if err := sem.Acquire(ctx, 1); err != nil {
 				log.Error().Err(err).Msg("Failed to acquire semaphore")
 				return
 			}
 			defer sem.Release(1)
-			// Ensure the name matches one of our account paths.
-			name := fmt.Sprintf("%s/%s", wallet.Name(), account.Name())
-			verified := false
-			for _, verificationRegex := range verificationRegexes {
-				if verificationRegex.Match([]byte(name)) {
-					verified = true
-					break
-				}
-			}
-			if !verified {
-				log.Debug().Str("account", name).Msg("Received unwanted account from server; ignoring")
-				return
-			}
+			s.unlockAccount(ctx, name, account, accounts, mu)
+		}(ctx, sem, &wg, walletAccount.name, walletAccount.account, accounts, &mu)
+	}
+	wg.Wait()
+}
 
-			var pubKey []byte
-			if provider, isProvider := account.(e2wtypes.AccountCompositePublicKeyProvider); isProvider {
-				pubKey = provider.CompositePublicKey().Marshal()
-			} else {
-				pubKey = account.PublicKey().Marshal()
+// unlockAccount unlocks the supplied account, adding it to the accounts map if successful.
+// It returns true if the account was unlocked.
+func (s *Service) unlockAccount(ctx context.Context,
+	name string,
+	account e2wtypes.Account,
+	accounts map[phase0.BLSPubKey]e2wtypes.Account,
+	mu *sync.Mutex,
+) bool {
+	// Ensure we can unlock the account with a known passphrase.
+	unlocked := false
+	if unlocker, isUnlocker := account.(e2wtypes.AccountLocker); isUnlocker {
+		for _, passphrase := range s.passphrases {
+			if err := unlocker.Unlock(ctx, passphrase); err == nil {
+				unlocked = true
+				break
 			}
+		}
+	}
+	alias := s.aliases.alias(name, accountPubKey(account))
+	if !unlocked {
+		log.Warn().Str("account", name).Str("alias", alias).Msg("Failed to unlock account with any passphrase")
+		return false
+	}
+	log.Trace().Str("account", name).Str("alias", alias).Msg("Obtained and unlocked account")
 
-			// Ensure we can unlock the account with a known passphrase.
-			unlocked := false
-			if unlocker, isUnlocker := account.(e2wtypes.AccountLocker); isUnlocker {
-				for _, passphrase := range s.passphrases {
-					if err := unlocker.Unlock(ctx, passphrase); err == nil {
-						unlocked = true
-						break
-					}
-				}
-			}
-			if !unlocked {
-				log.Warn().Str("account", name).Msg("Failed to unlock account with any passphrase")
-				return
-			}
-			log.Trace().Str("account", name).Msg("Obtained and unlocked account")
+	// Set up account as unknown to beacon chain.
+	mu.Lock()
+	accounts[accountPubKey(account)] = account
+	mu.Unlock()
+
+	return true
+}
 
-			// Set up account as unknown to beacon chain.
-			mu.Lock()
-			accounts[bytesutil.ToBytes48(pubKey)] = account
-			mu.Unlock()
-		}(ctx, sem, &wg, wallet, account, accounts, &mu)
+// accountPubKey returns the public key for an account, using the composite public key if available.
+func accountPubKey(account e2wtypes.Account) phase0.BLSPubKey {
+	if provider, isProvider := account.(e2wtypes.AccountCompositePublicKeyProvider); isProvider {
+		return bytesutil.ToBytes48(provider.CompositePublicKey().Marshal())
 	}
-	wg.Wait()
+	return bytesutil.ToBytes48(account.PublicKey().Marshal())
+}
+
+// knownAccounts returns the known accounts.
+// The accounts map is replaced rather than updated, so the returned map is
+// safe to use once the lock is released.
+func (s *Service) knownAccounts() map[phase0.BLSPubKey]e2wtypes.Account {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.accounts
 }
 
 // AccountByPublicKey returns the account for the given public key.
@@ -0,0 +1,146 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/stretchr/testify/require"
+	e2types "github.com/wealdtech/go-eth2-types/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	nd "github.com/wealdtech/go-eth2-wallet-nd/v2"
+	scratch "github.com/wealdtech/go-eth2-wallet-store-scratch"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+func setupTestWallet(ctx context.Context, t *testing.T, accounts int) e2wtypes.Wallet {
+	t.Helper()
+
+	wallet, err := nd.CreateWallet(ctx, "Test wallet", scratch.New(), keystorev4.New())
+	require.NoError(t, err)
+	require.NoError(t, wallet.(e2wtypes.WalletLocker).Unlock(ctx, nil))
+	for i := 0; i < accounts; i++ {
+		key, err := e2types.GenerateBLSPrivateKey()
+		require.NoError(t, err)
+		_, err = wallet.(e2wtypes.WalletAccountImporter).ImportAccount(ctx,
+			fmt.Sprintf("Account %d", i),
+			key.Marshal(),
+			[]byte("pass"),
+		)
+		require.NoError(t, err)
+	}
+
+	return wallet
+}
+
+func TestFetchAccountsForWalletLimit(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, e2types.InitBLS())
+
+	wallet := setupTestWallet(ctx, t, 5)
+	verificationRegexes := []*regexp.Regexp{regexp.MustCompile("^Test wallet/")}
+
+	tests := []struct {
+		name     string
+		limit    int
+		expected int
+		logEntry string
+	}{
+		{
+			name:     "Unlimited",
+			expected: 5,
+		},
+		{
+			name:     "LimitAboveAccounts",
+			limit:    10,
+			expected: 5,
+		},
+		{
+			name:     "LimitEqualsAccounts",
+			limit:    5,
+			expected: 5,
+		},
+		{
+			name:     "LimitBelowAccounts",
+			limit:    3,
+			expected: 3,
+			logEntry: "Maximum number of accounts loaded from wallet; not loading further accounts.  Please check that Vouch is using the intended wallet",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewModuleLogCapture(t, &log)
+			s := &Service{
+				processConcurrency: 2,
+				passphrases:        [][]byte{[]byte("pass")},
+			}
+			accounts := make(map[phase0.BLSPubKey]e2wtypes.Account)
+			deferred, _ := s.fetchAccountsForWallet(ctx, wallet, accounts, verificationRegexes, test.limit, nil)
+			require.Empty(t, deferred)
+			require.Len(t, accounts, test.expected)
+			if test.logEntry != "" {
+				capture.AssertHasEntry(t, test.logEntry)
+			}
+		})
+	}
+}
+
+func TestFetchAccountsForWalletActivePubKeys(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, e2types.InitBLS())
+
+	wallet := setupTestWallet(ctx, t, 5)
+	verificationRegexes := []*regexp.Regexp{regexp.MustCompile("^Test wallet/")}
+
+	activePubKeys := make(map[phase0.BLSPubKey]struct{})
+	for account := range wallet.Accounts(ctx) {
+		if account.Name() == "Account 1" || account.Name() == "Account 3" {
+			activePubKeys[accountPubKey(account)] = struct{}{}
+		}
+	}
+	require.Len(t, activePubKeys, 2)
+
+	s := &Service{
+		processConcurrency: 2,
+		passphrases:        [][]byte{[]byte("pass")},
+		validatorsManager:  mock.NewValidatorsManager(),
+	}
+	accounts := make(map[phase0.BLSPubKey]e2wtypes.Account)
+	deferred, _ := s.fetchAccountsForWallet(ctx, wallet, accounts, verificationRegexes, 0, activePubKeys)
+	require.Len(t, accounts, 2)
+	for pubKey := range activePubKeys {
+		require.Contains(t, accounts, pubKey)
+	}
+	require.Len(t, deferred, 3)
+
+	// Unlock the deferred accounts, whilst also obtaining validating accounts.
+	s.accounts = accounts
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.validatingAccountsForEpochByIndex(ctx, 0, []phase0.ValidatorIndex{0})
+	}()
+	s.loadDeferredAccounts(ctx, deferred)
+	wg.Wait()
+	require.Len(t, s.accounts, 5)
+}
@@ -0,0 +1,30 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// checkSlashed alerts if the account for a validator has been slashed.
+func (s *Service) checkSlashed(index phase0.ValidatorIndex,
+	validator *phase0.Validator,
+	state api.ValidatorState,
+	account e2wtypes.Account,
+) {
+	name := accountName(account)
+	s.slashedAccounts.Check(log, index, validator, state, name, s.aliases.alias(name, validator.PublicKey))
+}
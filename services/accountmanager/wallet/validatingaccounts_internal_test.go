@@ -0,0 +1,155 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/accountmanager"
+	"github.com/attestantio/vouch/services/validatorsmanager"
+	"github.com/stretchr/testify/require"
+	e2types "github.com/wealdtech/go-eth2-types/v2"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// staticValidatorsManager returns a fixed set of validators.
+type staticValidatorsManager struct {
+	validatorsmanager.Service
+	validators map[phase0.ValidatorIndex]*phase0.Validator
+}
+
+func (m *staticValidatorsManager) ValidatorsByPubKey(_ context.Context, _ []phase0.BLSPubKey) map[phase0.ValidatorIndex]*phase0.Validator {
+	return m.validators
+}
+
+func TestValidatingAccountsForEpochByIndexWithMissing(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, e2types.InitBLS())
+
+	farFutureEpoch := phase0.Epoch(0xffffffffffffffff)
+
+	wallet := setupTestWallet(ctx, t, 3)
+	accounts := make(map[phase0.BLSPubKey]e2wtypes.Account)
+	pubKeys := make([]phase0.BLSPubKey, 0, 3)
+	for account := range wallet.Accounts(ctx) {
+		pubKey := accountPubKey(account)
+		accounts[pubKey] = account
+		pubKeys = append(pubKeys, pubKey)
+	}
+	require.Len(t, pubKeys, 3)
+
+	// Validators 1 and 2 are active; validator 3 is pending activation.
+	validators := map[phase0.ValidatorIndex]*phase0.Validator{
+		1: {
+			PublicKey:                  pubKeys[0],
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		},
+		2: {
+			PublicKey:                  pubKeys[1],
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		},
+		3: {
+			PublicKey:                  pubKeys[2],
+			ActivationEligibilityEpoch: 5,
+			ActivationEpoch:            100,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		},
+	}
+
+	s := &Service{
+		accounts:          accounts,
+		farFutureEpoch:    farFutureEpoch,
+		validatorsManager: &staticValidatorsManager{Service: mock.NewValidatorsManager(), validators: validators},
+	}
+
+	tests := []struct {
+		name     string
+		indices  []phase0.ValidatorIndex
+		accounts []phase0.ValidatorIndex
+		missing  *accountmanager.MissingIndices
+	}{
+		{
+			name:     "Empty",
+			indices:  []phase0.ValidatorIndex{},
+			accounts: []phase0.ValidatorIndex{},
+			missing: &accountmanager.MissingIndices{
+				Unknown:  []phase0.ValidatorIndex{},
+				Inactive: []phase0.ValidatorIndex{},
+			},
+		},
+		{
+			name:     "AllActive",
+			indices:  []phase0.ValidatorIndex{1, 2},
+			accounts: []phase0.ValidatorIndex{1, 2},
+			missing: &accountmanager.MissingIndices{
+				Unknown:  []phase0.ValidatorIndex{},
+				Inactive: []phase0.ValidatorIndex{},
+			},
+		},
+		{
+			name:     "Unknown",
+			indices:  []phase0.ValidatorIndex{9, 1, 7},
+			accounts: []phase0.ValidatorIndex{1},
+			missing: &accountmanager.MissingIndices{
+				Unknown:  []phase0.ValidatorIndex{7, 9},
+				Inactive: []phase0.ValidatorIndex{},
+			},
+		},
+		{
+			name:     "Inactive",
+			indices:  []phase0.ValidatorIndex{2, 3},
+			accounts: []phase0.ValidatorIndex{2},
+			missing: &accountmanager.MissingIndices{
+				Unknown:  []phase0.ValidatorIndex{},
+				Inactive: []phase0.ValidatorIndex{3},
+			},
+		},
+		{
+			name:     "Mixed",
+			indices:  []phase0.ValidatorIndex{3, 4, 1, 2},
+			accounts: []phase0.ValidatorIndex{1, 2},
+			missing: &accountmanager.MissingIndices{
+				Unknown:  []phase0.ValidatorIndex{4},
+				Inactive: []phase0.ValidatorIndex{3},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, missing, err := s.ValidatingAccountsForEpochByIndexWithMissing(ctx, 10, test.indices)
+			require.NoError(t, err)
+			require.Len(t, res, len(test.accounts))
+			for _, index := range test.accounts {
+				require.Contains(t, res, index)
+			}
+			require.Equal(t, test.missing, missing)
+
+			// The standard call returns the same accounts.
+			standard, err := s.ValidatingAccountsForEpochByIndex(ctx, 10, test.indices)
+			require.NoError(t, err)
+			require.Equal(t, res, standard)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/attestantio/vouch/services/accountmanager/utils"
+)
+
+// checkValidatorStateAge checks that the validator state has been refreshed
+// recently enough to be trusted.
+func (s *Service) checkValidatorStateAge() error {
+	return utils.CheckValidatorStateAge(log,
+		time.Unix(0, s.lastValidatorsRefresh.Load()),
+		s.maxValidatorStateAge,
+		s.refuseStaleValidators,
+	)
+}
@@ -20,22 +20,42 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 )
 
+// FeeRecipientSource is the source of the fee recipient in a proposer configuration.
+type FeeRecipientSource string
+
+const (
+	// FeeRecipientSourceFallback is the fallback fee recipient supplied to Vouch.
+	FeeRecipientSourceFallback FeeRecipientSource = "fallback"
+	// FeeRecipientSourceDefault is the default fee recipient of the execution configuration.
+	FeeRecipientSourceDefault FeeRecipientSource = "default"
+	// FeeRecipientSourceAccount is a proposer-specific fee recipient matched by account name.
+	FeeRecipientSourceAccount FeeRecipientSource = "account"
+	// FeeRecipientSourceValidator is a proposer-specific fee recipient matched by validator public key.
+	FeeRecipientSourceValidator FeeRecipientSource = "validator"
+)
+
 // ProposerConfig contains configuration for a proposer.
 type ProposerConfig struct {
-	FeeRecipient bellatrix.ExecutionAddress
-	Relays       []*RelayConfig
+	FeeRecipient       bellatrix.ExecutionAddress
+	FeeRecipientSource FeeRecipientSource
+	Relays             []*RelayConfig
+	// ForceLocalBuild is true if the proposer should always build its
+	// blocks locally, without querying relays.
+	ForceLocalBuild bool
 }
 
 type proposerConfigJSON struct {
-	FeeRecipient string         `json:"fee_recipient"`
-	Relays       []*RelayConfig `json:"relays"`
+	FeeRecipient    string         `json:"fee_recipient"`
+	Relays          []*RelayConfig `json:"relays"`
+	ForceLocalBuild bool           `json:"force_local_build,omitempty"`
 }
 
 // MarshalJSON implements json.Marshaler.
 func (p *ProposerConfig) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&proposerConfigJSON{
-		FeeRecipient: fmt.Sprintf("%#x", p.FeeRecipient),
-		Relays:       p.Relays,
+		FeeRecipient:    fmt.Sprintf("%#x", p.FeeRecipient),
+		Relays:          p.Relays,
+		ForceLocalBuild: p.ForceLocalBuild,
 	})
 }
 
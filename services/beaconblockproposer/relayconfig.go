@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/shopspring/decimal"
@@ -27,21 +28,25 @@ var weiPerETH = decimal.New(1e18, 0)
 
 // RelayConfig contains configuration for a relay.
 type RelayConfig struct {
-	Address      string
-	PublicKey    *phase0.BLSPubKey
-	FeeRecipient bellatrix.ExecutionAddress
-	GasLimit     uint64
-	Grace        time.Duration
-	MinValue     decimal.Decimal
+	Address            string
+	PublicKey          *phase0.BLSPubKey
+	FeeRecipient       bellatrix.ExecutionAddress
+	GasLimit           uint64
+	Grace              time.Duration
+	MinValue           decimal.Decimal
+	ExpectedVersion    *spec.DataVersion
+	UncompetitiveDelta decimal.Decimal
 }
 
 type relayConfigJSON struct {
-	Address      string `json:"address"`
-	PublicKey    string `json:"public_key,omitempty"`
-	FeeRecipient string `json:"fee_recipient"`
-	GasLimit     string `json:"gas_limit"`
-	Grace        string `json:"grace,omitempty"`
-	MinValue     string `json:"min_value,omitempty"`
+	Address            string `json:"address"`
+	PublicKey          string `json:"public_key,omitempty"`
+	FeeRecipient       string `json:"fee_recipient"`
+	GasLimit           string `json:"gas_limit"`
+	Grace              string `json:"grace,omitempty"`
+	MinValue           string `json:"min_value,omitempty"`
+	ExpectedVersion    string `json:"expected_version,omitempty"`
+	UncompetitiveDelta string `json:"uncompetitive_delta,omitempty"`
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -58,13 +63,23 @@ func (r *RelayConfig) MarshalJSON() ([]byte, error) {
 	if !r.MinValue.Equal(decimal.Zero) {
 		minValue = fmt.Sprintf("%v", r.MinValue.Div(weiPerETH))
 	}
+	uncompetitiveDelta := ""
+	if !r.UncompetitiveDelta.Equal(decimal.Zero) {
+		uncompetitiveDelta = fmt.Sprintf("%v", r.UncompetitiveDelta.Div(weiPerETH))
+	}
+	expectedVersion := ""
+	if r.ExpectedVersion != nil {
+		expectedVersion = r.ExpectedVersion.String()
+	}
 	return json.Marshal(&relayConfigJSON{
-		Address:      r.Address,
-		PublicKey:    publicKey,
-		FeeRecipient: fmt.Sprintf("%#x", r.FeeRecipient),
-		GasLimit:     fmt.Sprintf("%d", r.GasLimit),
-		Grace:        grace,
-		MinValue:     minValue,
+		Address:            r.Address,
+		PublicKey:          publicKey,
+		FeeRecipient:       fmt.Sprintf("%#x", r.FeeRecipient),
+		GasLimit:           fmt.Sprintf("%d", r.GasLimit),
+		Grace:              grace,
+		MinValue:           minValue,
+		ExpectedVersion:    expectedVersion,
+		UncompetitiveDelta: uncompetitiveDelta,
 	})
 }
 
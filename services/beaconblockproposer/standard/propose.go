@@ -33,10 +33,12 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
 	"github.com/pkg/errors"
 	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/semaphore"
 )
 
@@ -196,12 +198,15 @@ func (s *Service) proposeBlockWithAuction(ctx context.Context,
 	monitorBestBidRelayCount(len(providers))
 	log.Trace().Int("providers", len(providers)).Msg("Obtained relays that can unblind the proposal")
 
+	signStarted := time.Now()
 	signedBlindedBlock, err := s.signBlindedProposal(ctx, duty, proposal)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to sign blinded proposal")
 		return auctionResultFailed
 	}
+	s.recordProposalPhase(ctx, span, blockrelay.ProposalPhaseSign, time.Since(signStarted))
 
+	submitStarted := time.Now()
 	signedBlock, err := s.unblindBlock(ctx, signedBlindedBlock, providers)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to unblind block")
@@ -213,10 +218,55 @@ func (s *Service) proposeBlockWithAuction(ctx context.Context,
 		log.Error().Err(err).Msg("Failed to submit beacon block proposal")
 		return auctionResultFailed
 	}
+	s.recordProposalPhase(ctx, span, blockrelay.ProposalPhaseSubmit, time.Since(submitStarted))
+
+	s.recordProposedBlock(ctx, duty, signedBlock, auctionResults)
 
 	return auctionResultSucceeded
 }
 
+// recordProposalPhase records the latency of a phase of the proposal path as a
+// span event and, if the auctioneer is interested, a metric alongside those of
+// the auction.
+func (s *Service) recordProposalPhase(ctx context.Context,
+	span trace.Span,
+	phase blockrelay.ProposalPhase,
+	duration time.Duration,
+) {
+	blockrelay.AddProposalPhaseEvent(span, phase, duration)
+	if recorder, isRecorder := s.blockAuctioneer.(blockrelay.ProposalPhaseRecorder); isRecorder {
+		recorder.RecordProposalPhase(ctx, phase, duration)
+	}
+}
+
+// recordProposedBlock records the relays that supplied the payload for a proposed block,
+// if the auctioneer is interested.
+func (s *Service) recordProposedBlock(ctx context.Context,
+	duty *beaconblockproposer.Duty,
+	signedBlock *spec.VersionedSignedBeaconBlock,
+	auctionResults *blockauctioneer.Results,
+) {
+	recorder, isRecorder := s.blockAuctioneer.(blockrelay.ProposedBlockRecorder)
+	if !isRecorder {
+		return
+	}
+
+	root, err := signedBlock.Root()
+	if err != nil {
+		log.Warn().Uint64("slot", uint64(duty.Slot())).Err(err).Msg("Failed to obtain root of proposed block; not recording")
+		return
+	}
+
+	relays := make([]string, 0, len(auctionResults.Providers))
+	for _, provider := range auctionResults.Providers {
+		if service, isService := provider.(builderclient.Service); isService {
+			relays = append(relays, service.Address())
+		}
+	}
+
+	recorder.RecordProposedBlock(ctx, duty.Slot(), root, relays)
+}
+
 func (s *Service) proposeBlockWithoutAuction(ctx context.Context,
 	duty *beaconblockproposer.Duty,
 	graffiti []byte,
@@ -0,0 +1,79 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockblockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
+	"github.com/attestantio/vouch/services/blockrelay"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// recordingAuctioneer is an auctioneer that records proposal phases.
+type recordingAuctioneer struct {
+	*mockblockauctioneer.Service
+	phases map[blockrelay.ProposalPhase]time.Duration
+}
+
+func (a *recordingAuctioneer) RecordProposalPhase(_ context.Context,
+	phase blockrelay.ProposalPhase,
+	duration time.Duration,
+) {
+	a.phases[phase] = duration
+}
+
+func TestRecordProposalPhase(t *testing.T) {
+	ctx := context.Background()
+
+	recorder := tracetest.NewSpanRecorder()
+	_, span := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test").Start(ctx, "test")
+
+	auctioneer := &recordingAuctioneer{
+		Service: mockblockauctioneer.New(),
+		phases:  make(map[blockrelay.ProposalPhase]time.Duration),
+	}
+	s := &Service{
+		blockAuctioneer: auctioneer,
+	}
+	s.recordProposalPhase(ctx, span, blockrelay.ProposalPhaseSign, 20*time.Millisecond)
+	s.recordProposalPhase(ctx, span, blockrelay.ProposalPhaseSubmit, 30*time.Millisecond)
+	span.End()
+
+	require.Equal(t, map[blockrelay.ProposalPhase]time.Duration{
+		blockrelay.ProposalPhaseSign:   20 * time.Millisecond,
+		blockrelay.ProposalPhaseSubmit: 30 * time.Millisecond,
+	}, auctioneer.phases)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	events := spans[0].Events()
+	require.Len(t, events, 2)
+	for i, phase := range []blockrelay.ProposalPhase{blockrelay.ProposalPhaseSign, blockrelay.ProposalPhaseSubmit} {
+		require.Equal(t, blockrelay.ProposalPhaseEvent, events[i].Name)
+		attrs := make(map[string]interface{})
+		for _, attr := range events[i].Attributes {
+			attrs[string(attr.Key)] = attr.Value.AsInterface()
+		}
+		require.Equal(t, string(phase), attrs["phase"])
+	}
+
+	// An auctioneer that does not record phases is ignored.
+	s.blockAuctioneer = mockblockauctioneer.New()
+	s.recordProposalPhase(ctx, span, blockrelay.ProposalPhaseSign, time.Millisecond)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/services/accountmanager"
 	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
 	"github.com/attestantio/vouch/services/cache"
 	"github.com/attestantio/vouch/services/chaintime"
 	"github.com/attestantio/vouch/services/graffitiprovider"
@@ -31,6 +32,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -120,6 +122,21 @@ func (s *Service) Prepare(ctx context.Context, data interface{}) error {
 	log.Trace().Dur("elapsed", time.Since(started)).Msg("Obtained proposing account")
 	duty.SetAccount(account)
 
+	if precomputer, isPrecomputer := s.blockAuctioneer.(blockrelay.ProposerConfigPrecomputer); isPrecomputer {
+		// Resolve the proposer configuration ahead of the proposal, to remove it from the critical path.
+		var pubkey phase0.BLSPubKey
+		if provider, isProvider := account.(e2wtypes.AccountCompositePublicKeyProvider); isProvider {
+			copy(pubkey[:], provider.CompositePublicKey().Marshal())
+		} else {
+			copy(pubkey[:], account.PublicKey().Marshal())
+		}
+		go func(ctx context.Context, pubkey phase0.BLSPubKey) {
+			if err := precomputer.PrecomputeProposerConfig(ctx, pubkey); err != nil {
+				log.Debug().Err(err).Msg("Failed to precompute proposer configuration")
+			}
+		}(ctx, pubkey)
+	}
+
 	randaoReveal, err := s.randaoRevealSigner.SignRANDAOReveal(ctx, account, duty.Slot())
 	if err != nil {
 		return errors.Wrap(err, "failed to sign RANDAO reveal")
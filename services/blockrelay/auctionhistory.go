@@ -0,0 +1,114 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AuctionRecord is a record of the decision made by an auction.
+type AuctionRecord struct {
+	Slot   phase0.Slot
+	Pubkey phase0.BLSPubKey
+	// Relays are the relays queried in the auction.
+	Relays []string
+	// Values are the values of the bids received, keyed by relay.
+	Values map[string]*big.Int
+	// Winners are the relays that provided the winning bid, if any.
+	Winners []string
+	// WinningValue is the value of the winning bid, if any.
+	WinningValue *big.Int
+	// NoBidReason is the reason that no bid was selected, if there are no winners.
+	NoBidReason NoBidReason
+	// Abandoned are the relays whose requests were abandoned once the auction
+	// had been decided, if any.
+	Abandoned []string
+	// Outcomes are the outcomes of the auction for each relay queried.
+	Outcomes map[string]RelayOutcome
+	Started  time.Time
+	Duration time.Duration
+}
+
+type auctionRecordJSON struct {
+	Slot         string            `json:"slot"`
+	Pubkey       string            `json:"pubkey"`
+	Relays       []string          `json:"relays"`
+	Values       map[string]string `json:"values"`
+	Winners      []string          `json:"winners"`
+	WinningValue string            `json:"winning_value,omitempty"`
+	NoBidReason  string            `json:"no_bid_reason,omitempty"`
+	Abandoned    []string          `json:"abandoned,omitempty"`
+	Outcomes     map[string]string `json:"outcomes,omitempty"`
+	Started      string            `json:"started"`
+	Duration     string            `json:"duration"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *AuctionRecord) MarshalJSON() ([]byte, error) {
+	values := make(map[string]string, len(r.Values))
+	for relay, value := range r.Values {
+		values[relay] = value.String()
+	}
+	winningValue := ""
+	if r.WinningValue != nil {
+		winningValue = r.WinningValue.String()
+	}
+	var outcomes map[string]string
+	if len(r.Outcomes) > 0 {
+		outcomes = make(map[string]string, len(r.Outcomes))
+		for relay, outcome := range r.Outcomes {
+			outcomes[relay] = outcome.String()
+		}
+	}
+	noBidReason := ""
+	if len(r.Winners) == 0 {
+		noBidReason = r.NoBidReason.String()
+	}
+
+	return json.Marshal(&auctionRecordJSON{
+		Slot:         fmt.Sprintf("%d", r.Slot),
+		Pubkey:       fmt.Sprintf("%#x", r.Pubkey),
+		Relays:       r.Relays,
+		Values:       values,
+		Winners:      r.Winners,
+		WinningValue: winningValue,
+		NoBidReason:  noBidReason,
+		Abandoned:    r.Abandoned,
+		Outcomes:     outcomes,
+		Started:      r.Started.Format(time.RFC3339Nano),
+		Duration:     r.Duration.String(),
+	})
+}
+
+// AuctionAuditSink is the interface for recording audit information about auctions.
+// It is called inline with the auction, so should return quickly.
+// An audit sink that also implements this interface is passed the record of each auction.
+type AuctionAuditSink interface {
+	// AuditAuction records the decision made by an auction.
+	AuditAuction(ctx context.Context, record *AuctionRecord)
+}
+
+// AuctionHistoryProvider is the interface for providing the decisions made by recent auctions.
+type AuctionHistoryProvider interface {
+	Service
+
+	// AuctionHistory returns the records of recent auctions, oldest first.
+	AuctionHistory(ctx context.Context) []*AuctionRecord
+}
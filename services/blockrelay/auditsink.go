@@ -0,0 +1,56 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+)
+
+// FeeRecipientRecord is an audit record of the fee recipient committed for a proposal.
+type FeeRecipientRecord struct {
+	Slot         phase0.Slot
+	Pubkey       phase0.BLSPubKey
+	FeeRecipient bellatrix.ExecutionAddress
+	Source       beaconblockproposer.FeeRecipientSource
+}
+
+type feeRecipientRecordJSON struct {
+	Slot         string `json:"slot"`
+	Pubkey       string `json:"pubkey"`
+	FeeRecipient string `json:"fee_recipient"`
+	Source       string `json:"source"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *FeeRecipientRecord) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&feeRecipientRecordJSON{
+		Slot:         fmt.Sprintf("%d", r.Slot),
+		Pubkey:       fmt.Sprintf("%#x", r.Pubkey),
+		FeeRecipient: fmt.Sprintf("%#x", r.FeeRecipient),
+		Source:       string(r.Source),
+	})
+}
+
+// AuditSink is the interface for recording audit information about proposals.
+// It is called inline with the auction, so should return quickly.
+type AuditSink interface {
+	// AuditFeeRecipient records the fee recipient committed for a proposal.
+	AuditFeeRecipient(ctx context.Context, record *FeeRecipientRecord)
+}
@@ -0,0 +1,32 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+)
+
+// BidValidator is the interface for custom validation of bids.
+// It is called after the built-in checks have passed, allowing operators
+// to apply their own policies to bids.
+type BidValidator interface {
+	// Validate validates a bid, returning an error if the bid should be rejected.
+	Validate(ctx context.Context,
+		bid *builderspec.VersionedSignedBuilderBid,
+		relayConfig *beaconblockproposer.RelayConfig,
+	) error
+}
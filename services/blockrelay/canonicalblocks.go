@@ -0,0 +1,48 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ProposedBlockRecorder is the interface for recording blocks that have been
+// proposed with a payload obtained from relays.
+type ProposedBlockRecorder interface {
+	Service
+
+	// RecordProposedBlock records that the block with the given root was
+	// proposed at the given slot with a payload supplied by the given relays.
+	RecordProposedBlock(ctx context.Context,
+		slot phase0.Slot,
+		root phase0.Root,
+		relays []string,
+	)
+}
+
+// ChainHeadFeedback is the interface for providing feedback about the
+// canonical chain for slots in which blocks were proposed via relays.
+type ChainHeadFeedback interface {
+	Service
+
+	// CanonicalBlock provides the root of the canonical block at the given slot,
+	// or nil if the slot is empty on the canonical chain.  Slots for which no
+	// block was recorded are ignored.
+	CanonicalBlock(ctx context.Context,
+		slot phase0.Slot,
+		root *phase0.Root,
+	)
+}
@@ -0,0 +1,46 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+)
+
+// DiagnosticAuctionResult is the result of a diagnostic auction.
+type DiagnosticAuctionResult struct {
+	// ProposerConfig is the proposer configuration used for the auction.
+	ProposerConfig *beaconblockproposer.ProposerConfig
+	// Results are the results of the auction, or nil if no useful bids were received.
+	Results *blockauctioneer.Results
+}
+
+// DiagnosticAuctioneer is the interface for running auctions that are not used for proposals.
+type DiagnosticAuctioneer interface {
+	Service
+
+	// DiagnosticAuction runs an auction for the given slot, parent hash and proposer,
+	// returning the results without making them available for a proposal.
+	DiagnosticAuction(ctx context.Context,
+		slot phase0.Slot,
+		parentHash phase0.Hash32,
+		pubkey phase0.BLSPubKey,
+	) (
+		*DiagnosticAuctionResult,
+		error,
+	)
+}
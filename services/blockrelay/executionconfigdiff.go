@@ -0,0 +1,56 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ExecutionConfigDiff is the set of changes to the proposer configuration
+// of managed validators made by a refresh of the execution configuration.
+type ExecutionConfigDiff struct {
+	// Timestamp is the time at which the refresh took place.
+	Timestamp time.Time
+	// Proposers are the changes for each validator whose proposer
+	// configuration changed.
+	Proposers map[phase0.BLSPubKey]*ProposerConfigDiff
+}
+
+// ProposerConfigDiff is the set of changes to the proposer configuration of
+// a single validator.
+type ProposerConfigDiff struct {
+	// RelaysAdded are the addresses of relays added to the configuration.
+	RelaysAdded []string
+	// RelaysRemoved are the addresses of relays removed from the configuration.
+	RelaysRemoved []string
+	// PreviousFeeRecipient is the fee recipient prior to the refresh, if it changed.
+	PreviousFeeRecipient *bellatrix.ExecutionAddress
+	// FeeRecipient is the fee recipient after the refresh, if it changed.
+	FeeRecipient *bellatrix.ExecutionAddress
+}
+
+// ExecutionConfigDiffProvider is the interface for reporting the changes made
+// by the most recent refresh of the execution configuration.
+type ExecutionConfigDiffProvider interface {
+	Service
+
+	// LastExecutionConfigDiff returns the changes made by the most recent refresh
+	// of the execution configuration that changed the configuration of any managed
+	// validator, or nil if there has been no such refresh.
+	LastExecutionConfigDiff(ctx context.Context) *ExecutionConfigDiff
+}
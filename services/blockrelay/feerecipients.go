@@ -0,0 +1,61 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+)
+
+// FeeRecipientReportEntry is the fee recipient that a validator will use for its proposals.
+type FeeRecipientReportEntry struct {
+	Index        phase0.ValidatorIndex
+	Account      string
+	Pubkey       phase0.BLSPubKey
+	FeeRecipient bellatrix.ExecutionAddress
+	Source       beaconblockproposer.FeeRecipientSource
+}
+
+type feeRecipientReportEntryJSON struct {
+	Index        string `json:"index"`
+	Account      string `json:"account"`
+	Pubkey       string `json:"pubkey"`
+	FeeRecipient string `json:"fee_recipient"`
+	Source       string `json:"source"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *FeeRecipientReportEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&feeRecipientReportEntryJSON{
+		Index:        fmt.Sprintf("%d", e.Index),
+		Account:      e.Account,
+		Pubkey:       fmt.Sprintf("%#x", e.Pubkey),
+		FeeRecipient: fmt.Sprintf("%#x", e.FeeRecipient),
+		Source:       string(e.Source),
+	})
+}
+
+// FeeRecipientsReporter is the interface for reporting the fee recipients of managed validators.
+type FeeRecipientsReporter interface {
+	Service
+
+	// FeeRecipientsReport returns the fee recipient for each validator managed by this instance,
+	// ordered by validator index.
+	FeeRecipientsReport(ctx context.Context) ([]*FeeRecipientReportEntry, error)
+}
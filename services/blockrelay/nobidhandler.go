@@ -0,0 +1,69 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// NoBidReason is the reason that an auction did not select a bid.
+type NoBidReason int
+
+const (
+	// NoBidReasonUnknown is an unknown reason.
+	NoBidReasonUnknown NoBidReason = iota
+	// NoBidReasonNoRelays is when the proposer has no relays configured.
+	NoBidReasonNoRelays
+	// NoBidReasonAllErrored is when all relays returned errors or timed out.
+	NoBidReasonAllErrored
+	// NoBidReasonNoBids is when relays responded but none of them provided a bid.
+	NoBidReasonNoBids
+	// NoBidReasonBelowMinValue is when all bids provided were below the minimum value.
+	NoBidReasonBelowMinValue
+	// NoBidReasonRejected is when the selected bid was rejected by post-selection validation.
+	NoBidReasonRejected
+	// NoBidReasonForceLocalBuild is when the proposer is configured to always build locally.
+	NoBidReasonForceLocalBuild
+)
+
+var noBidReasonStrings = [...]string{
+	"unknown",
+	"no relays",
+	"all errored",
+	"no bids",
+	"below min value",
+	"rejected",
+	"force local build",
+}
+
+// String returns a string representation of the reason.
+func (r NoBidReason) String() string {
+	if int(r) < 0 || int(r) >= len(noBidReasonStrings) {
+		return noBidReasonStrings[NoBidReasonUnknown]
+	}
+	return noBidReasonStrings[r]
+}
+
+// NoBidHandler is the interface for handling auctions that do not select a bid.
+// It is called inline with the auction, so should return quickly.
+type NoBidHandler interface {
+	// NoBid is called when an auction for the given slot and proposer selects no bid.
+	NoBid(ctx context.Context,
+		slot phase0.Slot,
+		pubkey phase0.BLSPubKey,
+		reason NoBidReason,
+	)
+}
@@ -0,0 +1,31 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+)
+
+// PostSelectionValidator is the interface for final validation of the result
+// of an auction.
+// It is called after the winning bid has been selected and before it is used,
+// allowing operators to apply last-second policies such as checking the bid
+// against an independent view of the chain.
+type PostSelectionValidator interface {
+	// PostSelection validates the result of an auction, returning an error if
+	// the result should be discarded in favour of a locally-built block.
+	PostSelection(ctx context.Context, results *blockauctioneer.Results) error
+}
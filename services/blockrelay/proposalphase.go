@@ -0,0 +1,69 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ProposalPhase is a phase of the proposal path, used to label the latency
+// of each phase in span events and metrics.
+type ProposalPhase string
+
+const (
+	// ProposalPhaseAuction is the whole of the block auction.
+	ProposalPhaseAuction ProposalPhase = "auction"
+	// ProposalPhaseRelayRequest is the request for a bid from a relay.
+	ProposalPhaseRelayRequest ProposalPhase = "relay_request"
+	// ProposalPhaseValidation is the validation of a bid from a relay.
+	ProposalPhaseValidation ProposalPhase = "validation"
+	// ProposalPhaseSign is the signing of the blinded block by the proposer.
+	ProposalPhaseSign ProposalPhase = "sign"
+	// ProposalPhaseSubmit is the unblinding of the signed blinded block by
+	// the relays and the submission of the resultant block by the proposer.
+	ProposalPhaseSubmit ProposalPhase = "submit"
+)
+
+// ProposalPhaseRecorder is the interface for recording the latency of phases
+// of the proposal path that take place outside of the auction.
+type ProposalPhaseRecorder interface {
+	Service
+
+	// RecordProposalPhase records the latency of a phase of the proposal path.
+	RecordProposalPhase(ctx context.Context,
+		phase ProposalPhase,
+		duration time.Duration,
+	)
+}
+
+// ProposalPhaseEvent is the name of the span event added at the end of a phase.
+const ProposalPhaseEvent = "proposal phase complete"
+
+// AddProposalPhaseEvent adds a span event marking the end of a phase of the
+// proposal path, with the phase and its duration in milliseconds as attributes.
+func AddProposalPhaseEvent(span trace.Span,
+	phase ProposalPhase,
+	duration time.Duration,
+	attrs ...attribute.KeyValue,
+) {
+	attrs = append([]attribute.KeyValue{
+		attribute.String("phase", string(phase)),
+		attribute.Int64("duration_ms", duration.Milliseconds()),
+	}, attrs...)
+	span.AddEvent(ProposalPhaseEvent, trace.WithAttributes(attrs...))
+}
@@ -0,0 +1,28 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ProposerConfigPrecomputer is the interface for block auctioneers that can
+// resolve proposer configuration ahead of a proposal.
+type ProposerConfigPrecomputer interface {
+	// PrecomputeProposerConfig resolves the proposer configuration for the
+	// validator with the given public key, and holds it for its next auction.
+	PrecomputeProposerConfig(ctx context.Context, pubkey phase0.BLSPubKey) error
+}
@@ -0,0 +1,29 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+import (
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// RandomSource is the interface for the source of randomness used when
+// running auctions.
+// All randomness in the auction is obtained through this interface, allowing
+// deterministic sources to be supplied for reproducible results.
+type RandomSource interface {
+	// Int returns a random value in the range [0, limit) for the given slot.
+	Int(slot phase0.Slot, limit *big.Int) *big.Int
+}
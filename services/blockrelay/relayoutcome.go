@@ -0,0 +1,65 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockrelay
+
+// RelayOutcome is the outcome of an auction for a relay.
+type RelayOutcome int
+
+const (
+	// RelayOutcomeUnknown is an unknown outcome.
+	RelayOutcomeUnknown RelayOutcome = iota
+	// RelayOutcomeSelected is when the relay's bid was selected.
+	RelayOutcomeSelected
+	// RelayOutcomeLowerValue is when the relay's bid was lower than the selected bid.
+	RelayOutcomeLowerValue
+	// RelayOutcomeEqualValue is when the relay's bid matched the value of the
+	// selected bid but the relay was not selected, for example because the
+	// maximum number of matching providers had been reached.
+	RelayOutcomeEqualValue
+	// RelayOutcomeOutweighed is when the relay's bid was higher than the
+	// selected bid, but another was chosen by weighted selection.
+	RelayOutcomeOutweighed
+	// RelayOutcomeBelowMinValue is when the relay's bid was below the minimum value.
+	RelayOutcomeBelowMinValue
+	// RelayOutcomeNoBid is when the relay responded without a bid.
+	RelayOutcomeNoBid
+	// RelayOutcomeErrored is when the relay returned an error, or its bid failed validation.
+	RelayOutcomeErrored
+	// RelayOutcomeTimedOut is when the relay did not respond before the auction ended.
+	RelayOutcomeTimedOut
+	// RelayOutcomeAbandoned is when the request to the relay was abandoned
+	// because the auction had been decided by the first acceptable bid.
+	RelayOutcomeAbandoned
+)
+
+var relayOutcomeStrings = [...]string{
+	"unknown",
+	"selected",
+	"lower value",
+	"equal value",
+	"outweighed",
+	"below min value",
+	"no bid",
+	"errored",
+	"timed out",
+	"abandoned",
+}
+
+// String returns a string representation of the outcome.
+func (o RelayOutcome) String() string {
+	if int(o) < 0 || int(o) >= len(relayOutcomeStrings) {
+		return relayOutcomeStrings[RelayOutcomeUnknown]
+	}
+	return relayOutcomeStrings[o]
+}
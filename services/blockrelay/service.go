@@ -47,3 +47,16 @@ type ExecutionConfigProvider interface {
 		error,
 	)
 }
+
+// ProposerConfigResolver is the interface for resolving proposer configuration by public key.
+type ProposerConfigResolver interface {
+	Service
+
+	// ResolveProposerConfig returns the proposer configuration for the validator with the given public key.
+	ResolveProposerConfig(ctx context.Context,
+		pubkey phase0.BLSPubKey,
+	) (
+		*beaconblockproposer.ProposerConfig,
+		error,
+	)
+}
@@ -28,9 +28,11 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
 	"github.com/attestantio/vouch/util"
 	"github.com/holiman/uint256"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
 	e2types "github.com/wealdtech/go-eth2-types/v2"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -43,6 +45,18 @@ var zeroExecutionAddress bellatrix.ExecutionAddress
 // zeroValue is used for comparison purposes.
 var zeroValue uint256.Int
 
+// zeroDomain is used for comparison purposes.
+var zeroDomain phase0.Domain
+
+// zeroPrevRandao is used for comparison purposes.
+var zeroPrevRandao [32]byte
+
+// emptyTransactionsRoot is the transactions root of an execution payload with no transactions.
+var emptyTransactionsRoot = phase0.Root{
+	0x7f, 0xfe, 0x24, 0x1e, 0xa6, 0x01, 0x87, 0xfd, 0xb0, 0x18, 0x7b, 0xfa, 0x22, 0xde, 0x35, 0xd1,
+	0xf9, 0xbe, 0xd7, 0xab, 0x06, 0x1d, 0x94, 0x01, 0xfd, 0x47, 0xe3, 0x4a, 0x54, 0xfb, 0xed, 0xe1,
+}
+
 // AuctionBlock obtains the best available use of the block space.
 func (s *Service) AuctionBlock(ctx context.Context,
 	slot phase0.Slot,
@@ -55,41 +69,83 @@ func (s *Service) AuctionBlock(ctx context.Context,
 	ctx, span := otel.Tracer("attestantio.vouch.services.blockrelay.standard").Start(ctx, "AuctionBlock")
 	defer span.End()
 
-	account, err := s.accountsProvider.AccountByPublicKey(ctx, pubkey)
-	if err != nil {
-		return nil, errors.New("no account found for public key")
+	record := &blockrelay.AuctionRecord{
+		Slot:    slot,
+		Pubkey:  pubkey,
+		Relays:  make([]string, 0),
+		Values:  make(map[string]*big.Int),
+		Winners: make([]string, 0),
+		Started: time.Now(),
 	}
-	s.executionConfigMu.RLock()
-	proposerConfig, err := s.executionConfig.ProposerConfig(ctx, account, pubkey, s.fallbackFeeRecipient, s.fallbackGasLimit)
+	defer func() {
+		s.recordAuction(ctx, record)
+	}()
+
+	proposerConfig, err := s.auctionProposerConfig(ctx, pubkey)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to obtain proposer configuration")
+		monitorProposerConfig("failed", unknownCohortLabel)
+		return nil, err
+	}
+	s.auditSink.AuditFeeRecipient(ctx, &blockrelay.FeeRecipientRecord{
+		Slot:         slot,
+		Pubkey:       pubkey,
+		FeeRecipient: proposerConfig.FeeRecipient,
+		Source:       proposerConfig.FeeRecipientSource,
+	})
+
+	if proposerConfig.ForceLocalBuild {
+		monitorProposerConfig("force_local_build", proposerCohortLabel(proposerConfig))
+		log.Debug().Uint64("slot", uint64(slot)).Str("pubkey", fmt.Sprintf("%#x", pubkey)).Msg("Proposer configured to build locally; not querying relays")
+		s.noBidHandler.NoBid(ctx, slot, pubkey, blockrelay.NoBidReasonForceLocalBuild)
+		record.NoBidReason = blockrelay.NoBidReasonForceLocalBuild
+		return nil, nil
 	}
-	s.executionConfigMu.RUnlock()
 
 	if len(proposerConfig.Relays) == 0 {
+		monitorProposerConfig("no_relays", proposerCohortLabel(proposerConfig))
 		log.Trace().Msg("No relays in proposer configuration")
+		s.noBidHandler.NoBid(ctx, slot, pubkey, blockrelay.NoBidReasonNoRelays)
+		record.NoBidReason = blockrelay.NoBidReasonNoRelays
 		return nil, nil
 	}
+	monitorProposerConfig("succeeded", proposerCohortLabel(proposerConfig))
+	for _, relay := range proposerConfig.Relays {
+		record.Relays = append(record.Relays, relay.Address)
+	}
+	defer func() {
+		s.recordProposalPhase(ctx, span, blockrelay.ProposalPhaseAuction, time.Since(record.Started))
+	}()
 
-	res := s.bestBuilderBid(ctx, slot, parentHash, pubkey, proposerConfig)
+	if s.auctionRetries > 0 {
+		ctx = context.WithValue(ctx, auctionRetryContextKey{}, true)
+	}
+	res := s.bestBuilderBid(ctx, slot, parentHash, pubkey, proposerConfig, record)
+	if res == nil && deferNoBid(ctx, record.NoBidReason) {
+		res = s.retryAuction(ctx, slot, parentHash, pubkey, proposerConfig, record)
+	}
+	s.logRelayOutcomes(slot, record.Outcomes)
 	if res == nil {
 		return nil, nil
 	}
 
 	if res.Bid != nil {
-		key := fmt.Sprintf("%d", slot)
-		subKey := fmt.Sprintf("%x:%x", parentHash, pubkey)
-		s.builderBidsCacheMu.Lock()
-		if _, exists := s.builderBidsCache[key]; !exists {
-			s.builderBidsCache[key] = make(map[string]*builderspec.VersionedSignedBuilderBid)
+		if err := s.postSelectionValidator.PostSelection(ctx, res); err != nil {
+			log.Warn().Uint64("slot", uint64(slot)).Err(err).Msg("Auction result rejected by post-selection validation; falling back to local block production")
+			s.noBidHandler.NoBid(ctx, slot, pubkey, blockrelay.NoBidReasonRejected)
+			record.NoBidReason = blockrelay.NoBidReasonRejected
+			return nil, nil
 		}
-		s.builderBidsCache[key][subKey] = res.Bid
-		s.builderBidsCacheMu.Unlock()
+	}
+
+	if res.Bid != nil {
+		s.cacheBuilderBid(slot, parentHash, pubkey, res.Bid)
+		recordWinningBidGas(slot, res.Bid)
 	}
 
 	selectedProviders := make(map[string]struct{})
 	for _, provider := range res.Providers {
 		selectedProviders[strings.ToLower(provider.Address())] = struct{}{}
+		record.Winners = append(record.Winners, provider.Address())
 	}
 
 	// Update metrics.
@@ -97,11 +153,12 @@ func (s *Service) AuctionBlock(ctx context.Context,
 	if err != nil {
 		log.Warn().Err(err).Msg("Failed to obtain bid value")
 	} else {
+		record.WinningValue = val.ToBig()
 		for provider, value := range res.Values {
 			delta := new(big.Int).Sub(val.ToBig(), value)
 			_, isSelected := selectedProviders[strings.ToLower(provider)]
 			if !isSelected {
-				monitorBuilderBidDelta(provider, delta)
+				monitorBuilderBidDelta(s.relayLabel(provider), delta)
 			}
 			if s.logResults {
 				log.Info().Uint64("slot", uint64(slot)).Str("provider", provider).Stringer("value", value).Stringer("delta", delta).Bool("selected", isSelected).Msg("Auction participant")
@@ -109,23 +166,159 @@ func (s *Service) AuctionBlock(ctx context.Context,
 				log.Trace().Uint64("slot", uint64(slot)).Str("provider", provider).Stringer("value", value).Stringer("delta", delta).Bool("selected", isSelected).Msg("Auction participant")
 			}
 		}
+		s.trackUncompetitive(ctx, proposerConfig.Relays, res.Values, val.ToBig())
 	}
 
 	return res, nil
 }
 
+// logRelayOutcomes logs the outcome of the auction for each relay.
+func (s *Service) logRelayOutcomes(slot phase0.Slot, outcomes map[string]blockrelay.RelayOutcome) {
+	for relay, outcome := range outcomes {
+		if s.logResults {
+			log.Info().Uint64("slot", uint64(slot)).Str("relay", relay).Stringer("outcome", outcome).Msg("Auction relay outcome")
+		} else {
+			log.Trace().Uint64("slot", uint64(slot)).Str("relay", relay).Stringer("outcome", outcome).Msg("Auction relay outcome")
+		}
+	}
+}
+
+// recordWinningBidGas records the gas used and gas limit of the winning bid.
+func recordWinningBidGas(slot phase0.Slot, bid *builderspec.VersionedSignedBuilderBid) {
+	gasUsed, gasLimit, err := bidGas(bid)
+	if err != nil {
+		log.Warn().Uint64("slot", uint64(slot)).Err(err).Msg("Failed to obtain gas of winning bid")
+		return
+	}
+	log.Trace().Uint64("slot", uint64(slot)).Uint64("gas_used", gasUsed).Uint64("gas_limit", gasLimit).Msg("Winning bid gas")
+	monitorWinningBidGas(gasUsed, gasLimit)
+}
+
 type builderBidResponse struct {
-	provider builderclient.BuilderBidProvider
-	bid      *builderspec.VersionedSignedBuilderBid
-	score    *big.Int
+	provider      builderclient.BuilderBidProvider
+	bid           *builderspec.VersionedSignedBuilderBid
+	score         *big.Int
+	value         *big.Int
+	belowMinValue bool
+	// targetGasLimit is the gas limit configured for the relay.
+	targetGasLimit uint64
+}
+
+// noBidReason provides the reason that an auction selected no bid.
+func noBidReason(responded int, belowMinValue int) blockrelay.NoBidReason {
+	switch {
+	case belowMinValue > 0:
+		return blockrelay.NoBidReasonBelowMinValue
+	case responded > 0:
+		return blockrelay.NoBidReasonNoBids
+	default:
+		return blockrelay.NoBidReasonAllErrored
+	}
+}
+
+// processBidResponse updates the auction results with a bid response, returning the new best score.
+// Matching bids from different relays are retained in the order in which they arrived, up to the
+// maximum number of matching providers.  If configured, a different bid of equal value replaces the
+// current bid if only the new bid has the target gas limit.
+func (s *Service) processBidResponse(log zerolog.Logger,
+	res *blockauctioneer.Results,
+	bestScore *big.Int,
+	resp *builderBidResponse,
+) *big.Int {
+	switch {
+	case resp.score.Cmp(bestScore) > 0:
+		log.Trace().Str("provider", resp.provider.Address()).Stringer("score", resp.score).Msg("New winning bid")
+		res.Bid = resp.bid
+		bestScore = resp.score
+		res.Providers = []builderclient.BuilderBidProvider{resp.provider}
+	case res.Bid != nil && resp.score.Cmp(bestScore) == 0 && bidsEqual(res.Bid, resp.bid):
+		if len(res.Providers) >= s.maxMatchingProviders {
+			log.Trace().Str("provider", resp.provider.Address()).Msg("Matching bid from different relay; already have maximum matching providers so ignoring")
+			break
+		}
+		log.Trace().Str("provider", resp.provider.Address()).Msg("Matching bid from different relay")
+		res.Providers = append(res.Providers, resp.provider)
+	case res.Bid != nil && resp.score.Cmp(bestScore) == 0 && s.preferTargetGasLimit &&
+		hasGasLimit(resp.bid, resp.targetGasLimit) && !hasGasLimit(res.Bid, resp.targetGasLimit):
+		log.Trace().Str("provider", resp.provider.Address()).Uint64("target_gas_limit", resp.targetGasLimit).Msg("Equal value bid with target gas limit")
+		res.Bid = resp.bid
+		res.Providers = []builderclient.BuilderBidProvider{resp.provider}
+	default:
+		log.Trace().Str("provider", resp.provider.Address()).Stringer("score", resp.score).Msg("Low or slow bid")
+	}
+	res.Values[resp.provider.Address()] = resp.score
+
+	return bestScore
+}
+
+// auctionProgress tracks the responses and errors received from relays during an auction.
+type auctionProgress struct {
+	started           time.Time
+	queried           []string
+	responded         int
+	errored           int
+	timedOut          int
+	belowMinValue     int
+	bestScore         *big.Int
+	candidates        []*builderBidResponse
+	salvageCandidates []*salvageableBidError
+	// settled are the relays that have responded or errored.
+	settled map[string]struct{}
+	// outcomes are the outcomes of the auction for each relay.
+	outcomes map[string]blockrelay.RelayOutcome
+}
+
+// handleBidResponse updates the auction progress and results with a bid response.
+// It returns true if the response contained an eligible bid.
+func (s *Service) handleBidResponse(log zerolog.Logger,
+	res *blockauctioneer.Results,
+	progress *auctionProgress,
+	resp *builderBidResponse,
+) bool {
+	progress.responded++
+	progress.settled[resp.provider.Address()] = struct{}{}
+	log.Trace().Dur("elapsed", time.Since(progress.started)).Int("responded", progress.responded).Int("errored", progress.errored).Int("timed_out", progress.timedOut).Msg("Response received")
+	if resp.bid == nil {
+		// This means that the bid was ineligible, for example the bid value was too small.
+		progress.outcomes[resp.provider.Address()] = blockrelay.RelayOutcomeNoBid
+		if resp.belowMinValue {
+			progress.belowMinValue++
+			progress.outcomes[resp.provider.Address()] = blockrelay.RelayOutcomeBelowMinValue
+			if s.recordBelowMinValueBids {
+				res.Values[resp.provider.Address()] = resp.value
+			}
+		}
+
+		return false
+	}
+	progress.bestScore = s.processBidResponse(log, res, progress.bestScore, resp)
+	progress.candidates = append(progress.candidates, resp)
+
+	return true
+}
+
+// handleBidError updates the auction progress with an error from a relay.
+func (p *auctionProgress) handleBidError(log zerolog.Logger, err error) {
+	p.errored++
+	if relay := erroredRelay(err, p.queried); relay != "" {
+		p.settled[relay] = struct{}{}
+		p.outcomes[relay] = blockrelay.RelayOutcomeErrored
+	}
+	if candidate := salvageCandidate(err); candidate != nil {
+		p.salvageCandidates = append(p.salvageCandidates, candidate)
+	}
+	log.Debug().Dur("elapsed", time.Since(p.started)).Int("responded", p.responded).Int("errored", p.errored).Int("timed_out", p.timedOut).Err(err).Msg("Error received")
 }
 
 // bestBuilderBid provides the best builder bid from a number of relays.
+// If supplied, the record is updated with the values of the bids and the
+// reason that no bid was selected, if applicable.
 func (s *Service) bestBuilderBid(ctx context.Context,
 	slot phase0.Slot,
 	parentHash phase0.Hash32,
 	pubkey phase0.BLSPubKey,
 	proposerConfig *beaconblockproposer.ProposerConfig,
+	record *blockrelay.AuctionRecord,
 ) *blockauctioneer.Results {
 	ctx, span := otel.Tracer("attestantio.vouch.services.blockrelay.standard").Start(ctx, "bestBuilderBid")
 	defer span.End()
@@ -144,12 +337,18 @@ func (s *Service) bestBuilderBid(ctx context.Context,
 	// The soft timeout is half the duration of the hard timeout.
 	ctx, cancel := context.WithTimeout(ctx, s.timeout)
 	softCtx, softCancel := context.WithTimeout(ctx, s.timeout/2)
+	// Requests are abandoned if the auction is decided before they complete.
+	requestCtx, abandon := context.WithCancelCause(ctx)
+	defer abandon(nil)
 
 	respCh := make(chan *builderBidResponse, requests)
 	errCh := make(chan error, requests)
 	// Kick off the requests.
+	queried := make([]string, 0, requests)
+	// outcomes are the outcomes of the auction for each relay.
+	outcomes := make(map[string]blockrelay.RelayOutcome, requests)
 	for _, relay := range proposerConfig.Relays {
-		builderClient, err := util.FetchBuilderClient(ctx, relay.Address, s.monitor)
+		builderClient, err := util.FetchBuilderClient(ctx, relay.Address, s.monitor, s.builderClientIdleTimeout)
 		if err != nil {
 			// Error but continue.
 			log.Error().Err(err).Msg("Failed to obtain builder client for block auction")
@@ -161,106 +360,184 @@ func (s *Service) bestBuilderBid(ctx context.Context,
 			log.Error().Err(err).Msg("Builder client does not supply builder bids")
 			continue
 		}
-		go s.builderBid(ctx, provider, respCh, errCh, slot, parentHash, pubkey, relay)
+		queried = append(queried, provider.Address())
+		go s.builderBid(requestCtx, provider, respCh, errCh, slot, parentHash, pubkey, relay)
 	}
 
 	// Wait for all responses (or context done).
-	responded := 0
-	errored := 0
-	timedOut := 0
+	progress := &auctionProgress{
+		started:           started,
+		queried:           queried,
+		bestScore:         big.NewInt(0),
+		candidates:        make([]*builderBidResponse, 0, requests),
+		salvageCandidates: make([]*salvageableBidError, 0),
+		settled:           make(map[string]struct{}, requests),
+		outcomes:          outcomes,
+	}
 	softTimedOut := 0
-	bestScore := big.NewInt(0)
+	awaitingLateBids := false
+	// decided is set if the auction is decided by the first acceptable bid.
+	decided := false
 
 	// Loop 1: prior to soft timeout.
-	for responded+errored+timedOut+softTimedOut != requests {
+	for !decided && progress.responded+progress.errored+progress.timedOut+softTimedOut != requests {
 		select {
 		case resp := <-respCh:
-			responded++
-			log.Trace().Dur("elapsed", time.Since(started)).Int("responded", responded).Int("errored", errored).Int("timed_out", timedOut).Msg("Response received")
-			if resp.bid == nil {
-				// This means that the bid was ineligible, for example the bid value was too small.
-				continue
+			if s.handleBidResponse(log, res, progress, resp) {
+				decided = s.firstAcceptableBid
 			}
-			switch {
-			case resp.score.Cmp(bestScore) > 0:
-				log.Trace().Str("provider", resp.provider.Address()).Stringer("score", resp.score).Msg("New winning bid")
-				res.Bid = resp.bid
-				bestScore = resp.score
-				res.Providers = []builderclient.BuilderBidProvider{resp.provider}
-			case res.Bid != nil && resp.score.Cmp(bestScore) == 0 && bidsEqual(res.Bid, resp.bid):
-				log.Trace().Str("provider", resp.provider.Address()).Msg("Matching bid from different relay")
-				res.Providers = append(res.Providers, resp.provider)
-			default:
-				log.Trace().Str("provider", resp.provider.Address()).Stringer("score", resp.score).Msg("Low or slow bid")
-			}
-			res.Values[resp.provider.Address()] = resp.score
 		case err := <-errCh:
-			errored++
-			log.Debug().Dur("elapsed", time.Since(started)).Int("responded", responded).Int("errored", errored).Int("timed_out", timedOut).Err(err).Msg("Error received")
+			progress.handleBidError(log, err)
 		case <-softCtx.Done():
-			// If we have any responses at this point we consider the non-responders timed out.
-			if responded > 0 {
-				timedOut = requests - responded - errored
-				log.Debug().Dur("elapsed", time.Since(started)).Int("responded", responded).Int("errored", errored).Int("timed_out", timedOut).Msg("Soft timeout reached with responses")
-			} else {
-				log.Debug().Dur("elapsed", time.Since(started)).Int("errored", errored).Msg("Soft timeout reached with no responses")
+			// If we have any responses at this point we consider the non-responders timed out,
+			// unless we are allowing a window for late bids.
+			switch {
+			case progress.responded > 0 && s.lateBidWindow > 0:
+				awaitingLateBids = true
+				log.Debug().Dur("elapsed", time.Since(started)).Int("responded", progress.responded).Int("errored", progress.errored).Msg("Soft timeout reached with responses; waiting for late bids")
+			case progress.responded > 0:
+				progress.timedOut = requests - progress.responded - progress.errored
+				log.Debug().Dur("elapsed", time.Since(started)).Int("responded", progress.responded).Int("errored", progress.errored).Int("timed_out", progress.timedOut).Msg("Soft timeout reached with responses")
+			default:
+				log.Debug().Dur("elapsed", time.Since(started)).Int("errored", progress.errored).Msg("Soft timeout reached with no responses")
 			}
 			// Set the number of requests that have soft timed out.
-			softTimedOut = requests - responded - errored - timedOut
+			softTimedOut = requests - progress.responded - progress.errored - progress.timedOut
 		}
 	}
 	softCancel()
+	// Note if there is a winner prior to the soft timeout, to allow the benefit of
+	// waiting past the soft timeout to be measured.
+	decidedBeforeSoftTimeout := res.Bid != nil
+	improvedAfterSoftTimeout := false
+
+	// If we are waiting for late bids then the wait is bounded by the late bid window.
+	// The window is always shorter than the time remaining to the hard timeout, and
+	// the results are only returned to the proposer once it has closed, so a late
+	// bid can never change a result that the proposer has already acted upon.
+	waitCtx := ctx
+	if awaitingLateBids {
+		var lateCancel context.CancelFunc
+		waitCtx, lateCancel = context.WithTimeout(ctx, s.lateBidWindow)
+		defer lateCancel()
+	}
 
 	// Loop 2: after soft timeout.
-	for responded+errored+timedOut != requests {
+	for !decided && progress.responded+progress.errored+progress.timedOut != requests {
 		select {
 		case resp := <-respCh:
-			responded++
-			log.Trace().Dur("elapsed", time.Since(started)).Int("responded", responded).Int("errored", errored).Int("timed_out", timedOut).Msg("Response received")
-			if resp.bid == nil {
-				// This means that the bid was ineligible, for example the bid value was too small.
+			previousScore := progress.bestScore
+			if !s.handleBidResponse(log, res, progress, resp) {
 				continue
 			}
-			switch {
-			case resp.score.Cmp(bestScore) > 0:
-				log.Trace().Str("provider", resp.provider.Address()).Stringer("score", resp.score).Msg("New winning bid")
-				res.Bid = resp.bid
-				bestScore = resp.score
-				res.Providers = []builderclient.BuilderBidProvider{resp.provider}
-			case res.Bid != nil && resp.score.Cmp(bestScore) == 0 && bidsEqual(res.Bid, resp.bid):
-				log.Trace().Str("provider", resp.provider.Address()).Msg("Matching bid from different relay")
-				res.Providers = append(res.Providers, resp.provider)
-			default:
-				log.Trace().Str("provider", resp.provider.Address()).Stringer("score", resp.score).Msg("Low or slow bid")
+			if resp.score.Cmp(previousScore) > 0 {
+				improvedAfterSoftTimeout = true
+				if awaitingLateBids {
+					log.Debug().Str("provider", resp.provider.Address()).Stringer("score", resp.score).Stringer("previous_score", previousScore).Msg("Late bid improves auction result")
+					if !isDiagnostic(ctx) {
+						monitorLateBid()
+					}
+				}
 			}
-			res.Values[resp.provider.Address()] = resp.score
+			decided = s.firstAcceptableBid
 		case err := <-errCh:
-			errored++
-			log.Debug().Dur("elapsed", time.Since(started)).Int("responded", responded).Int("errored", errored).Int("timed_out", timedOut).Err(err).Msg("Error received")
-		case <-ctx.Done():
+			progress.handleBidError(log, err)
+		case <-waitCtx.Done():
 			// Anyone not responded by now is considered errored.
-			timedOut = requests - responded - errored
-			log.Debug().Dur("elapsed", time.Since(started)).Int("responded", responded).Int("errored", errored).Int("timed_out", timedOut).Msg("Hard timeout reached")
+			progress.timedOut = requests - progress.responded - progress.errored
+			if awaitingLateBids {
+				log.Debug().Dur("elapsed", time.Since(started)).Int("responded", progress.responded).Int("errored", progress.errored).Int("timed_out", progress.timedOut).Msg("Late bid window closed")
+			} else {
+				log.Debug().Dur("elapsed", time.Since(started)).Int("responded", progress.responded).Int("errored", progress.errored).Int("timed_out", progress.timedOut).Msg("Hard timeout reached")
+			}
+		}
+	}
+	var abandoned []string
+	if decided {
+		abandoned = abandonedRelays(queried, progress.settled)
+		abandon(errAuctionDecided)
+		log.Debug().Dur("elapsed", time.Since(started)).Strs("abandoned", abandoned).Msg("Auction decided by first acceptable bid")
+		if !isDiagnostic(ctx) {
+			for _, relay := range abandoned {
+				monitorAuctionAbandoned(s.relayLabel(relay))
+			}
 		}
 	}
 	cancel()
-	log.Trace().Dur("elapsed", time.Since(started)).Int("responded", responded).Int("errored", errored).Int("timed_out", timedOut).Msg("Results")
+	log.Trace().Dur("elapsed", time.Since(started)).Int("responded", progress.responded).Int("errored", progress.errored).Int("timed_out", progress.timedOut).Msg("Results")
+	if res.Bid == nil && len(progress.salvageCandidates) > 0 {
+		// Last resort: no valid bids, but some bids failed only relaxed validations.
+		s.salvageBid(ctx, log, res, progress.salvageCandidates)
+	}
+	if res.Bid != nil {
+		s.recordWinnerTiming(ctx, span, decidedBeforeSoftTimeout, improvedAfterSoftTimeout)
+	}
+
+	if !isDiagnostic(ctx) {
+		s.trackEqualBids(progress.candidates)
+	}
+
+	if res.Bid != nil && s.weightedSelectionMargin > 0 {
+		s.weightedSelection(log, slot, res, progress.bestScore, progress.candidates)
+	}
+
+	if record != nil {
+		for provider, value := range res.Values {
+			record.Values[provider] = value
+		}
+		if len(abandoned) > 0 {
+			record.Abandoned = abandoned
+		}
+		record.Outcomes = relayOutcomes(queried, outcomes, progress.candidates, res, abandoned)
+	}
 
 	if res.Bid == nil {
-		log.Debug().Msg("No useful bids received")
-		monitorAuctionBlock("", false, time.Since(started))
+		reason := noBidReason(progress.responded, progress.belowMinValue)
+		log.Debug().Stringer("reason", reason).Msg("No useful bids received")
+		if record != nil {
+			record.NoBidReason = reason
+		}
+		if !isDiagnostic(ctx) && !deferNoBid(ctx, reason) {
+			monitorAuctionBlock("", false, time.Since(started))
+			s.noBidHandler.NoBid(ctx, slot, pubkey, reason)
+		}
 		return nil
 	}
 
 	log.Trace().Stringer("bid", res.Bid).Msg("Selected best bid")
 
-	for _, provider := range res.Providers {
-		monitorAuctionBlock(provider.Address(), true, time.Since(started))
+	if !isDiagnostic(ctx) {
+		for _, provider := range res.Providers {
+			monitorAuctionBlock(s.relayLabel(provider.Address()), true, time.Since(started))
+		}
 	}
 
 	return res
 }
 
+// recordWinnerTiming records if the winner of an auction was decided before the
+// soft timeout, or improved upon after it.
+func (*Service) recordWinnerTiming(ctx context.Context,
+	span trace.Span,
+	decidedBeforeSoftTimeout bool,
+	improvedAfterSoftTimeout bool,
+) {
+	timing := "before_soft_timeout"
+	switch {
+	case decidedBeforeSoftTimeout && improvedAfterSoftTimeout:
+		timing = "improved_after_soft_timeout"
+		span.AddEvent("winner improved after soft timeout")
+	case improvedAfterSoftTimeout:
+		timing = "after_soft_timeout"
+		span.AddEvent("winner decided after soft timeout")
+	default:
+		span.AddEvent("winner decided before soft timeout")
+	}
+	if !isDiagnostic(ctx) {
+		monitorAuctionWinnerTiming(timing)
+	}
+}
+
 func (s *Service) builderBid(ctx context.Context,
 	provider builderclient.BuilderBidProvider,
 	respCh chan *builderBidResponse,
@@ -279,14 +556,43 @@ func (s *Service) builderBid(ctx context.Context,
 		time.Sleep(relayConfig.Grace)
 		span.AddEvent("grace period over")
 	}
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline && !isDiagnostic(ctx) {
+		// Record how much of the time available for the request remains
+		// once grace has been applied, to help with tuning grace periods.
+		monitorRelayGrace(s.relayLabel(provider.Address()), relayConfig.Grace, time.Until(deadline))
+	}
+
+	// Any exit without a response is an error, so track that for the relay.
+	// Requests abandoned because the auction has been decided are not the
+	// fault of the relay, so are not tracked.
+	succeeded := false
+	if !isDiagnostic(ctx) {
+		defer func() {
+			if !succeeded && isAbandoned(ctx) {
+				return
+			}
+			s.trackRelayError(provider.Address(), !succeeded)
+		}()
+	}
 
 	log := log.With().Str("bidder", provider.Address()).Logger()
+	// relaxedValidations are validations that the bid failed, but which have
+	// been relaxed to allow the bid to be salvaged if no other bid is available.
+	relaxedValidations := make([]string, 0)
+	requestStarted := time.Now()
 	builderBid, err := provider.BuilderBid(ctx, slot, parentHash, pubkey)
+	s.recordProposalPhase(ctx, span, blockrelay.ProposalPhaseRelayRequest, time.Since(requestStarted), attribute.String("relay", provider.Address()))
 	if err != nil {
 		errCh <- errors.Wrap(err, provider.Address())
 		return
 	}
+	// Validation covers the remainder of the operation, whatever its result.
+	validationStarted := time.Now()
+	defer func() {
+		s.recordProposalPhase(ctx, span, blockrelay.ProposalPhaseValidation, time.Since(validationStarted), attribute.String("relay", provider.Address()))
+	}()
 	if builderBid == nil {
+		succeeded = true
 		respCh <- &builderBidResponse{
 			provider: provider,
 			score:    big.NewInt(0),
@@ -305,6 +611,13 @@ func (s *Service) builderBid(ctx context.Context,
 		errCh <- fmt.Errorf("%s: builder bid empty", provider.Address())
 		return
 	}
+	if relayConfig.ExpectedVersion != nil && builderBid.Version != *relayConfig.ExpectedVersion {
+		// The relay may be running different software to that expected, so flag it.
+		log.Warn().Stringer("expected_version", *relayConfig.ExpectedVersion).Stringer("version", builderBid.Version).Msg("Bid version does not match that expected for relay")
+		if !isDiagnostic(ctx) {
+			monitorVersionMismatch(s.relayLabel(provider.Address()))
+		}
+	}
 
 	value, err := builderBid.Value()
 	if err != nil {
@@ -312,14 +625,45 @@ func (s *Service) builderBid(ctx context.Context,
 		return
 	}
 	if zeroValue.Cmp(value) == 0 {
+		if s.zeroValueAsNoBid {
+			log.Debug().Msg("Zero value; treating as no bid")
+			succeeded = true
+			respCh <- &builderBidResponse{
+				provider: provider,
+				score:    big.NewInt(0),
+			}
+			return
+		}
 		errCh <- fmt.Errorf("%s: zero value", provider.Address())
 		return
 	}
-	if value.ToBig().Cmp(relayConfig.MinValue.BigInt()) < 0 {
-		log.Debug().Stringer("value", value.ToBig()).Stringer("min_value", relayConfig.MinValue.BigInt()).Msg("Value below minimum; ignoring")
+	minValue := relayConfig.MinValue.BigInt()
+	if s.baseFeeFloorGas > 0 {
+		// The floor scales with the base fee, so that it remains meaningful as network conditions change.
+		baseFeePerGas, err := bidBaseFeePerGas(builderBid)
+		if err != nil {
+			errCh <- fmt.Errorf("%s: base fee per gas: %w", provider.Address(), err)
+			return
+		}
+		baseFeeFloor := new(big.Int).Mul(baseFeePerGas, new(big.Int).SetUint64(s.baseFeeFloorGas))
+		if baseFeeFloor.Cmp(minValue) > 0 {
+			minValue = baseFeeFloor
+		}
+	}
+	if !isDiagnostic(ctx) {
+		s.trackJustBelowMinValue(provider.Address(), value.ToBig(), minValue)
+	}
+	if value.ToBig().Cmp(minValue) < 0 {
+		log.Debug().Stringer("value", value.ToBig()).Stringer("min_value", minValue).Msg("Value below minimum; ignoring")
+		if !isDiagnostic(ctx) {
+			monitorBelowMinValue(s.relayLabel(provider.Address()))
+		}
+		succeeded = true
 		respCh <- &builderBidResponse{
-			provider: provider,
-			score:    big.NewInt(0),
+			provider:      provider,
+			score:         big.NewInt(0),
+			value:         value.ToBig(),
+			belowMinValue: true,
 		}
 		return
 	}
@@ -334,15 +678,50 @@ func (s *Service) builderBid(ctx context.Context,
 		return
 	}
 
+	prevRandao, err := bidPrevRandao(builderBid)
+	if err != nil {
+		errCh <- fmt.Errorf("%s: prev randao: %w", provider.Address(), err)
+		return
+	}
+	if bytes.Equal(prevRandao[:], zeroPrevRandao[:]) {
+		errCh <- fmt.Errorf("%s: zero prev randao", provider.Address())
+		return
+	}
+
+	if s.rejectEmptyBlocks {
+		// The header only provides the root of the transactions, so the only
+		// count that can be determined is whether or not the block is empty.
+		transactionsRoot, err := builderBid.TransactionsRoot()
+		if err != nil {
+			errCh <- fmt.Errorf("%s: transactions root: %w", provider.Address(), err)
+			return
+		}
+		if bytes.Equal(transactionsRoot[:], emptyTransactionsRoot[:]) {
+			if !s.salvageValidations[salvageValidationEmptyBlock] {
+				errCh <- fmt.Errorf("%s: no transactions", provider.Address())
+				return
+			}
+			relaxedValidations = append(relaxedValidations, salvageValidationEmptyBlock)
+		}
+	}
+
 	timestamp, err := builderBid.Timestamp()
 	if err != nil {
 		errCh <- fmt.Errorf("%s: timestamp: %w", provider.Address(), err)
 		return
 	}
-	if uint64(s.chainTime.StartOfSlot(slot).Unix()) != timestamp {
-		errCh <- fmt.Errorf("%s: provided timestamp %d for slot %d not expected value of %d", provider.Address(), timestamp, slot, s.chainTime.StartOfSlot(slot).Unix())
+	expectedTimestamp := s.chainTime.StartOfSlot(slot).Unix()
+	if uint64(expectedTimestamp) != timestamp {
+		if !isDiagnostic(ctx) {
+			s.checkClockSkew(slot, provider.Address(), int64(timestamp)-expectedTimestamp)
+		}
+		errCh <- fmt.Errorf("%s: provided timestamp %d for slot %d not expected value of %d", provider.Address(), timestamp, slot, expectedTimestamp)
 		return
 	}
+	if !isDiagnostic(ctx) {
+		s.clockSkew.match(slot)
+		monitorClockSkewSuspected(false)
+	}
 
 	verified, err := s.verifyBidSignature(ctx, relayConfig, builderBid, provider)
 	if err != nil {
@@ -355,11 +734,37 @@ func (s *Service) builderBid(ctx context.Context,
 		return
 	}
 
-	respCh <- &builderBidResponse{
-		bid:      builderBid,
-		provider: provider,
-		score:    value.ToBig(),
+	if err := s.bidValidator.Validate(ctx, builderBid, relayConfig); err != nil {
+		errCh <- fmt.Errorf("%s: bid rejected: %w", provider.Address(), err)
+		return
+	}
+
+	resp := &builderBidResponse{
+		bid:            builderBid,
+		provider:       provider,
+		score:          value.ToBig(),
+		targetGasLimit: relayConfig.GasLimit,
 	}
+	if len(relaxedValidations) > 0 {
+		// The bid is only usable if the auction has no valid bids, so it is still an error.
+		log.Debug().Strs("relaxed_validations", relaxedValidations).Msg("Bid failed relaxed validations; holding for salvage")
+		errCh <- &salvageableBidError{
+			resp:        resp,
+			validations: relaxedValidations,
+		}
+		return
+	}
+
+	succeeded = true
+	respCh <- resp
+}
+
+// checkClockSkew checks to see if a timestamp mismatch suggests that the local clock is skewed.
+func (s *Service) checkClockSkew(slot phase0.Slot, provider string, offset int64) {
+	if s.clockSkew.mismatch(slot, provider, offset) {
+		log.Warn().Uint64("slot", uint64(slot)).Int64("offset", offset).Msg("Multiple relays provided bids with the same timestamp offset; local clock may be skewed")
+	}
+	monitorClockSkewSuspected(s.clockSkew.isSuspected())
 }
 
 // verifyBidSignature verifies the signature of a bid to ensure it comes from the expected source.
@@ -384,6 +789,12 @@ func (s *Service) verifyBidSignature(_ context.Context,
 		}
 	}
 
+	if bytes.Equal(s.applicationBuilderDomain[:], zeroDomain[:]) {
+		// Should never happen, as the domain is checked at startup, but avoid
+		// reporting every bid as having an invalid signature if it does.
+		return false, errors.New("application builder domain not available")
+	}
+
 	s.relayPubkeysMu.RLock()
 	pubkey, exists := s.relayPubkeys[*relayPubkey]
 	s.relayPubkeysMu.RUnlock()
@@ -17,18 +17,198 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
 	builderclient "github.com/attestantio/go-builder-client"
 	builderspec "github.com/attestantio/go-builder-client/spec"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/mock"
 	"github.com/attestantio/vouch/services/beaconblockproposer"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/attestantio/vouch/testutil"
+	"github.com/holiman/uint256"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	e2types "github.com/wealdtech/go-eth2-types/v2"
+	e2wallet "github.com/wealdtech/go-eth2-wallet"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	nd "github.com/wealdtech/go-eth2-wallet-nd/v2"
+	scratch "github.com/wealdtech/go-eth2-wallet-store-scratch"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
+// testBidJSON is a valid bid for slot 0 of a chain with genesis at testBidTimestamp.
+var testBidJSON = []byte(`{"version":"BELLATRIX","data":{"message":{"header":{"parent_hash":"0x15b38d69d54789359784bd2826d2811e938e6abf87588ab75d0e62857494771a","fee_recipient":"0x320715b08bcf4cac1df2c55288a6bad79da1566b","state_root":"0xa47d81eb2717c3e2ae136e82e1242c4b350cda041f189aac422a16a9a7c6fca5","receipts_root":"0xd080a066ff223b1c759709fa9cd8d9105952cb7a5b231beafe683f964e2ab0d4","logs_bloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","prev_randao":"0x924ac8e956cf60a79b10ed4087c4678862eae91c0c9c50c768eeb3ee852786de","block_number":"2229624","gas_limit":"30000000","gas_used":"42000","timestamp":"1667652084","extra_data":"0x496c6c756d696e61746520446d6f63726174697a6520447374726962757465","base_fee_per_gas":"7","block_hash":"0xf843fff3b010a668e97a7958a1fab678ce34b06dc394452df17dad43a0f8a9ad","transactions_root":"0x6febb1545754c4ebcf3335dad815f2380289156ef264f72a69260535cdcad4e8"},"value":"52499999853000","pubkey":"0x845bd072b7cd566f02faeb0a4033ce9399e42839ced64e8b2adcfc859ed1e8e1a5a293336a49feac6d9a5edb779be53a"},"signature":"0x877681cc963750f3b63968baded23994f4e460b8b38a9ea11ba4c2fe0aba6c3902004248ac61c914092641b743fff44303ddff9e82be46da780ebff0fa777867424dc8e3b5bfe2b2484651dab270676cd4edf105508651cbd62f544f53b74191"}}`)
+
+// testBidTimestamp is the timestamp of testBidJSON.
+const testBidTimestamp = 1667652084
+
+// testBid returns a copy of the test bid.
+func testBid(t *testing.T) *builderspec.VersionedSignedBuilderBid {
+	t.Helper()
+	bid := &builderspec.VersionedSignedBuilderBid{}
+	require.NoError(t, json.Unmarshal(testBidJSON, bid))
+	return bid
+}
+
+// testAuctionService returns a minimal service suitable for running auctions against test bids.
+func testAuctionService(t *testing.T) *Service {
+	t.Helper()
+	ctx := context.Background()
+
+	require.NoError(t, e2types.InitBLS())
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Unix(testBidTimestamp, 0))),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	return &Service{
+		chainTime:              chainTime,
+		timeout:                time.Second,
+		relayPubkeys:           make(map[phase0.BLSPubKey]*e2types.BLSPublicKey),
+		bidValidator:           &nullBidValidator{},
+		clockSkew:              newClockSkewDetector(),
+		maxMatchingProviders:   3,
+		auditSink:              &nullAuditSink{},
+		uncompetitive:          newUncompetitiveDetector(10),
+		errorRate:              newErrorRateDetector(20, 0.5),
+		equalBids:              newEqualBidDetector(20, 0.9),
+		postSelectionValidator: &nullPostSelectionValidator{},
+		randomSource:           &slotRandomSource{},
+	}
+}
+
+// runBuilderBid runs builderBid for the given provider, returning either the response or the error.
+func runBuilderBid(ctx context.Context,
+	s *Service,
+	provider builderclient.BuilderBidProvider,
+	relayConfig *beaconblockproposer.RelayConfig,
+) (
+	*builderBidResponse,
+	error,
+) {
+	respCh := make(chan *builderBidResponse, 1)
+	errCh := make(chan error, 1)
+	s.builderBid(ctx, provider, respCh, errCh, 0, phase0.Hash32{}, phase0.BLSPubKey{}, relayConfig)
+	select {
+	case resp := <-respCh:
+		return resp, nil
+	case err := <-errCh:
+		return nil, err
+	}
+}
+
+// testParentHash is the parent hash of testBidJSON.
+func testParentHash() phase0.Hash32 {
+	parentHash := phase0.Hash32{}
+	copy(parentHash[:], testutil.HexToBytes("0x15b38d69d54789359784bd2826d2811e938e6abf87588ab75d0e62857494771a"))
+	return parentHash
+}
+
+// testAccount returns an interop validator account and its public key.
+func testAccount(t *testing.T) (e2wtypes.Account, phase0.BLSPubKey) {
+	t.Helper()
+	ctx := context.Background()
+
+	require.NoError(t, e2types.InitBLS())
+	store := scratch.New()
+	require.NoError(t, e2wallet.UseStore(store))
+	testWallet, err := nd.CreateWallet(ctx, "Test wallet", store, keystorev4.New())
+	require.NoError(t, err)
+	require.NoError(t, testWallet.(e2wtypes.WalletLocker).Unlock(ctx, nil))
+	account, err := testWallet.(e2wtypes.WalletAccountImporter).ImportAccount(ctx,
+		"Interop 0",
+		testutil.HexToBytes("0x25295f0d1d592a90b333e26e85149708208e9f8e8bc18f6c77bd62f8ad7a6866"),
+		[]byte("pass"),
+	)
+	require.NoError(t, err)
+	var pubkey phase0.BLSPubKey
+	copy(pubkey[:], account.PublicKey().Marshal())
+
+	return account, pubkey
+}
+
+// testProposerAuctionService returns a service with a single validating account,
+// suitable for running full auctions for that account.
+func testProposerAuctionService(t *testing.T) (*Service, phase0.BLSPubKey) {
+	t.Helper()
+
+	s := testAuctionService(t)
+	account, pubkey := testAccount(t)
+	s.accountsProvider = &testAccountsProvider{
+		accounts: map[phase0.BLSPubKey]e2wtypes.Account{
+			pubkey: account,
+		},
+	}
+	s.fallbackFeeRecipient = bellatrix.ExecutionAddress{0x01}
+	s.fallbackGasLimit = 30000000
+	s.noBidHandler = &recordingNoBidHandler{}
+	s.builderBidsCache = make(map[string]map[string]*builderspec.VersionedSignedBuilderBid)
+
+	return s, pubkey
+}
+
+// setTestTimeout sets the timeout used by builder clients for the duration of the test.
+func setTestTimeout(t *testing.T) {
+	t.Helper()
+
+	timeout := viper.Get("timeout")
+	viper.Set("timeout", time.Second)
+	t.Cleanup(func() {
+		viper.Set("timeout", timeout)
+	})
+}
+
+// testBidRelay is a relay that returns testBidJSON for all requests.
+type testBidRelay struct {
+	*httptest.Server
+	requestsMu sync.Mutex
+	requests   int
+}
+
+// newTestBidRelay starts a relay that returns testBidJSON for the duration of the test.
+func newTestBidRelay(t *testing.T) *testBidRelay {
+	t.Helper()
+
+	setTestTimeout(t)
+	relay := &testBidRelay{}
+	relay.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		relay.requestsMu.Lock()
+		relay.requests++
+		relay.requestsMu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(testBidJSON)
+	}))
+	t.Cleanup(relay.Close)
+
+	return relay
+}
+
+// Requests returns the number of requests received by the relay.
+func (r *testBidRelay) Requests() int {
+	r.requestsMu.Lock()
+	defer r.requestsMu.Unlock()
+	return r.requests
+}
+
 func pubkey(input string) *phase0.BLSPubKey {
 	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
 	if err != nil {
@@ -134,3 +314,438 @@ func TestVerifyBidSignature(t *testing.T) {
 		})
 	}
 }
+
+// gasUsedBidValidator rejects bids that use less than a given amount of gas.
+type gasUsedBidValidator struct {
+	minGasUsed uint64
+}
+
+func (v *gasUsedBidValidator) Validate(_ context.Context,
+	bid *builderspec.VersionedSignedBuilderBid,
+	_ *beaconblockproposer.RelayConfig,
+) error {
+	if bid.Bellatrix == nil {
+		return errors.New("unsupported bid version")
+	}
+	if bid.Bellatrix.Message.Header.GasUsed < v.minGasUsed {
+		return errors.New("insufficient gas used")
+	}
+	return nil
+}
+
+func TestBuilderBidValidator(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		validator *gasUsedBidValidator
+		err       string
+	}{
+		{
+			name:      "Accepted",
+			validator: &gasUsedBidValidator{minGasUsed: 42000},
+		},
+		{
+			name:      "Rejected",
+			validator: &gasUsedBidValidator{minGasUsed: 42001},
+			err:       "relay: bid rejected: insufficient gas used",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testAuctionService(t)
+			s.bidValidator = test.validator
+			provider := &mock.BuilderClient{
+				MockAddress: "relay",
+				MockBid:     testBid(t),
+			}
+			resp, err := runBuilderBid(ctx, s, provider, &beaconblockproposer.RelayConfig{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp.bid)
+				require.Equal(t, big.NewInt(52499999853000), resp.score)
+			}
+		})
+	}
+}
+
+func TestProcessBidResponseMaxMatchingProviders(t *testing.T) {
+	bid := testBid(t)
+
+	tests := []struct {
+		name                 string
+		maxMatchingProviders int
+		relays               int
+		providers            []string
+	}{
+		{
+			name:                 "Single",
+			maxMatchingProviders: 1,
+			relays:               10,
+			providers:            []string{"relay0"},
+		},
+		{
+			name:                 "BelowCap",
+			maxMatchingProviders: 5,
+			relays:               3,
+			providers:            []string{"relay0", "relay1", "relay2"},
+		},
+		{
+			name:                 "Capped",
+			maxMatchingProviders: 3,
+			relays:               20,
+			providers:            []string{"relay0", "relay1", "relay2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testAuctionService(t)
+			s.maxMatchingProviders = test.maxMatchingProviders
+			res := &blockauctioneer.Results{
+				Values:    make(map[string]*big.Int),
+				Providers: make([]builderclient.BuilderBidProvider, 0),
+			}
+			bestScore := big.NewInt(0)
+			for i := 0; i < test.relays; i++ {
+				bestScore = s.processBidResponse(log, res, bestScore, &builderBidResponse{
+					provider: &mock.BuilderClient{MockAddress: fmt.Sprintf("relay%d", i)},
+					bid:      bid,
+					score:    big.NewInt(52499999853000),
+				})
+			}
+
+			providers := make([]string, 0, len(res.Providers))
+			for _, provider := range res.Providers {
+				providers = append(providers, provider.Address())
+			}
+			require.Equal(t, test.providers, providers)
+			require.Len(t, res.Values, test.relays)
+
+			// A better bid replaces all existing providers.
+			s.processBidResponse(log, res, bestScore, &builderBidResponse{
+				provider: &mock.BuilderClient{MockAddress: "better"},
+				bid:      bid,
+				score:    big.NewInt(52499999853001),
+			})
+			require.Len(t, res.Providers, 1)
+			require.Equal(t, "better", res.Providers[0].Address())
+		})
+	}
+}
+
+func TestProcessBidResponseTargetGasLimit(t *testing.T) {
+	// Two bids of equal value, differing only in gas limit.
+	bid30M := testBid(t)
+	bid36M := testBid(t)
+	bid36M.Bellatrix.Message.Header.GasLimit = 36000000
+	score := big.NewInt(52499999853000)
+
+	tests := []struct {
+		name                 string
+		preferTargetGasLimit bool
+		targetGasLimit       uint64
+		provider             string
+	}{
+		{
+			name:           "Disabled",
+			targetGasLimit: 36000000,
+			provider:       "first",
+		},
+		{
+			name:                 "FirstMatchesTarget",
+			preferTargetGasLimit: true,
+			targetGasLimit:       30000000,
+			provider:             "first",
+		},
+		{
+			name:                 "SecondMatchesTarget",
+			preferTargetGasLimit: true,
+			targetGasLimit:       36000000,
+			provider:             "second",
+		},
+		{
+			name:                 "NeitherMatchesTarget",
+			preferTargetGasLimit: true,
+			targetGasLimit:       45000000,
+			provider:             "first",
+		},
+		{
+			name:                 "NoTarget",
+			preferTargetGasLimit: true,
+			provider:             "first",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testAuctionService(t)
+			s.preferTargetGasLimit = test.preferTargetGasLimit
+			res := &blockauctioneer.Results{
+				Values:    make(map[string]*big.Int),
+				Providers: make([]builderclient.BuilderBidProvider, 0),
+			}
+			bestScore := s.processBidResponse(log, res, big.NewInt(0), &builderBidResponse{
+				provider:       &mock.BuilderClient{MockAddress: "first"},
+				bid:            bid30M,
+				score:          score,
+				targetGasLimit: test.targetGasLimit,
+			})
+			s.processBidResponse(log, res, bestScore, &builderBidResponse{
+				provider:       &mock.BuilderClient{MockAddress: "second"},
+				bid:            bid36M,
+				score:          score,
+				targetGasLimit: test.targetGasLimit,
+			})
+
+			require.Len(t, res.Providers, 1)
+			require.Equal(t, test.provider, res.Providers[0].Address())
+			if test.provider == "second" {
+				require.Equal(t, bid36M, res.Bid)
+			} else {
+				require.Equal(t, bid30M, res.Bid)
+			}
+			require.Len(t, res.Values, 2)
+		})
+	}
+}
+
+func TestBuilderBidPrevRandao(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		prevRandao [32]byte
+		err        string
+	}{
+		{
+			name:       "Good",
+			prevRandao: testBid(t).Bellatrix.Message.Header.PrevRandao,
+		},
+		{
+			name: "Zero",
+			err:  "relay: zero prev randao",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testAuctionService(t)
+			bid := testBid(t)
+			bid.Bellatrix.Message.Header.PrevRandao = test.prevRandao
+			provider := &mock.BuilderClient{
+				MockAddress: "relay",
+				MockBid:     bid,
+			}
+			resp, err := runBuilderBid(ctx, s, provider, &beaconblockproposer.RelayConfig{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp.bid)
+			}
+		})
+	}
+}
+
+func TestBuilderBidRejectEmptyBlocks(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		rejectEmptyBlocks bool
+		transactionsRoot  phase0.Root
+		err               string
+	}{
+		{
+			name:             "DisabledEmpty",
+			transactionsRoot: emptyTransactionsRoot,
+		},
+		{
+			name:              "EnabledNotEmpty",
+			rejectEmptyBlocks: true,
+			transactionsRoot:  testBid(t).Bellatrix.Message.Header.TransactionsRoot,
+		},
+		{
+			name:              "EnabledEmpty",
+			rejectEmptyBlocks: true,
+			transactionsRoot:  emptyTransactionsRoot,
+			err:               "relay: no transactions",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testAuctionService(t)
+			s.rejectEmptyBlocks = test.rejectEmptyBlocks
+			bid := testBid(t)
+			bid.Bellatrix.Message.Header.TransactionsRoot = test.transactionsRoot
+			provider := &mock.BuilderClient{
+				MockAddress: "relay",
+				MockBid:     bid,
+			}
+			resp, err := runBuilderBid(ctx, s, provider, &beaconblockproposer.RelayConfig{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp.bid)
+			}
+		})
+	}
+}
+
+func TestBuilderBidBelowMinValue(t *testing.T) {
+	ctx := context.Background()
+
+	s := testAuctionService(t)
+	provider := &mock.BuilderClient{
+		MockAddress: "relay",
+		MockBid:     testBid(t),
+	}
+	resp, err := runBuilderBid(ctx, s, provider, &beaconblockproposer.RelayConfig{
+		MinValue: decimal.New(1, 18),
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp.bid)
+	require.True(t, resp.belowMinValue)
+	require.Equal(t, big.NewInt(0), resp.score)
+	require.Equal(t, big.NewInt(52499999853000), resp.value)
+}
+
+func TestBuilderBidZeroValue(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name             string
+		zeroValueAsNoBid bool
+		err              string
+	}{
+		{
+			name: "Error",
+			err:  "relay: zero value",
+		},
+		{
+			name:             "NoBid",
+			zeroValueAsNoBid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testAuctionService(t)
+			s.zeroValueAsNoBid = test.zeroValueAsNoBid
+			bid := testBid(t)
+			bid.Bellatrix.Message.Value = uint256.NewInt(0)
+			provider := &mock.BuilderClient{
+				MockAddress: "relay",
+				MockBid:     bid,
+			}
+			resp, err := runBuilderBid(ctx, s, provider, &beaconblockproposer.RelayConfig{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Nil(t, resp.bid)
+			require.False(t, resp.belowMinValue)
+			require.Equal(t, big.NewInt(0), resp.score)
+		})
+	}
+}
+
+func TestBuilderBidExpectedVersion(t *testing.T) {
+	ctx := context.Background()
+
+	bellatrix := consensusspec.DataVersionBellatrix
+	capella := consensusspec.DataVersionCapella
+
+	tests := []struct {
+		name            string
+		expectedVersion *consensusspec.DataVersion
+		mismatch        bool
+	}{
+		{
+			name: "NotSpecified",
+		},
+		{
+			name:            "Match",
+			expectedVersion: &bellatrix,
+		},
+		{
+			name:            "Mismatch",
+			expectedVersion: &capella,
+			mismatch:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewModuleLogCapture(t, &log)
+
+			s := testAuctionService(t)
+			provider := &mock.BuilderClient{
+				MockAddress: "relay",
+				MockBid:     testBid(t),
+			}
+			resp, err := runBuilderBid(ctx, s, provider, &beaconblockproposer.RelayConfig{
+				ExpectedVersion: test.expectedVersion,
+			})
+			// A version mismatch is flagged but the bid is still used.
+			require.NoError(t, err)
+			require.NotNil(t, resp.bid)
+			require.Equal(t, test.mismatch, capture.HasLog(map[string]interface{}{
+				"message":          "Bid version does not match that expected for relay",
+				"expected_version": "capella",
+				"version":          "bellatrix",
+			}))
+		})
+	}
+}
+
+func TestRecordWinnerTiming(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name                     string
+		decidedBeforeSoftTimeout bool
+		improvedAfterSoftTimeout bool
+		event                    string
+	}{
+		{
+			name:                     "BeforeSoftTimeout",
+			decidedBeforeSoftTimeout: true,
+			event:                    "winner decided before soft timeout",
+		},
+		{
+			name:                     "ImprovedAfterSoftTimeout",
+			decidedBeforeSoftTimeout: true,
+			improvedAfterSoftTimeout: true,
+			event:                    "winner improved after soft timeout",
+		},
+		{
+			name:                     "AfterSoftTimeout",
+			improvedAfterSoftTimeout: true,
+			event:                    "winner decided after soft timeout",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			_, span := provider.Tracer("test").Start(ctx, "test")
+			s := testAuctionService(t)
+			s.recordWinnerTiming(ctx, span, test.decidedBeforeSoftTimeout, test.improvedAfterSoftTimeout)
+			span.End()
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			require.Len(t, spans[0].Events(), 1)
+			require.Equal(t, test.event, spans[0].Events()[0].Name)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/attestantio/vouch/services/blockrelay"
+)
+
+// auctionHistory is a fixed-size ring buffer of recent auction records.
+type auctionHistory struct {
+	mu      sync.Mutex
+	records []*blockrelay.AuctionRecord
+	// next is the position at which the next record will be written.
+	next int
+	full bool
+}
+
+// newAuctionHistory creates a new auction history holding the given number of records.
+func newAuctionHistory(size int) *auctionHistory {
+	return &auctionHistory{
+		records: make([]*blockrelay.AuctionRecord, size),
+	}
+}
+
+// AuditAuction records the decision made by an auction, overwriting the
+// oldest record if the history is full.
+func (h *auctionHistory) AuditAuction(_ context.Context, record *blockrelay.AuctionRecord) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.records[h.next] = record
+	h.next++
+	if h.next == len(h.records) {
+		h.next = 0
+		h.full = true
+	}
+}
+
+// history returns the records held, oldest first.
+func (h *auctionHistory) history() []*blockrelay.AuctionRecord {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.full {
+		res := make([]*blockrelay.AuctionRecord, h.next)
+		copy(res, h.records[:h.next])
+		return res
+	}
+
+	res := make([]*blockrelay.AuctionRecord, 0, len(h.records))
+	res = append(res, h.records[h.next:]...)
+	res = append(res, h.records[:h.next]...)
+
+	return res
+}
+
+// recordAuction records the decision made by an auction.
+func (s *Service) recordAuction(ctx context.Context, record *blockrelay.AuctionRecord) {
+	record.Duration = time.Since(record.Started)
+	if s.auctionHistory != nil {
+		s.auctionHistory.AuditAuction(ctx, record)
+	}
+	if sink, isSink := s.auditSink.(blockrelay.AuctionAuditSink); isSink {
+		sink.AuditAuction(ctx, record)
+	}
+}
+
+// AuctionHistory returns the records of recent auctions, oldest first.
+func (s *Service) AuctionHistory(_ context.Context) []*blockrelay.AuctionRecord {
+	if s.auctionHistory == nil {
+		return []*blockrelay.AuctionRecord{}
+	}
+
+	return s.auctionHistory.history()
+}
+
+// AuctionHistoryHandler returns an HTTP handler that provides the records of
+// recent auctions as JSON.
+func (s *Service) AuctionHistoryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := json.Marshal(s.AuctionHistory(r.Context()))
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to marshal auction history")
+			http.Error(w, "failed to marshal auction history", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(data); err != nil {
+			log.Debug().Err(err).Msg("Failed to write auction history")
+		}
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/blockrelay"
+	v2 "github.com/attestantio/vouch/services/blockrelay/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func historySlots(records []*blockrelay.AuctionRecord) []phase0.Slot {
+	slots := make([]phase0.Slot, 0, len(records))
+	for _, record := range records {
+		slots = append(slots, record.Slot)
+	}
+	return slots
+}
+
+func TestAuctionHistoryWraparound(t *testing.T) {
+	ctx := context.Background()
+
+	history := newAuctionHistory(3)
+	require.Empty(t, history.history())
+
+	history.AuditAuction(ctx, &blockrelay.AuctionRecord{Slot: 1})
+	history.AuditAuction(ctx, &blockrelay.AuctionRecord{Slot: 2})
+	require.Equal(t, []phase0.Slot{1, 2}, historySlots(history.history()))
+
+	history.AuditAuction(ctx, &blockrelay.AuctionRecord{Slot: 3})
+	require.Equal(t, []phase0.Slot{1, 2, 3}, historySlots(history.history()))
+
+	// Oldest records are overwritten.
+	history.AuditAuction(ctx, &blockrelay.AuctionRecord{Slot: 4})
+	require.Equal(t, []phase0.Slot{2, 3, 4}, historySlots(history.history()))
+
+	for slot := phase0.Slot(5); slot <= 10; slot++ {
+		history.AuditAuction(ctx, &blockrelay.AuctionRecord{Slot: slot})
+	}
+	require.Equal(t, []phase0.Slot{8, 9, 10}, historySlots(history.history()))
+}
+
+func TestAuctionHistoryConcurrent(t *testing.T) {
+	ctx := context.Background()
+
+	history := newAuctionHistory(16)
+
+	// Many validators' auctions in the same slot record concurrently, whilst
+	// the history is being read.
+	var wg sync.WaitGroup
+	for i := 0; i < 64; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			history.AuditAuction(ctx, &blockrelay.AuctionRecord{Slot: 1, Pubkey: phase0.BLSPubKey{byte(i)}})
+		}(i)
+		go func() {
+			defer wg.Done()
+			for _, record := range history.history() {
+				require.NotNil(t, record)
+			}
+		}()
+	}
+	wg.Wait()
+
+	records := history.history()
+	require.Len(t, records, 16)
+	pubkeys := make(map[phase0.BLSPubKey]struct{})
+	for _, record := range records {
+		pubkeys[record.Pubkey] = struct{}{}
+	}
+	require.Len(t, pubkeys, 16)
+}
+
+func TestAuctionHistoryDisabled(t *testing.T) {
+	s := testAuctionService(t)
+	s.recordAuction(context.Background(), &blockrelay.AuctionRecord{Slot: 1})
+	require.Empty(t, s.AuctionHistory(context.Background()))
+}
+
+func TestAuctionBlockHistory(t *testing.T) {
+	ctx := context.Background()
+
+	bidding := newTestBidRelay(t)
+
+	s, pubkey := testProposerAuctionService(t)
+	s.auctionHistory = newAuctionHistory(8)
+
+	parentHash := testParentHash()
+
+	// Auction with a winning bid.
+	s.setExecutionConfig(&v2.ExecutionConfig{
+		Relays: map[string]*v2.BaseRelayConfig{
+			bidding.URL: {},
+		},
+	})
+	res, err := s.AuctionBlock(ctx, 0, parentHash, pubkey)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// Auction without any relays.
+	s.setExecutionConfig(&v2.ExecutionConfig{})
+	res, err = s.AuctionBlock(ctx, 1, parentHash, pubkey)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	records := s.AuctionHistory(ctx)
+	require.Len(t, records, 2)
+
+	require.Equal(t, phase0.Slot(0), records[0].Slot)
+	require.Equal(t, pubkey, records[0].Pubkey)
+	require.Len(t, records[0].Relays, 1)
+	require.Len(t, records[0].Winners, 1)
+	require.NotNil(t, records[0].WinningValue)
+	require.Len(t, records[0].Values, 1)
+
+	require.Equal(t, phase0.Slot(1), records[1].Slot)
+	require.Empty(t, records[1].Winners)
+	require.Equal(t, blockrelay.NoBidReasonNoRelays, records[1].NoBidReason)
+
+	// History is available over HTTP.
+	rec := httptest.NewRecorder()
+	s.AuctionHistoryHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var decoded []map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &decoded))
+	require.Len(t, decoded, 2)
+	require.Equal(t, "1", decoded[1]["slot"])
+	require.Equal(t, "no relays", decoded[1]["no_bid_reason"])
+}
@@ -0,0 +1,93 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
+)
+
+// auctionRetryContextKey marks a context as belonging to an auction that can
+// be retried if every relay fails.
+type auctionRetryContextKey struct{}
+
+// isRetryable returns true if the context belongs to an auction that can be retried.
+func isRetryable(ctx context.Context) bool {
+	retryable, ok := ctx.Value(auctionRetryContextKey{}).(bool)
+	return ok && retryable
+}
+
+// deferNoBid returns true if reporting that an auction has no bid should be
+// deferred, as the auction may yet be retried.
+func deferNoBid(ctx context.Context, reason blockrelay.NoBidReason) bool {
+	return reason == blockrelay.NoBidReasonAllErrored && isRetryable(ctx)
+}
+
+// retryAuction retries an auction in which every relay failed, for as long as
+// retries remain and a retry can complete within the retry budget.  If no retry
+// obtains a bid the lack of a bid is reported.
+func (s *Service) retryAuction(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+	proposerConfig *beaconblockproposer.ProposerConfig,
+	record *blockrelay.AuctionRecord,
+) *blockauctioneer.Results {
+	for attempt := 1; attempt <= s.auctionRetries; attempt++ {
+		if !s.auctionRetryPermitted(ctx, slot) {
+			log.Debug().Uint64("slot", uint64(slot)).Int("attempt", attempt).Msg("Insufficient time remaining to retry auction")
+			break
+		}
+		log.Debug().Uint64("slot", uint64(slot)).Int("attempt", attempt).Msg("All relays failed; retrying auction")
+
+		record.NoBidReason = blockrelay.NoBidReasonUnknown
+		res := s.bestBuilderBid(ctx, slot, parentHash, pubkey, proposerConfig, record)
+		monitorAuctionRetry(res != nil)
+		if res != nil {
+			return res
+		}
+		if record.NoBidReason != blockrelay.NoBidReasonAllErrored {
+			// Relays responded, and the lack of a bid has already been reported.
+			return nil
+		}
+	}
+
+	monitorAuctionBlock("", false, time.Since(record.Started))
+	s.noBidHandler.NoBid(ctx, slot, pubkey, blockrelay.NoBidReasonAllErrored)
+
+	return nil
+}
+
+// auctionRetryPermitted returns true if there is time for an auction to be
+// retried.  A retry can take up to the full timeout, so it must be able to
+// complete by both the end of the retry budget and the context deadline.
+func (s *Service) auctionRetryPermitted(ctx context.Context, slot phase0.Slot) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	completion := time.Now().Add(s.timeout)
+	if completion.After(s.chainTime.StartOfSlot(slot).Add(s.auctionRetryBudget)) {
+		return false
+	}
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline && completion.After(deadline) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,156 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/vouch/mock"
+	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	"github.com/attestantio/vouch/services/blockrelay"
+	v2 "github.com/attestantio/vouch/services/blockrelay/v2"
+	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	mockscheduler "github.com/attestantio/vouch/services/scheduler/mock"
+	mocksigner "github.com/attestantio/vouch/services/signer/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	directconfidant "github.com/wealdtech/go-majordomo/confidants/direct"
+	standardmajordomo "github.com/wealdtech/go-majordomo/standard"
+)
+
+func TestNewAuctionRetries(t *testing.T) {
+	ctx := context.Background()
+
+	majordomoSvc, err := standardmajordomo.New(ctx)
+	require.NoError(t, err)
+	directConfidant, err := directconfidant.New(ctx)
+	require.NoError(t, err)
+	require.NoError(t, majordomoSvc.RegisterConfidant(ctx, directConfidant))
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithMonitor(nullmetrics.New(ctx)),
+		WithTimeout(time.Second),
+		WithMajordomo(majordomoSvc),
+		WithScheduler(mockscheduler.New()),
+		WithListenAddress("0.0.0.0:13532"),
+		WithChainTime(testAuctionService(t).chainTime),
+		WithFallbackFeeRecipient(bellatrix.ExecutionAddress{0x01}),
+		WithFallbackGasLimit(10000000),
+		WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+		WithAccountsProvider(mockaccountmanager.NewAccountsProvider()),
+		WithValidatorRegistrationSigner(mocksigner.New()),
+		WithSpecProvider(mock.NewSpecProvider()),
+		WithDomainProvider(mock.NewDomainProvider()),
+		WithAuctionRetries(2),
+		WithAuctionRetryBudget(3*time.Second),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, s.auctionRetries)
+	require.Equal(t, 3*time.Second, s.auctionRetryBudget)
+}
+
+func TestAuctionBlockRetry(t *testing.T) {
+	ctx := context.Background()
+	setTestTimeout(t)
+
+	parentHash := testParentHash()
+
+	tests := []struct {
+		name             string
+		failures         int32
+		retries          int
+		budget           time.Duration
+		expectedBid      bool
+		expectedRequests int32
+		expectedNoBids   int
+	}{
+		{
+			name:             "NoRetries",
+			failures:         1,
+			expectedBid:      false,
+			expectedRequests: 1,
+			expectedNoBids:   1,
+		},
+		{
+			name:             "RetrySucceeds",
+			failures:         1,
+			retries:          2,
+			expectedBid:      true,
+			expectedRequests: 2,
+		},
+		{
+			name:             "RetriesExhausted",
+			failures:         100,
+			retries:          2,
+			expectedBid:      false,
+			expectedRequests: 3,
+			expectedNoBids:   1,
+		},
+		{
+			name:             "BudgetExhausted",
+			failures:         1,
+			retries:          2,
+			budget:           time.Second,
+			expectedBid:      false,
+			expectedRequests: 1,
+			expectedNoBids:   1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := atomic.Int32{}
+			relay := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if requests.Add(1) <= test.failures {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(testBidJSON)
+			}))
+			defer relay.Close()
+
+			s, pubkey := testProposerAuctionService(t)
+			noBidHandler := &recordingNoBidHandler{}
+			s.noBidHandler = noBidHandler
+			s.auctionRetries = test.retries
+			s.auctionRetryBudget = test.budget
+			if s.auctionRetryBudget == 0 {
+				// The test bid is for slot 0, so allow retries up to now.
+				s.auctionRetryBudget = time.Since(s.chainTime.StartOfSlot(0)) + time.Minute
+			}
+			s.setExecutionConfig(&v2.ExecutionConfig{
+				Relays: map[string]*v2.BaseRelayConfig{
+					relay.URL: {},
+				},
+			})
+
+			res, err := s.AuctionBlock(ctx, 0, parentHash, pubkey)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedBid, res != nil)
+			require.Equal(t, test.expectedRequests, requests.Load())
+			require.Equal(t, test.expectedNoBids, noBidHandler.calls)
+			if test.expectedNoBids > 0 {
+				require.Equal(t, blockrelay.NoBidReasonAllErrored, noBidHandler.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/attestantio/vouch/services/blockrelay"
+	"github.com/pkg/errors"
+)
+
+// nullAuditSink is an audit sink that does nothing.
+type nullAuditSink struct{}
+
+// AuditFeeRecipient records the fee recipient committed for a proposal.
+func (*nullAuditSink) AuditFeeRecipient(_ context.Context, _ *blockrelay.FeeRecipientRecord) {}
+
+// fileAuditSink is an audit sink that appends records to a file, one JSON object per line.
+type fileAuditSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// newFileAuditSink creates an audit sink that appends to the given file.
+func newFileAuditSink(path string) (*fileAuditSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open audit log")
+	}
+
+	return &fileAuditSink{
+		file: file,
+	}, nil
+}
+
+// AuditFeeRecipient records the fee recipient committed for a proposal.
+func (s *fileAuditSink) AuditFeeRecipient(_ context.Context, record *blockrelay.FeeRecipientRecord) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal fee recipient audit record")
+		return
+	}
+	data = append(data, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(data); err != nil {
+		log.Error().Err(err).Msg("Failed to write fee recipient audit record")
+		return
+	}
+	if err := s.file.Sync(); err != nil {
+		log.Error().Err(err).Msg("Failed to sync audit log")
+	}
+}
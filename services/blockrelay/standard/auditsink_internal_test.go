@@ -0,0 +1,66 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileAuditSink(t *testing.T) {
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := newFileAuditSink(path)
+	require.NoError(t, err)
+
+	sink.AuditFeeRecipient(ctx, &blockrelay.FeeRecipientRecord{
+		Slot:         1,
+		Pubkey:       phase0.BLSPubKey{0x01},
+		FeeRecipient: bellatrix.ExecutionAddress{0x02},
+		Source:       beaconblockproposer.FeeRecipientSourceValidator,
+	})
+	sink.AuditFeeRecipient(ctx, &blockrelay.FeeRecipientRecord{
+		Slot:         2,
+		Pubkey:       phase0.BLSPubKey{0x03},
+		FeeRecipient: bellatrix.ExecutionAddress{0x04},
+		Source:       beaconblockproposer.FeeRecipientSourceFallback,
+	})
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, `{"slot":"1","pubkey":"0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","fee_recipient":"0x0200000000000000000000000000000000000000","source":"validator"}
+{"slot":"2","pubkey":"0x030000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","fee_recipient":"0x0400000000000000000000000000000000000000","source":"fallback"}
+`, string(data))
+
+	// Ensure that reopening the sink appends to the existing file.
+	sink, err = newFileAuditSink(path)
+	require.NoError(t, err)
+	sink.AuditFeeRecipient(ctx, &blockrelay.FeeRecipientRecord{
+		Slot:   3,
+		Source: beaconblockproposer.FeeRecipientSourceDefault,
+	})
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 3, strings.Count(string(data), "\n"))
+}
@@ -0,0 +1,106 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"math/big"
+
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// bidPrevRandao returns the prev_randao of the execution payload header of the bid.
+func bidPrevRandao(bid *builderspec.VersionedSignedBuilderBid) ([32]byte, error) {
+	if bid == nil {
+		return [32]byte{}, errors.New("nil bid")
+	}
+	switch bid.Version {
+	case consensusspec.DataVersionBellatrix:
+		if bid.Bellatrix == nil || bid.Bellatrix.Message == nil || bid.Bellatrix.Message.Header == nil {
+			return [32]byte{}, errors.New("no data message header")
+		}
+		return bid.Bellatrix.Message.Header.PrevRandao, nil
+	case consensusspec.DataVersionCapella:
+		if bid.Capella == nil || bid.Capella.Message == nil || bid.Capella.Message.Header == nil {
+			return [32]byte{}, errors.New("no data message header")
+		}
+		return bid.Capella.Message.Header.PrevRandao, nil
+	default:
+		return [32]byte{}, errors.New("unsupported version")
+	}
+}
+
+// bidBaseFeePerGas returns the base fee per gas of the execution payload header of the bid.
+func bidBaseFeePerGas(bid *builderspec.VersionedSignedBuilderBid) (*big.Int, error) {
+	if bid == nil {
+		return nil, errors.New("nil bid")
+	}
+	var baseFeePerGas [32]byte
+	switch bid.Version {
+	case consensusspec.DataVersionBellatrix:
+		if bid.Bellatrix == nil || bid.Bellatrix.Message == nil || bid.Bellatrix.Message.Header == nil {
+			return nil, errors.New("no data message header")
+		}
+		baseFeePerGas = bid.Bellatrix.Message.Header.BaseFeePerGas
+	case consensusspec.DataVersionCapella:
+		if bid.Capella == nil || bid.Capella.Message == nil || bid.Capella.Message.Header == nil {
+			return nil, errors.New("no data message header")
+		}
+		baseFeePerGas = bid.Capella.Message.Header.BaseFeePerGas
+	default:
+		return nil, errors.New("unsupported version")
+	}
+
+	// Base fee per gas is held little-endian.
+	for i, j := 0, len(baseFeePerGas)-1; i < j; i, j = i+1, j-1 {
+		baseFeePerGas[i], baseFeePerGas[j] = baseFeePerGas[j], baseFeePerGas[i]
+	}
+
+	return new(big.Int).SetBytes(baseFeePerGas[:]), nil
+}
+
+// bidGas returns the gas used and gas limit of the execution payload header of the bid.
+func bidGas(bid *builderspec.VersionedSignedBuilderBid) (uint64, uint64, error) {
+	if bid == nil {
+		return 0, 0, errors.New("nil bid")
+	}
+	switch bid.Version {
+	case consensusspec.DataVersionBellatrix:
+		if bid.Bellatrix == nil || bid.Bellatrix.Message == nil || bid.Bellatrix.Message.Header == nil {
+			return 0, 0, errors.New("no data message header")
+		}
+		return bid.Bellatrix.Message.Header.GasUsed, bid.Bellatrix.Message.Header.GasLimit, nil
+	case consensusspec.DataVersionCapella:
+		if bid.Capella == nil || bid.Capella.Message == nil || bid.Capella.Message.Header == nil {
+			return 0, 0, errors.New("no data message header")
+		}
+		return bid.Capella.Message.Header.GasUsed, bid.Capella.Message.Header.GasLimit, nil
+	default:
+		return 0, 0, errors.New("unsupported version")
+	}
+}
+
+// hasGasLimit returns true if the gas limit of the bid is the given gas limit.
+func hasGasLimit(bid *builderspec.VersionedSignedBuilderBid, gasLimit uint64) bool {
+	if gasLimit == 0 {
+		return false
+	}
+	_, bidGasLimit, err := bidGas(bid)
+	if err != nil {
+		return false
+	}
+
+	return bidGasLimit == gasLimit
+}
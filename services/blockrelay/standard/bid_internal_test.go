@@ -0,0 +1,169 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/holiman/uint256"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBidBaseFeePerGas(t *testing.T) {
+	bid := testBid(t)
+	baseFeePerGas, err := bidBaseFeePerGas(bid)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), baseFeePerGas)
+
+	bid.Bellatrix.Message.Header = nil
+	_, err = bidBaseFeePerGas(bid)
+	require.EqualError(t, err, "no data message header")
+
+	_, err = bidBaseFeePerGas(nil)
+	require.EqualError(t, err, "nil bid")
+}
+
+func TestBidGas(t *testing.T) {
+	bid := testBidWithGasUsed(t, 15000000)
+	bid.Bellatrix.Message.Header.GasLimit = 30000000
+	gasUsed, gasLimit, err := bidGas(bid)
+	require.NoError(t, err)
+	require.Equal(t, uint64(15000000), gasUsed)
+	require.Equal(t, uint64(30000000), gasLimit)
+
+	bid.Bellatrix.Message.Header = nil
+	_, _, err = bidGas(bid)
+	require.EqualError(t, err, "no data message header")
+
+	_, _, err = bidGas(nil)
+	require.EqualError(t, err, "nil bid")
+}
+
+func TestRecordWinningBidGas(t *testing.T) {
+	// Use unregistered metrics, so that the values can be checked in isolation.
+	winningBidGasUsed = prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_gas_used"})
+	winningBidGasLimit = prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_gas_limit"})
+	winningBidGasRatio = prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_gas_ratio"})
+	defer func() {
+		winningBidGasUsed = nil
+		winningBidGasLimit = nil
+		winningBidGasRatio = nil
+	}()
+
+	bid := testBidWithGasUsed(t, 24000000)
+	bid.Bellatrix.Message.Header.GasLimit = 30000000
+	recordWinningBidGas(1, bid)
+	require.Equal(t, float64(24000000), testutil.ToFloat64(winningBidGasUsed))
+	require.Equal(t, float64(30000000), testutil.ToFloat64(winningBidGasLimit))
+	ratio := &dto.Metric{}
+	require.NoError(t, winningBidGasRatio.Write(ratio))
+	require.Equal(t, uint64(1), ratio.GetHistogram().GetSampleCount())
+	require.InDelta(t, 0.8, ratio.GetHistogram().GetSampleSum(), 1e-9)
+
+	// A bid without a gas limit does not record a ratio.
+	bid = testBidWithGasUsed(t, 0)
+	bid.Bellatrix.Message.Header.GasLimit = 0
+	recordWinningBidGas(2, bid)
+	require.Equal(t, float64(0), testutil.ToFloat64(winningBidGasUsed))
+	require.Equal(t, float64(0), testutil.ToFloat64(winningBidGasLimit))
+	ratio = &dto.Metric{}
+	require.NoError(t, winningBidGasRatio.Write(ratio))
+	require.Equal(t, uint64(1), ratio.GetHistogram().GetSampleCount())
+}
+
+func TestBuilderBidBaseFeeFloor(t *testing.T) {
+	ctx := context.Background()
+
+	// 100 Gwei.
+	highBaseFee := uint256.NewInt(100000000000).Bytes32()
+
+	tests := []struct {
+		name            string
+		baseFeeFloorGas uint64
+		highBaseFee     bool
+		minValue        decimal.Decimal
+		belowMinValue   bool
+	}{
+		{
+			name: "Disabled",
+		},
+		{
+			name:        "DisabledHighBaseFee",
+			highBaseFee: true,
+		},
+		{
+			// Floor is 7 wei * 1,000,000 gas, well below the bid value.
+			name:            "LowBaseFee",
+			baseFeeFloorGas: 1000000,
+		},
+		{
+			// Floor is 100 Gwei * 1,000,000 gas, or 0.1 Ether, above the bid value.
+			name:            "HighBaseFee",
+			baseFeeFloorGas: 1000000,
+			highBaseFee:     true,
+			belowMinValue:   true,
+		},
+		{
+			// Static minimum value is higher than the floor.
+			name:            "LowBaseFeeHighMinValue",
+			baseFeeFloorGas: 1000000,
+			minValue:        decimal.New(1, 18),
+			belowMinValue:   true,
+		},
+		{
+			// Floor is higher than the static minimum value.
+			name:            "HighBaseFeeLowMinValue",
+			baseFeeFloorGas: 1000000,
+			highBaseFee:     true,
+			minValue:        decimal.New(1, 9),
+			belowMinValue:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testAuctionService(t)
+			s.baseFeeFloorGas = test.baseFeeFloorGas
+			bid := testBid(t)
+			if test.highBaseFee {
+				// Base fee per gas is held little-endian.
+				for i := range highBaseFee {
+					bid.Bellatrix.Message.Header.BaseFeePerGas[i] = highBaseFee[len(highBaseFee)-1-i]
+				}
+			}
+			provider := &mock.BuilderClient{
+				MockAddress: "relay",
+				MockBid:     bid,
+			}
+			resp, err := runBuilderBid(ctx, s, provider, &beaconblockproposer.RelayConfig{
+				MinValue: test.minValue,
+			})
+			require.NoError(t, err)
+			require.Equal(t, test.belowMinValue, resp.belowMinValue)
+			if test.belowMinValue {
+				require.Nil(t, resp.bid)
+			} else {
+				require.NotNil(t, resp.bid)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+)
+
+// nullBidValidator is a bid validator that accepts all bids.
+type nullBidValidator struct{}
+
+// Validate validates a bid.
+func (*nullBidValidator) Validate(_ context.Context,
+	_ *builderspec.VersionedSignedBuilderBid,
+	_ *beaconblockproposer.RelayConfig,
+) error {
+	return nil
+}
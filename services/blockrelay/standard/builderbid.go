@@ -42,8 +42,7 @@ func (s *Service) BuilderBid(ctx context.Context,
 	log.Trace().Uint64("slot", uint64(slot)).Str("parent_hash", fmt.Sprintf("%#x", parentHash)).Str("pubkey", fmt.Sprintf("%#x", pubkey)).Msg("Builder bid called")
 
 	// Fetch the matching header from the cache.
-	key := fmt.Sprintf("%d", slot)
-	subKey := fmt.Sprintf("%x:%x", parentHash, pubkey)
+	key, subKey := builderBidsCacheKeys(slot, parentHash, pubkey)
 	s.builderBidsCacheMu.RLock()
 	slotBuilderBids, exists := s.builderBidsCache[key]
 	if !exists {
@@ -60,6 +59,10 @@ func (s *Service) BuilderBid(ctx context.Context,
 		return nil, errors.New("builder bid not known (subkey)")
 	}
 
+	// The block for the slot is being built on this parent, so bids for
+	// other parents will not be requested.
+	s.pruneBuilderBids(key, subKey)
+
 	if e := log.Trace(); e.Enabled() {
 		data, err := json.Marshal(builderBid)
 		if err == nil {
@@ -0,0 +1,87 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"fmt"
+	"strconv"
+
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// builderBidsCacheSlots is the number of slots for which builder bids are
+// retained; bids for earlier slots are evicted when a new bid is cached.
+const builderBidsCacheSlots = 32
+
+// builderBidsCacheKeys returns the keys for a builder bid in the cache.
+// The subkey includes the parent hash, so bids for different forks of the
+// same slot are held separately.
+func builderBidsCacheKeys(slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+) (
+	string,
+	string,
+) {
+	return fmt.Sprintf("%d", slot), fmt.Sprintf("%x:%x", parentHash, pubkey)
+}
+
+// cacheBuilderBid adds a builder bid to the cache, evicting bids for slots
+// that are too old to be requested.
+func (s *Service) cacheBuilderBid(slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+	bid *builderspec.VersionedSignedBuilderBid,
+) {
+	key, subKey := builderBidsCacheKeys(slot, parentHash, pubkey)
+
+	s.builderBidsCacheMu.Lock()
+	defer s.builderBidsCacheMu.Unlock()
+
+	if _, exists := s.builderBidsCache[key]; !exists {
+		s.builderBidsCache[key] = make(map[string]*builderspec.VersionedSignedBuilderBid)
+	}
+	s.builderBidsCache[key][subKey] = bid
+
+	if slot < builderBidsCacheSlots {
+		return
+	}
+	minSlot := uint64(slot) - builderBidsCacheSlots
+	for cachedKey := range s.builderBidsCache {
+		cachedSlot, err := strconv.ParseUint(cachedKey, 10, 64)
+		if err != nil || cachedSlot < minSlot {
+			delete(s.builderBidsCache, cachedKey)
+		}
+	}
+}
+
+// pruneBuilderBids removes bids for a slot other than the one that has been
+// provided for the block proposal, as they are for forks that were not built
+// upon.
+func (s *Service) pruneBuilderBids(key string, subKey string) {
+	s.builderBidsCacheMu.Lock()
+	defer s.builderBidsCacheMu.Unlock()
+
+	slotBuilderBids, exists := s.builderBidsCache[key]
+	if !exists {
+		return
+	}
+	for cachedSubKey := range slotBuilderBids {
+		if cachedSubKey != subKey {
+			log.Trace().Str("key", key).Str("subkey", cachedSubKey).Msg("Evicting builder bid for unused fork")
+			delete(slotBuilderBids, cachedSubKey)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderBidsCacheForks(t *testing.T) {
+	ctx := context.Background()
+
+	s := &Service{
+		builderBidsCache: make(map[string]map[string]*builderspec.VersionedSignedBuilderBid),
+	}
+
+	slot := phase0.Slot(100)
+	pubkey := phase0.BLSPubKey{0x01}
+	parentHash1 := phase0.Hash32{0x01}
+	parentHash2 := phase0.Hash32{0x02}
+	bid1 := &builderspec.VersionedSignedBuilderBid{}
+	bid2 := &builderspec.VersionedSignedBuilderBid{}
+
+	// Bids for both forks are held separately.
+	s.cacheBuilderBid(slot, parentHash1, pubkey, bid1)
+	s.cacheBuilderBid(slot, parentHash2, pubkey, bid2)
+	require.Len(t, s.builderBidsCache["100"], 2)
+
+	res, err := s.BuilderBid(ctx, slot, parentHash1, pubkey)
+	require.NoError(t, err)
+	require.Same(t, bid1, res)
+
+	// Providing the bid for the first fork evicts the second.
+	require.Len(t, s.builderBidsCache["100"], 1)
+	_, err = s.BuilderBid(ctx, slot, parentHash2, pubkey)
+	require.EqualError(t, err, "builder bid not known (subkey)")
+
+	// The provided bid remains available for repeated requests.
+	res, err = s.BuilderBid(ctx, slot, parentHash1, pubkey)
+	require.NoError(t, err)
+	require.Same(t, bid1, res)
+}
+
+func TestBuilderBidsCacheSlots(t *testing.T) {
+	s := &Service{
+		builderBidsCache: make(map[string]map[string]*builderspec.VersionedSignedBuilderBid),
+	}
+
+	pubkey := phase0.BLSPubKey{0x01}
+	parentHash := phase0.Hash32{0x01}
+
+	s.cacheBuilderBid(10, parentHash, pubkey, &builderspec.VersionedSignedBuilderBid{})
+	s.cacheBuilderBid(20, parentHash, pubkey, &builderspec.VersionedSignedBuilderBid{})
+	require.Len(t, s.builderBidsCache, 2)
+
+	// Slot 10 is within the retained slots of slot 42.
+	s.cacheBuilderBid(42, parentHash, pubkey, &builderspec.VersionedSignedBuilderBid{})
+	require.Len(t, s.builderBidsCache, 3)
+
+	// Slot 10 is outside the retained slots of slot 43.
+	s.cacheBuilderBid(43, parentHash, pubkey, &builderspec.VersionedSignedBuilderBid{})
+	require.Len(t, s.builderBidsCache, 3)
+	require.NotContains(t, s.builderBidsCache, "10")
+	require.Contains(t, s.builderBidsCache, "20")
+}
@@ -0,0 +1,92 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// proposedBlockRetention is the number of slots for which a proposed block is
+// retained awaiting feedback about the canonical chain.
+const proposedBlockRetention = phase0.Slot(256)
+
+// proposedBlock is a block proposed with a payload supplied by relays.
+type proposedBlock struct {
+	root   phase0.Root
+	relays []string
+}
+
+// RecordProposedBlock records that the block with the given root was
+// proposed at the given slot with a payload supplied by the given relays.
+func (s *Service) RecordProposedBlock(_ context.Context,
+	slot phase0.Slot,
+	root phase0.Root,
+	relays []string,
+) {
+	if len(relays) == 0 {
+		return
+	}
+
+	s.proposedBlocksMu.Lock()
+	defer s.proposedBlocksMu.Unlock()
+
+	s.proposedBlocks[slot] = &proposedBlock{
+		root:   root,
+		relays: relays,
+	}
+
+	// Drop blocks for which feedback has not been received in a reasonable time.
+	for proposedSlot := range s.proposedBlocks {
+		if proposedSlot+proposedBlockRetention < slot {
+			log.Debug().Uint64("slot", uint64(proposedSlot)).Msg("No canonical chain feedback received for proposed block; dropping")
+			delete(s.proposedBlocks, proposedSlot)
+		}
+	}
+}
+
+// CanonicalBlock provides the root of the canonical block at the given slot,
+// or nil if the slot is empty on the canonical chain.  Slots for which no
+// block was recorded are ignored.
+func (s *Service) CanonicalBlock(_ context.Context,
+	slot phase0.Slot,
+	root *phase0.Root,
+) {
+	s.proposedBlocksMu.Lock()
+	block, exists := s.proposedBlocks[slot]
+	delete(s.proposedBlocks, slot)
+	s.proposedBlocksMu.Unlock()
+
+	if !exists {
+		return
+	}
+
+	result := "canonical"
+	if root == nil || *root != block.root {
+		result = "orphaned"
+	}
+
+	log := log.With().Uint64("slot", uint64(slot)).Str("block_root", fmt.Sprintf("%#x", block.root)).Strs("relays", block.relays).Logger()
+	if result == "canonical" {
+		log.Trace().Msg("Block proposed via relays is canonical")
+	} else {
+		log.Warn().Msg("Block proposed via relays is not canonical")
+	}
+
+	for _, relay := range block.relays {
+		monitorBlockCanonical(s.relayLabel(relay), result)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalBlock(t *testing.T) {
+	ctx := context.Background()
+
+	root := phase0.Root{0x01}
+	otherRoot := phase0.Root{0x02}
+
+	tests := []struct {
+		name      string
+		relays    []string
+		slot      phase0.Slot
+		root      *phase0.Root
+		recorded  bool
+		remaining int
+	}{
+		{
+			name:      "NoRelays",
+			slot:      100,
+			root:      &root,
+			remaining: 0,
+		},
+		{
+			name:      "Canonical",
+			relays:    []string{"relay1", "relay2"},
+			slot:      100,
+			root:      &root,
+			recorded:  true,
+			remaining: 0,
+		},
+		{
+			name:      "Orphaned",
+			relays:    []string{"relay1"},
+			slot:      100,
+			root:      &otherRoot,
+			recorded:  true,
+			remaining: 0,
+		},
+		{
+			name:      "EmptySlot",
+			relays:    []string{"relay1"},
+			slot:      100,
+			recorded:  true,
+			remaining: 0,
+		},
+		{
+			name:      "OtherSlot",
+			relays:    []string{"relay1"},
+			slot:      101,
+			root:      &root,
+			recorded:  true,
+			remaining: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				proposedBlocks: make(map[phase0.Slot]*proposedBlock),
+			}
+			s.RecordProposedBlock(ctx, 100, root, test.relays)
+			if test.recorded {
+				require.Len(t, s.proposedBlocks, 1)
+			} else {
+				require.Empty(t, s.proposedBlocks)
+			}
+			s.CanonicalBlock(ctx, test.slot, test.root)
+			require.Len(t, s.proposedBlocks, test.remaining)
+		})
+	}
+}
+
+func TestProposedBlockRetention(t *testing.T) {
+	ctx := context.Background()
+
+	s := &Service{
+		proposedBlocks: make(map[phase0.Slot]*proposedBlock),
+	}
+	s.RecordProposedBlock(ctx, 100, phase0.Root{0x01}, []string{"relay1"})
+	s.RecordProposedBlock(ctx, 100+proposedBlockRetention, phase0.Root{0x02}, []string{"relay1"})
+	require.Len(t, s.proposedBlocks, 2)
+
+	// A block beyond the retention period causes the oldest block to be dropped.
+	s.RecordProposedBlock(ctx, 101+proposedBlockRetention, phase0.Root{0x03}, []string{"relay1"})
+	require.Len(t, s.proposedBlocks, 2)
+	require.NotContains(t, s.proposedBlocks, phase0.Slot(100))
+}
@@ -0,0 +1,91 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// clockSkewRelayThreshold is the number of distinct relays that must report
+// the same timestamp offset in a slot for local clock skew to be suspected.
+const clockSkewRelayThreshold = 2
+
+// clockSkewDetector tracks mismatches between the timestamps provided in
+// bids and the timestamps that we expect.  If multiple relays provide the
+// same offset for a slot it is likely that the problem is with the local
+// clock rather than with the relays.
+type clockSkewDetector struct {
+	mu        sync.Mutex
+	slot      phase0.Slot
+	offsets   map[int64]map[string]struct{}
+	suspected bool
+}
+
+// newClockSkewDetector creates a new clock skew detector.
+func newClockSkewDetector() *clockSkewDetector {
+	return &clockSkewDetector{
+		offsets: make(map[int64]map[string]struct{}),
+	}
+}
+
+// mismatch records a timestamp mismatch from a relay.
+// It returns true if this mismatch results in clock skew being suspected for the slot.
+func (d *clockSkewDetector) mismatch(slot phase0.Slot, provider string, offset int64) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rollover(slot)
+	if _, exists := d.offsets[offset]; !exists {
+		d.offsets[offset] = make(map[string]struct{})
+	}
+	d.offsets[offset][strings.ToLower(provider)] = struct{}{}
+
+	if len(d.offsets[offset]) == clockSkewRelayThreshold {
+		d.suspected = true
+		return true
+	}
+
+	return false
+}
+
+// match records a timestamp match from a relay.
+// A match shows that the local clock agrees with at least one relay, so clears any suspicion.
+func (d *clockSkewDetector) match(slot phase0.Slot) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rollover(slot)
+	d.suspected = false
+}
+
+// isSuspected returns true if clock skew is currently suspected.
+func (d *clockSkewDetector) isSuspected() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.suspected
+}
+
+// rollover clears per-slot data if the slot has changed.
+// Suspicion is retained across slots, and only cleared by a match.
+// Must be called with the lock held.
+func (d *clockSkewDetector) rollover(slot phase0.Slot) {
+	if slot != d.slot {
+		d.slot = slot
+		d.offsets = make(map[int64]map[string]struct{})
+	}
+}
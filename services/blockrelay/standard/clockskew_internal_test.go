@@ -0,0 +1,54 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockSkewDetector(t *testing.T) {
+	d := newClockSkewDetector()
+
+	// Single relay with an offset is not enough.
+	require.False(t, d.mismatch(1, "https://relay1.example.com/", 12))
+	require.False(t, d.isSuspected())
+
+	// Same relay again is still not enough.
+	require.False(t, d.mismatch(1, "https://RELAY1.example.com/", 12))
+	require.False(t, d.isSuspected())
+
+	// Different relay with a different offset is not enough.
+	require.False(t, d.mismatch(1, "https://relay2.example.com/", 24))
+	require.False(t, d.isSuspected())
+
+	// Different relay with the same offset results in suspicion.
+	require.True(t, d.mismatch(1, "https://relay2.example.com/", 12))
+	require.True(t, d.isSuspected())
+
+	// Further relays do not re-trigger for the slot.
+	require.False(t, d.mismatch(1, "https://relay3.example.com/", 12))
+	require.True(t, d.isSuspected())
+
+	// Suspicion remains across slots until a match.
+	require.False(t, d.mismatch(2, "https://relay1.example.com/", 12))
+	require.True(t, d.isSuspected())
+	d.match(2)
+	require.False(t, d.isSuspected())
+
+	// Offsets from earlier slots are not counted.
+	require.False(t, d.mismatch(3, "https://relay1.example.com/", 12))
+	require.False(t, d.isSuspected())
+}
@@ -0,0 +1,65 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/blockrelay"
+	"go.opentelemetry.io/otel"
+)
+
+// diagnosticContextKey marks a context as belonging to a diagnostic auction.
+type diagnosticContextKey struct{}
+
+// isDiagnostic returns true if the context belongs to a diagnostic auction.
+func isDiagnostic(ctx context.Context) bool {
+	diagnostic, ok := ctx.Value(diagnosticContextKey{}).(bool)
+	return ok && diagnostic
+}
+
+// DiagnosticAuction runs an auction for the given slot, parent hash and proposer,
+// returning the results without making them available for a proposal.
+// This allows relays to be checked before a validator is due to propose.  The
+// auction does not cache its bid, audit its fee recipient, call the no bid
+// handler, or update the state and metrics used to track relays and clock skew.
+func (s *Service) DiagnosticAuction(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+) (
+	*blockrelay.DiagnosticAuctionResult,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.vouch.services.blockrelay.standard").Start(ctx, "DiagnosticAuction")
+	defer span.End()
+
+	proposerConfig, err := s.ResolveProposerConfig(ctx, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	res := &blockrelay.DiagnosticAuctionResult{
+		ProposerConfig: proposerConfig,
+	}
+	if len(proposerConfig.Relays) == 0 {
+		log.Debug().Msg("No relays in proposer configuration; not running diagnostic auction")
+		return res, nil
+	}
+
+	log.Debug().Uint64("slot", uint64(slot)).Int("relays", len(proposerConfig.Relays)).Msg("Running diagnostic auction")
+	res.Results = s.bestBuilderBid(context.WithValue(ctx, diagnosticContextKey{}, true), slot, parentHash, pubkey, proposerConfig, nil)
+
+	return res, nil
+}
@@ -0,0 +1,172 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
+	v1 "github.com/attestantio/vouch/services/blockrelay/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingNoBidHandler records the auctions for which it is called.
+type recordingNoBidHandler struct {
+	mu     sync.Mutex
+	calls  int
+	reason blockrelay.NoBidReason
+}
+
+func (h *recordingNoBidHandler) NoBid(_ context.Context, _ phase0.Slot, _ phase0.BLSPubKey, reason blockrelay.NoBidReason) {
+	h.mu.Lock()
+	h.calls++
+	h.reason = reason
+	h.mu.Unlock()
+}
+
+func TestDiagnosticAuction(t *testing.T) {
+	ctx := context.Background()
+	bidding := newTestBidRelay(t)
+	notBidding := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer notBidding.Close()
+
+	s, pubkey := testProposerAuctionService(t)
+
+	parentHash := testParentHash()
+
+	tests := []struct {
+		name    string
+		relays  []string
+		slot    phase0.Slot
+		pubkey  phase0.BLSPubKey
+		results bool
+		err     string
+	}{
+		{
+			name:   "UnknownAccount",
+			pubkey: phase0.BLSPubKey{0x01},
+			err:    "no account found for public key",
+		},
+		{
+			name:   "NoRelays",
+			pubkey: pubkey,
+		},
+		{
+			name:    "Bid",
+			relays:  []string{bidding.URL},
+			pubkey:  pubkey,
+			results: true,
+		},
+		{
+			name:   "NoBid",
+			relays: []string{notBidding.URL},
+			pubkey: pubkey,
+		},
+		{
+			name:   "WrongSlot",
+			relays: []string{bidding.URL},
+			slot:   1,
+			pubkey: pubkey,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			noBidHandler := &recordingNoBidHandler{}
+			s.noBidHandler = noBidHandler
+			s.clockSkew = newClockSkewDetector()
+			s.setExecutionConfig(&v1.ExecutionConfig{
+				DefaultConfig: &v1.ProposerConfig{
+					FeeRecipient: bellatrix.ExecutionAddress{0x02},
+					Builder: &v1.BuilderConfig{
+						Enabled: len(test.relays) > 0,
+						Relays:  test.relays,
+					},
+				},
+			})
+
+			res, err := s.DiagnosticAuction(ctx, test.slot, parentHash, test.pubkey)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, res.ProposerConfig)
+			require.Len(t, res.ProposerConfig.Relays, len(test.relays))
+			if test.results {
+				require.NotNil(t, res.Results)
+				require.NotNil(t, res.Results.Bid)
+				require.Len(t, res.Results.Providers, 1)
+			} else {
+				require.Nil(t, res.Results)
+			}
+
+			// The auction must not affect the state used for proposals.
+			require.Empty(t, s.builderBidsCache)
+			require.Equal(t, 0, noBidHandler.calls)
+			require.Equal(t, phase0.Slot(0), s.clockSkew.slot)
+			require.Empty(t, s.clockSkew.offsets)
+		})
+	}
+}
+
+func TestDiagnosticAuctionRelayMetrics(t *testing.T) {
+	// Use unregistered metrics, so that the values can be checked in isolation.
+	belowMinValueCounter = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_below_min_value"}, []string{"relay"})
+	versionMismatchCounter = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_version_mismatch"}, []string{"relay"})
+	defer func() {
+		belowMinValueCounter = nil
+		versionMismatchCounter = nil
+	}()
+
+	capella := consensusspec.DataVersionCapella
+	relayConfig := &beaconblockproposer.RelayConfig{
+		MinValue:        decimal.New(1, 18),
+		ExpectedVersion: &capella,
+	}
+	s := testAuctionService(t)
+	provider := &mock.BuilderClient{
+		MockAddress: "relay",
+		MockBid:     testBid(t),
+	}
+	label := s.relayLabel(provider.Address())
+
+	// A diagnostic auction does not update the metrics of the relay.
+	ctx := context.WithValue(context.Background(), diagnosticContextKey{}, true)
+	resp, err := runBuilderBid(ctx, s, provider, relayConfig)
+	require.NoError(t, err)
+	require.True(t, resp.belowMinValue)
+	require.Equal(t, float64(0), testutil.ToFloat64(belowMinValueCounter.WithLabelValues(label)))
+	require.Equal(t, float64(0), testutil.ToFloat64(versionMismatchCounter.WithLabelValues(label)))
+
+	resp, err = runBuilderBid(context.Background(), s, provider, relayConfig)
+	require.NoError(t, err)
+	require.True(t, resp.belowMinValue)
+	require.Equal(t, float64(1), testutil.ToFloat64(belowMinValueCounter.WithLabelValues(label)))
+	require.Equal(t, float64(1), testutil.ToFloat64(versionMismatchCounter.WithLabelValues(label)))
+}
@@ -0,0 +1,128 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"strings"
+	"sync"
+
+	builderspec "github.com/attestantio/go-builder-client/spec"
+)
+
+// equalBidDetector tracks how often pairs of relays return equal bids in the
+// auctions in which both took part.  If the proportion of equal bids for a pair
+// over the window reaches the threshold the relays are considered likely to
+// share a backend.
+type equalBidDetector struct {
+	mu        sync.Mutex
+	window    int
+	threshold float64
+	history   map[[2]string][]bool
+	shared    map[[2]string]bool
+}
+
+// newEqualBidDetector creates a new equal bid detector.
+func newEqualBidDetector(window int, threshold float64) *equalBidDetector {
+	return &equalBidDetector{
+		window:    window,
+		threshold: threshold,
+		history:   make(map[[2]string][]bool),
+		shared:    make(map[[2]string]bool),
+	}
+}
+
+// relayPair provides a consistent key for a pair of relays.
+func relayPair(provider1 string, provider2 string) [2]string {
+	provider1 = strings.ToLower(provider1)
+	provider2 = strings.ToLower(provider2)
+	if provider2 < provider1 {
+		return [2]string{provider2, provider1}
+	}
+
+	return [2]string{provider1, provider2}
+}
+
+// record records whether a pair of relays returned equal bids in an auction.
+// It returns the equal bid rate of the pair over the window, whether the pair
+// is considered to share a backend, and if the shared state has changed.
+func (d *equalBidDetector) record(pair [2]string, equal bool) (float64, bool, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	history := append(d.history[pair], equal)
+	if len(history) > d.window {
+		history = history[len(history)-d.window:]
+	}
+	d.history[pair] = history
+
+	equals := 0
+	for _, entry := range history {
+		if entry {
+			equals++
+		}
+	}
+	rate := float64(equals) / float64(len(history))
+
+	// Only consider the pair shared once there is a full window of auctions, to
+	// avoid flagging relays based on a handful of coincidentally equal bids.
+	shared := len(history) == d.window && rate >= d.threshold
+
+	changed := shared != d.shared[pair]
+	d.shared[pair] = shared
+
+	return rate, shared, changed
+}
+
+// trackEqualBids records which pairs of relays returned equal bids in an auction.
+func (s *Service) trackEqualBids(candidates []*builderBidResponse) {
+	for i := range candidates {
+		for j := i + 1; j < len(candidates); j++ {
+			provider1 := candidates[i].provider.Address()
+			provider2 := candidates[j].provider.Address()
+			if strings.EqualFold(provider1, provider2) {
+				continue
+			}
+			equal := bidValuesEqual(candidates[i].bid, candidates[j].bid) &&
+				bidsEqual(candidates[i].bid, candidates[j].bid)
+			pair := relayPair(provider1, provider2)
+			rate, shared, changed := s.equalBids.record(pair, equal)
+			label1, labelled1 := s.relayGaugeLabel(pair[0])
+			label2, labelled2 := s.relayGaugeLabel(pair[1])
+			if labelled1 && labelled2 {
+				monitorRelayEqualBidRate(label1, label2, rate)
+			}
+			if !changed {
+				continue
+			}
+			if shared {
+				log.Warn().Str("provider", pair[0]).Str("other_provider", pair[1]).Float64("equal_bid_rate", rate).Int("auctions", s.equalBids.window).Msg("Relays frequently return equal bids; they may share a backend")
+			} else {
+				log.Info().Str("provider", pair[0]).Str("other_provider", pair[1]).Float64("equal_bid_rate", rate).Msg("Relays no longer frequently return equal bids")
+			}
+		}
+	}
+}
+
+// bidValuesEqual returns true if two bids have the same value.
+func bidValuesEqual(bid1 *builderspec.VersionedSignedBuilderBid, bid2 *builderspec.VersionedSignedBuilderBid) bool {
+	value1, err := bid1.Value()
+	if err != nil {
+		return false
+	}
+	value2, err := bid2.Value()
+	if err != nil {
+		return false
+	}
+	return value1.Cmp(value2) == 0
+}
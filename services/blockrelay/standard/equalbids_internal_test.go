@@ -0,0 +1,123 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+// equalBidsCandidate creates a candidate as provided by a successful builderBid.
+func equalBidsCandidate(t *testing.T,
+	provider *mock.BuilderClient,
+	gasUsed uint64,
+	value uint64,
+) *builderBidResponse {
+	t.Helper()
+	bid := testBidWithGasUsed(t, gasUsed)
+	bid.Bellatrix.Message.Value = uint256.NewInt(value)
+
+	return &builderBidResponse{
+		provider: provider,
+		bid:      bid,
+		score:    new(big.Int).SetUint64(value),
+	}
+}
+
+func TestEqualBidDetector(t *testing.T) {
+	d := newEqualBidDetector(4, 0.75)
+	pair := relayPair("https://relay2.example.com/", "https://RELAY1.example.com/")
+	require.Equal(t, [2]string{"https://relay1.example.com/", "https://relay2.example.com/"}, pair)
+	require.Equal(t, pair, relayPair("https://relay1.example.com/", "https://relay2.example.com/"))
+
+	// Equal bids are not enough until the window is full.
+	rate, shared, changed := d.record(pair, true)
+	require.Equal(t, 1.0, rate)
+	require.False(t, shared)
+	require.False(t, changed)
+	_, shared, changed = d.record(pair, true)
+	require.False(t, shared)
+	require.False(t, changed)
+	_, shared, changed = d.record(pair, false)
+	require.False(t, shared)
+	require.False(t, changed)
+
+	// Filling the window with a rate at the threshold flags the pair.
+	rate, shared, changed = d.record(pair, true)
+	require.Equal(t, 0.75, rate)
+	require.True(t, shared)
+	require.True(t, changed)
+
+	// Other pairs are tracked separately.
+	rate, shared, changed = d.record(relayPair("https://relay1.example.com/", "https://relay3.example.com/"), true)
+	require.Equal(t, 1.0, rate)
+	require.False(t, shared)
+	require.False(t, changed)
+
+	// Differing bids pushing equal bids out of the window clear the state.
+	rate, shared, changed = d.record(pair, false)
+	require.Equal(t, 0.5, rate)
+	require.False(t, shared)
+	require.True(t, changed)
+}
+
+func TestTrackEqualBids(t *testing.T) {
+	capture := logger.NewModuleLogCapture(t, &log)
+
+	s := testAuctionService(t)
+	s.equalBids = newEqualBidDetector(2, 1)
+
+	relay1 := &mock.BuilderClient{MockAddress: "https://relay1.example.com/"}
+	relay2 := &mock.BuilderClient{MockAddress: "https://relay2.example.com/"}
+	relay3 := &mock.BuilderClient{MockAddress: "https://relay3.example.com/"}
+	auction := func() []*builderBidResponse {
+		return []*builderBidResponse{
+			equalBidsCandidate(t, relay1, 1, 1000),
+			equalBidsCandidate(t, relay2, 1, 1000),
+			// Same header as the other relays but a different value.
+			equalBidsCandidate(t, relay3, 1, 999),
+		}
+	}
+
+	s.trackEqualBids(auction())
+	require.False(t, capture.HasLog(map[string]interface{}{
+		"message": "Relays frequently return equal bids; they may share a backend",
+	}))
+
+	s.trackEqualBids(auction())
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"message":        "Relays frequently return equal bids; they may share a backend",
+		"provider":       "https://relay1.example.com/",
+		"other_provider": "https://relay2.example.com/",
+	}))
+	require.False(t, capture.HasLog(map[string]interface{}{
+		"message":        "Relays frequently return equal bids; they may share a backend",
+		"other_provider": "https://relay3.example.com/",
+	}))
+
+	// A differing bid clears the state.
+	candidates := auction()
+	candidates[1] = equalBidsCandidate(t, relay2, 2, 1000)
+	s.trackEqualBids(candidates)
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"message":        "Relays no longer frequently return equal bids",
+		"provider":       "https://relay1.example.com/",
+		"other_provider": "https://relay2.example.com/",
+	}))
+}
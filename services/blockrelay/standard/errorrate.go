@@ -0,0 +1,92 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/attestantio/vouch/util"
+)
+
+// errorRateDetector tracks the outcomes of bid requests to relays.  If the
+// proportion of requests to a relay that errored over the window reaches the
+// threshold the relay is considered to have a high error rate.
+type errorRateDetector struct {
+	mu        sync.Mutex
+	window    int
+	threshold float64
+	history   map[string][]bool
+	high      map[string]bool
+}
+
+// newErrorRateDetector creates a new error rate detector.
+func newErrorRateDetector(window int, threshold float64) *errorRateDetector {
+	return &errorRateDetector{
+		window:    window,
+		threshold: threshold,
+		history:   make(map[string][]bool),
+		high:      make(map[string]bool),
+	}
+}
+
+// record records whether a request to a relay errored.
+// It returns the error rate of the relay over the window, whether the error rate
+// is high, and if the high state has changed.
+func (d *errorRateDetector) record(provider string, errored bool) (float64, bool, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	provider = strings.ToLower(provider)
+	history := append(d.history[provider], errored)
+	if len(history) > d.window {
+		history = history[len(history)-d.window:]
+	}
+	d.history[provider] = history
+
+	failures := 0
+	for _, entry := range history {
+		if entry {
+			failures++
+		}
+	}
+	rate := float64(failures) / float64(len(history))
+
+	// Only consider the rate high once there is a full window of requests, to
+	// avoid flagging a relay based on a handful of early failures.
+	high := len(history) == d.window && rate >= d.threshold
+
+	changed := high != d.high[provider]
+	d.high[provider] = high
+
+	return rate, high, changed
+}
+
+// trackRelayError records the outcome of a bid request to a relay.
+func (s *Service) trackRelayError(provider string, errored bool) {
+	rate, high, changed := s.errorRate.record(provider, errored)
+	if label, labelled := s.relayGaugeLabel(provider); labelled {
+		monitorRelayErrorRate(label, rate)
+	}
+	if !changed {
+		return
+	}
+	if high {
+		log.Warn().Str("provider", provider).Float64("error_rate", rate).Int("requests", s.errorRate.window).Msg("Relay error rate above threshold")
+		// Start afresh with a new client for the relay, in case the problem is with its connections.
+		util.EvictBuilderClient(provider)
+	} else {
+		log.Info().Str("provider", provider).Float64("error_rate", rate).Msg("Relay error rate no longer above threshold")
+	}
+}
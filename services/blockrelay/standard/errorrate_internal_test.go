@@ -0,0 +1,60 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorRateDetector(t *testing.T) {
+	d := newErrorRateDetector(4, 0.5)
+
+	// Errors are not enough until the window is full.
+	rate, high, changed := d.record("https://relay1.example.com/", true)
+	require.Equal(t, 1.0, rate)
+	require.False(t, high)
+	require.False(t, changed)
+	_, high, changed = d.record("https://relay1.example.com/", true)
+	require.False(t, high)
+	require.False(t, changed)
+	_, high, changed = d.record("https://relay1.example.com/", false)
+	require.False(t, high)
+	require.False(t, changed)
+
+	// Filling the window with a rate at the threshold results in a high error rate.
+	rate, high, changed = d.record("https://RELAY1.example.com/", false)
+	require.Equal(t, 0.5, rate)
+	require.True(t, high)
+	require.True(t, changed)
+
+	// Remaining high is not a change.
+	rate, high, changed = d.record("https://relay1.example.com/", true)
+	require.Equal(t, 0.5, rate)
+	require.True(t, high)
+	require.False(t, changed)
+
+	// Other relays are tracked separately.
+	rate, high, changed = d.record("https://relay2.example.com/", false)
+	require.Equal(t, 0.0, rate)
+	require.False(t, high)
+	require.False(t, changed)
+
+	// Successes pushing errors out of the window clear the state.
+	rate, high, changed = d.record("https://relay1.example.com/", false)
+	require.Equal(t, 0.25, rate)
+	require.False(t, high)
+	require.True(t, changed)
+}
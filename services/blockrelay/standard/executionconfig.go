@@ -79,9 +79,8 @@ func (s *Service) fetchExecutionConfig(ctx context.Context,
 	}
 
 	// Start with our current execution configuration.
-	s.executionConfigMu.RLock()
-	executionConfig := s.executionConfig
-	s.executionConfigMu.RUnlock()
+	currentExecutionConfig := s.currentExecutionConfig()
+	executionConfig := currentExecutionConfig
 
 	if s.configURL == "" {
 		log.Trace().Msg("No config URL; using default configuration with fallback")
@@ -93,23 +92,39 @@ func (s *Service) fetchExecutionConfig(ctx context.Context,
 			succeeded = false
 			log.Error().Str("config_url", s.configURL).Err(err).Msg("Failed to obtain execution configuration")
 			// Restore current execution configuration.
-			executionConfig = s.executionConfig
+			executionConfig = currentExecutionConfig
 		} else if executionConfig == nil {
 			succeeded = false
 			log.Error().Str("config_url", s.configURL).Msg("Obtained nil execution configuration")
 			// Restore current execution configuration.
-			executionConfig = s.executionConfig
+			executionConfig = currentExecutionConfig
 		}
 		monitorExecutionConfig(time.Since(started), succeeded)
 	}
 
-	s.executionConfigMu.Lock()
-	s.executionConfig = executionConfig
-	s.executionConfigMu.Unlock()
+	s.recordExecutionConfigDiff(ctx, accounts, currentExecutionConfig, executionConfig)
+	s.setExecutionConfig(executionConfig)
+	s.checkMaxRelays(ctx, accounts, executionConfig)
 
 	log.Trace().Msg("Obtained configuration")
 }
 
+// currentExecutionConfig returns the current execution configuration.
+func (s *Service) currentExecutionConfig() blockrelay.ExecutionConfigurator {
+	executionConfig := s.executionConfig.Load()
+	if executionConfig == nil {
+		return nil
+	}
+	return *executionConfig
+}
+
+// setExecutionConfig atomically replaces the current execution configuration.
+// Any precomputed proposer configurations are cleared, as they may no longer be valid.
+func (s *Service) setExecutionConfig(executionConfig blockrelay.ExecutionConfigurator) {
+	s.executionConfig.Store(&executionConfig)
+	s.clearPrecomputedProposerConfigs()
+}
+
 func (s *Service) obtainExecutionConfig(ctx context.Context,
 	pubkeys [][]byte,
 ) (
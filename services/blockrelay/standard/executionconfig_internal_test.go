@@ -0,0 +1,97 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// generationExecutionConfig is an execution configurator whose proposer
+// configurations are all stamped with its generation.
+type generationExecutionConfig struct {
+	generation byte
+}
+
+func (e *generationExecutionConfig) ProposerConfig(_ context.Context,
+	_ e2wtypes.Account,
+	_ phase0.BLSPubKey,
+	_ bellatrix.ExecutionAddress,
+	_ uint64,
+) (
+	*beaconblockproposer.ProposerConfig,
+	error,
+) {
+	config := &beaconblockproposer.ProposerConfig{
+		FeeRecipient: bellatrix.ExecutionAddress{e.generation},
+		Relays:       make([]*beaconblockproposer.RelayConfig, 0, int(e.generation)),
+	}
+	for i := 0; i < int(e.generation); i++ {
+		config.Relays = append(config.Relays, &beaconblockproposer.RelayConfig{
+			Address:      fmt.Sprintf("https://relay%d.example.com/", i),
+			FeeRecipient: bellatrix.ExecutionAddress{e.generation},
+		})
+	}
+	return config, nil
+}
+
+func TestExecutionConfigConcurrentSwap(t *testing.T) {
+	ctx := context.Background()
+
+	s := &Service{}
+	s.setExecutionConfig(&generationExecutionConfig{generation: 1})
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				config, err := s.ProposerConfig(ctx, nil, phase0.BLSPubKey{})
+				if !assert.NoError(t, err) {
+					return
+				}
+				// The configuration must be entirely from a single generation.
+				generation := config.FeeRecipient[0]
+				assert.Len(t, config.Relays, int(generation))
+				for _, relay := range config.Relays {
+					assert.Equal(t, generation, relay.FeeRecipient[0])
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 1000; i++ {
+		s.setExecutionConfig(&generationExecutionConfig{generation: byte(i%8 + 1)})
+	}
+	close(done)
+	wg.Wait()
+
+	require.Equal(t, &generationExecutionConfig{generation: 8}, s.currentExecutionConfig())
+}
@@ -0,0 +1,179 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// LastExecutionConfigDiff returns the changes made by the most recent refresh
+// of the execution configuration that changed the configuration of any managed
+// validator, or nil if there has been no such refresh.
+func (s *Service) LastExecutionConfigDiff(_ context.Context) *blockrelay.ExecutionConfigDiff {
+	return s.lastExecutionConfigDiff.Load()
+}
+
+// recordExecutionConfigDiff logs and stores the changes to the proposer
+// configuration of the given accounts made by replacing an execution configuration.
+func (s *Service) recordExecutionConfigDiff(ctx context.Context,
+	accounts map[phase0.ValidatorIndex]e2wtypes.Account,
+	previous blockrelay.ExecutionConfigurator,
+	current blockrelay.ExecutionConfigurator,
+) {
+	if previous == nil || current == nil || previous == current {
+		return
+	}
+
+	diff := s.executionConfigDiff(ctx, accounts, previous, current)
+	if len(diff.Proposers) == 0 {
+		log.Trace().Msg("Execution configuration refreshed with no changes")
+		return
+	}
+
+	relaysAdded := make(map[string]bool)
+	relaysRemoved := make(map[string]bool)
+	feeRecipientsChanged := 0
+	for pubkey, proposerDiff := range diff.Proposers {
+		e := log.Debug().Str("pubkey", fmt.Sprintf("%#x", pubkey))
+		if len(proposerDiff.RelaysAdded) > 0 {
+			e = e.Strs("relays_added", proposerDiff.RelaysAdded)
+		}
+		if len(proposerDiff.RelaysRemoved) > 0 {
+			e = e.Strs("relays_removed", proposerDiff.RelaysRemoved)
+		}
+		if proposerDiff.FeeRecipient != nil {
+			feeRecipientsChanged++
+			e = e.Str("previous_fee_recipient", fmt.Sprintf("%#x", *proposerDiff.PreviousFeeRecipient)).Str("fee_recipient", fmt.Sprintf("%#x", *proposerDiff.FeeRecipient))
+		}
+		e.Msg("Proposer configuration changed")
+		for _, relay := range proposerDiff.RelaysAdded {
+			relaysAdded[relay] = true
+		}
+		for _, relay := range proposerDiff.RelaysRemoved {
+			relaysRemoved[relay] = true
+		}
+	}
+	log.Info().
+		Int("proposers", len(diff.Proposers)).
+		Strs("relays_added", sortedKeys(relaysAdded)).
+		Strs("relays_removed", sortedKeys(relaysRemoved)).
+		Int("fee_recipients_changed", feeRecipientsChanged).
+		Msg("Execution configuration changed")
+
+	s.lastExecutionConfigDiff.Store(diff)
+}
+
+// executionConfigDiff calculates the changes to the proposer configuration of
+// the given accounts made by replacing an execution configuration.
+func (s *Service) executionConfigDiff(ctx context.Context,
+	accounts map[phase0.ValidatorIndex]e2wtypes.Account,
+	previous blockrelay.ExecutionConfigurator,
+	current blockrelay.ExecutionConfigurator,
+) *blockrelay.ExecutionConfigDiff {
+	diff := &blockrelay.ExecutionConfigDiff{
+		Timestamp: time.Now(),
+		Proposers: make(map[phase0.BLSPubKey]*blockrelay.ProposerConfigDiff),
+	}
+
+	for _, account := range accounts {
+		var pubkey phase0.BLSPubKey
+		if provider, isProvider := account.(e2wtypes.AccountCompositePublicKeyProvider); isProvider {
+			copy(pubkey[:], provider.CompositePublicKey().Marshal())
+		} else {
+			copy(pubkey[:], account.PublicKey().Marshal())
+		}
+
+		previousConfig, err := previous.ProposerConfig(ctx, account, pubkey, s.fallbackFeeRecipient, s.fallbackGasLimit)
+		if err != nil {
+			log.Debug().Str("pubkey", fmt.Sprintf("%#x", pubkey)).Err(err).Msg("Failed to obtain previous proposer configuration; not comparing")
+			continue
+		}
+		currentConfig, err := current.ProposerConfig(ctx, account, pubkey, s.fallbackFeeRecipient, s.fallbackGasLimit)
+		if err != nil {
+			log.Debug().Str("pubkey", fmt.Sprintf("%#x", pubkey)).Err(err).Msg("Failed to obtain proposer configuration; not comparing")
+			continue
+		}
+
+		if proposerDiff := proposerConfigDiff(previousConfig, currentConfig); proposerDiff != nil {
+			diff.Proposers[pubkey] = proposerDiff
+		}
+	}
+
+	return diff
+}
+
+// proposerConfigDiff calculates the changes between two proposer configurations,
+// returning nil if there are none.
+func proposerConfigDiff(previous *beaconblockproposer.ProposerConfig,
+	current *beaconblockproposer.ProposerConfig,
+) *blockrelay.ProposerConfigDiff {
+	previousRelays := make(map[string]bool, len(previous.Relays))
+	for _, relay := range previous.Relays {
+		previousRelays[relay.Address] = true
+	}
+	currentRelays := make(map[string]bool, len(current.Relays))
+	for _, relay := range current.Relays {
+		currentRelays[relay.Address] = true
+	}
+
+	diff := &blockrelay.ProposerConfigDiff{
+		RelaysAdded:   make([]string, 0),
+		RelaysRemoved: make([]string, 0),
+	}
+	for relay := range currentRelays {
+		if !previousRelays[relay] {
+			diff.RelaysAdded = append(diff.RelaysAdded, relay)
+		}
+	}
+	for relay := range previousRelays {
+		if !currentRelays[relay] {
+			diff.RelaysRemoved = append(diff.RelaysRemoved, relay)
+		}
+	}
+	sort.Strings(diff.RelaysAdded)
+	sort.Strings(diff.RelaysRemoved)
+
+	if !bytes.Equal(previous.FeeRecipient[:], current.FeeRecipient[:]) {
+		previousFeeRecipient := previous.FeeRecipient
+		currentFeeRecipient := current.FeeRecipient
+		diff.PreviousFeeRecipient = &previousFeeRecipient
+		diff.FeeRecipient = &currentFeeRecipient
+	}
+
+	if len(diff.RelaysAdded) == 0 && len(diff.RelaysRemoved) == 0 && diff.FeeRecipient == nil {
+		return nil
+	}
+
+	return diff
+}
+
+// sortedKeys returns the keys of the map in order.
+func sortedKeys(input map[string]bool) []string {
+	res := make([]string, 0, len(input))
+	for key := range input {
+		res = append(res, key)
+	}
+	sort.Strings(res)
+
+	return res
+}
@@ -0,0 +1,91 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
+	"github.com/stretchr/testify/require"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+func TestExecutionConfigDiff(t *testing.T) {
+	ctx := context.Background()
+
+	s := testAuctionService(t)
+	s.fallbackFeeRecipient = bellatrix.ExecutionAddress{0x01}
+	s.fallbackGasLimit = 30000000
+
+	account, pubkey := testAccount(t)
+	accounts := map[phase0.ValidatorIndex]e2wtypes.Account{
+		0: account,
+	}
+
+	previous, err := blockrelay.UnmarshalJSON([]byte(`{"version":2,"fee_recipient":"0x1111111111111111111111111111111111111111","relays":{"https://relay1.example.com/":{},"https://relay2.example.com/":{}}}`))
+	require.NoError(t, err)
+	current, err := blockrelay.UnmarshalJSON([]byte(`{"version":2,"fee_recipient":"0x2222222222222222222222222222222222222222","relays":{"https://relay2.example.com/":{},"https://relay3.example.com/":{}}}`))
+	require.NoError(t, err)
+	unchanged, err := blockrelay.UnmarshalJSON([]byte(`{"version":2,"fee_recipient":"0x2222222222222222222222222222222222222222","relays":{"https://relay3.example.com/":{},"https://relay2.example.com/":{}}}`))
+	require.NoError(t, err)
+
+	// No refresh yet.
+	require.Nil(t, s.LastExecutionConfigDiff(ctx))
+
+	// Refresh with changes.
+	s.recordExecutionConfigDiff(ctx, accounts, previous, current)
+	diff := s.LastExecutionConfigDiff(ctx)
+	require.NotNil(t, diff)
+	require.Len(t, diff.Proposers, 1)
+	require.Equal(t, &blockrelay.ProposerConfigDiff{
+		RelaysAdded:          []string{"https://relay3.example.com/"},
+		RelaysRemoved:        []string{"https://relay1.example.com/"},
+		PreviousFeeRecipient: &bellatrix.ExecutionAddress{0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11},
+		FeeRecipient:         &bellatrix.ExecutionAddress{0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22},
+	}, diff.Proposers[pubkey])
+
+	// Refresh without changes retains the previous diff.
+	s.recordExecutionConfigDiff(ctx, accounts, current, unchanged)
+	require.Equal(t, diff, s.LastExecutionConfigDiff(ctx))
+}
+
+func TestProposerConfigDiff(t *testing.T) {
+	previousConfig := &beaconblockproposer.ProposerConfig{
+		FeeRecipient: bellatrix.ExecutionAddress{0x01},
+		Relays: []*beaconblockproposer.RelayConfig{
+			{Address: "https://relay1.example.com/"},
+		},
+	}
+	relayAddedConfig := &beaconblockproposer.ProposerConfig{
+		FeeRecipient: bellatrix.ExecutionAddress{0x01},
+		Relays: []*beaconblockproposer.RelayConfig{
+			{Address: "https://relay2.example.com/"},
+			{Address: "https://relay1.example.com/"},
+		},
+	}
+
+	require.Nil(t, proposerConfigDiff(previousConfig, previousConfig))
+	require.Equal(t, &blockrelay.ProposerConfigDiff{
+		RelaysAdded:   []string{"https://relay2.example.com/"},
+		RelaysRemoved: []string{},
+	}, proposerConfigDiff(previousConfig, relayAddedConfig))
+	require.Equal(t, &blockrelay.ProposerConfigDiff{
+		RelaysAdded:   []string{},
+		RelaysRemoved: []string{"https://relay2.example.com/"},
+	}, proposerConfigDiff(relayAddedConfig, previousConfig))
+}
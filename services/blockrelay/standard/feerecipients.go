@@ -0,0 +1,66 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
+	"github.com/pkg/errors"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// FeeRecipientsReport returns the fee recipient for each validator managed by this instance,
+// ordered by validator index.  Fee recipients are resolved in the same way as for an auction.
+func (s *Service) FeeRecipientsReport(ctx context.Context) ([]*blockrelay.FeeRecipientReportEntry, error) {
+	accounts, err := s.validatingAccountsProvider.ValidatingAccountsForEpoch(ctx, s.chainTime.CurrentEpoch())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain validating accounts")
+	}
+
+	report := make([]*blockrelay.FeeRecipientReportEntry, 0, len(accounts))
+	fallbacks := 0
+	for index, account := range accounts {
+		var pubkey phase0.BLSPubKey
+		if provider, isProvider := account.(e2wtypes.AccountCompositePublicKeyProvider); isProvider {
+			copy(pubkey[:], provider.CompositePublicKey().Marshal())
+		} else {
+			copy(pubkey[:], account.PublicKey().Marshal())
+		}
+		proposerConfig, err := s.ProposerConfig(ctx, account, pubkey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain proposer configuration for validator %d", index)
+		}
+		if proposerConfig.FeeRecipientSource == beaconblockproposer.FeeRecipientSourceFallback {
+			fallbacks++
+		}
+		report = append(report, &blockrelay.FeeRecipientReportEntry{
+			Index:        index,
+			Account:      account.Name(),
+			Pubkey:       pubkey,
+			FeeRecipient: proposerConfig.FeeRecipient,
+			Source:       proposerConfig.FeeRecipientSource,
+		})
+	}
+	sort.Slice(report, func(i, j int) bool { return report[i].Index < report[j].Index })
+
+	if fallbacks > 0 {
+		log.Warn().Int("validators", fallbacks).Msg("Some validators are using the fallback fee recipient")
+	}
+
+	return report, nil
+}
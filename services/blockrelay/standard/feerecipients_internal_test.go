@@ -0,0 +1,151 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/vouch/mock"
+	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	mockscheduler "github.com/attestantio/vouch/services/scheduler/mock"
+	mocksigner "github.com/attestantio/vouch/services/signer/mock"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/attestantio/vouch/testutil"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	e2types "github.com/wealdtech/go-eth2-types/v2"
+	e2wallet "github.com/wealdtech/go-eth2-wallet"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	nd "github.com/wealdtech/go-eth2-wallet-nd/v2"
+	scratch "github.com/wealdtech/go-eth2-wallet-store-scratch"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+	directconfidant "github.com/wealdtech/go-majordomo/confidants/direct"
+	fileconfidant "github.com/wealdtech/go-majordomo/confidants/file"
+	standardmajordomo "github.com/wealdtech/go-majordomo/standard"
+)
+
+func TestFeeRecipientsReport(t *testing.T) {
+	ctx := context.Background()
+
+	mockValidatingAccountsProvider := mockaccountmanager.NewValidatingAccountsProvider()
+	require.NoError(t, e2types.InitBLS())
+	store := scratch.New()
+	require.NoError(t, e2wallet.UseStore(store))
+	testWallet, err := nd.CreateWallet(ctx, "Test wallet", store, keystorev4.New())
+	require.NoError(t, err)
+	require.NoError(t, testWallet.(e2wtypes.WalletLocker).Unlock(ctx, nil))
+	account0, err := testWallet.(e2wtypes.WalletAccountImporter).ImportAccount(ctx,
+		"Interop 0",
+		testutil.HexToBytes("0x25295f0d1d592a90b333e26e85149708208e9f8e8bc18f6c77bd62f8ad7a6866"),
+		[]byte("pass"),
+	)
+	require.NoError(t, err)
+	account1, err := testWallet.(e2wtypes.WalletAccountImporter).ImportAccount(ctx,
+		"Interop 1",
+		testutil.HexToBytes("0x51d0b65185db6989ab0b560d6deed19c7ead0e24b9b6372cbecb1f26bdfad000"),
+		[]byte("pass"),
+	)
+	require.NoError(t, err)
+	mockValidatingAccountsProvider.AddAccount(2, account1)
+	mockValidatingAccountsProvider.AddAccount(1, account0)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithLogLevel(zerolog.Disabled),
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	majordomoSvc, err := standardmajordomo.New(ctx)
+	require.NoError(t, err)
+	fileConfidant, err := fileconfidant.New(ctx)
+	require.NoError(t, err)
+	require.NoError(t, majordomoSvc.RegisterConfidant(ctx, fileConfidant))
+	directConfidant, err := directconfidant.New(ctx)
+	require.NoError(t, err)
+	require.NoError(t, majordomoSvc.RegisterConfidant(ctx, directConfidant))
+
+	base, err := os.MkdirTemp("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(base)
+	configFile := filepath.Join(base, "config.json")
+	require.NoError(t, os.WriteFile(configFile, []byte(`{"version":2}`), 0o600))
+
+	// A single service is used for all tests, as the module logger is replaced
+	// each time a service is created and would race with the background
+	// submission of validator registrations started by an earlier service.
+	capture := logger.NewLogCapture()
+	s, err := New(ctx,
+		WithMonitor(nullmetrics.New(ctx)),
+		WithTimeout(time.Second),
+		WithMajordomo(majordomoSvc),
+		WithScheduler(mockscheduler.New()),
+		WithListenAddress("0.0.0.0:13532"),
+		WithChainTime(chainTime),
+		WithConfigURL(fmt.Sprintf("file://%s", configFile)),
+		WithFallbackFeeRecipient(bellatrix.ExecutionAddress{0x01}),
+		WithFallbackGasLimit(10000000),
+		WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+		WithAccountsProvider(mockaccountmanager.NewAccountsProvider()),
+		WithValidatorRegistrationSigner(mocksigner.New()),
+		WithSpecProvider(mock.NewSpecProvider()),
+		WithDomainProvider(mock.NewDomainProvider()),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		config   string
+		report   string
+		logEntry string
+	}{
+		{
+			name:     "Fallback",
+			config:   `{"version":2}`,
+			report:   `[{"index":"1","account":"Interop 0","pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","fee_recipient":"0x0100000000000000000000000000000000000000","source":"fallback"},{"index":"2","account":"Interop 1","pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","fee_recipient":"0x0100000000000000000000000000000000000000","source":"fallback"}]`,
+			logEntry: "Some validators are using the fallback fee recipient",
+		},
+		{
+			name:   "Config",
+			config: `{"version":2,"fee_recipient":"0x0200000000000000000000000000000000000000","proposers":[{"proposer":"Test wallet/Interop 1","fee_recipient":"0x0300000000000000000000000000000000000000"}]}`,
+			report: `[{"index":"1","account":"Interop 0","pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","fee_recipient":"0x0200000000000000000000000000000000000000","source":"default"},{"index":"2","account":"Interop 1","pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","fee_recipient":"0x0300000000000000000000000000000000000000","source":"account"}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.NoError(t, os.WriteFile(configFile, []byte(test.config), 0o600))
+			s.fetchExecutionConfig(ctx, nil)
+
+			report, err := s.FeeRecipientsReport(ctx)
+			require.NoError(t, err)
+			data, err := json.Marshal(report)
+			require.NoError(t, err)
+			require.Equal(t, test.report, string(data))
+			if test.logEntry != "" {
+				capture.AssertHasEntry(t, test.logEntry)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// errAuctionDecided is the cause given to requests that are abandoned because
+// the auction has been decided by the first acceptable bid.
+var errAuctionDecided = errors.New("auction decided by first acceptable bid")
+
+// isAbandoned returns true if the context is for a request that was abandoned
+// because the auction had already been decided.
+func isAbandoned(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), errAuctionDecided)
+}
+
+// erroredRelay returns the relay to which an error from a bid request relates,
+// or an empty string if it cannot be determined.  Errors from bid requests are
+// prefixed with the address of the relay.
+func erroredRelay(err error, relays []string) string {
+	msg := err.Error()
+	for _, relay := range relays {
+		if strings.HasPrefix(msg, relay+": ") {
+			return relay
+		}
+	}
+
+	return ""
+}
+
+// abandonedRelays returns the relays that were queried but had not settled,
+// in the order in which they were queried.
+func abandonedRelays(queried []string, settled map[string]struct{}) []string {
+	abandoned := make([]string, 0)
+	for _, relay := range queried {
+		if _, exists := settled[relay]; !exists {
+			abandoned = append(abandoned, relay)
+		}
+	}
+
+	return abandoned
+}
@@ -0,0 +1,137 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v2 "github.com/attestantio/vouch/services/blockrelay/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuctionBlockFirstAcceptableBid(t *testing.T) {
+	ctx := context.Background()
+	setTestTimeout(t)
+
+	parentHash := testParentHash()
+
+	tests := []struct {
+		name               string
+		firstAcceptableBid bool
+		slowRelayDelay     time.Duration
+		belowMinValue      bool
+		expectedAbandoned  bool
+		expectedValues     int
+		maxDuration        time.Duration
+	}{
+		{
+			name:           "Disabled",
+			slowRelayDelay: 300 * time.Millisecond,
+			expectedValues: 2,
+		},
+		{
+			name:               "Enabled",
+			firstAcceptableBid: true,
+			slowRelayDelay:     5 * time.Second,
+			expectedAbandoned:  true,
+			expectedValues:     1,
+			maxDuration:        250 * time.Millisecond,
+		},
+		{
+			name:               "BelowMinValue",
+			firstAcceptableBid: true,
+			slowRelayDelay:     300 * time.Millisecond,
+			belowMinValue:      true,
+			expectedValues:     1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(testBidJSON)
+			}))
+			defer fast.Close()
+			slowCancelled := make(chan struct{})
+			slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+					close(slowCancelled)
+				case <-time.After(test.slowRelayDelay):
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write(testBidJSON)
+				}
+			}))
+			defer slow.Close()
+
+			s, pubkey := testProposerAuctionService(t)
+			s.timeout = 10 * time.Second
+			s.firstAcceptableBid = test.firstAcceptableBid
+			s.auctionHistory = newAuctionHistory(1)
+			fastConfig := &v2.BaseRelayConfig{}
+			if test.belowMinValue {
+				// The fast relay's bid is not acceptable, so the auction
+				// waits for the slow relay.
+				minValue := decimal.New(1, 30)
+				fastConfig.MinValue = &minValue
+			}
+			s.setExecutionConfig(&v2.ExecutionConfig{
+				Relays: map[string]*v2.BaseRelayConfig{
+					fast.URL: fastConfig,
+					slow.URL: {},
+				},
+			})
+
+			started := time.Now()
+			res, err := s.AuctionBlock(ctx, 0, parentHash, pubkey)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			if test.maxDuration > 0 {
+				require.Less(t, time.Since(started), test.maxDuration)
+			}
+
+			records := s.AuctionHistory(ctx)
+			require.Len(t, records, 1)
+			require.NotEmpty(t, records[0].Winners)
+			require.Len(t, records[0].Values, test.expectedValues)
+			if !test.expectedAbandoned {
+				require.Empty(t, records[0].Abandoned)
+				return
+			}
+
+			require.Len(t, records[0].Abandoned, 1)
+			require.Len(t, records[0].Winners, 1)
+			require.Equal(t, slow.URL, strings.TrimSuffix(records[0].Abandoned[0], "/"))
+			require.Equal(t, fast.URL, strings.TrimSuffix(records[0].Winners[0], "/"))
+
+			// The abandoned request is cancelled, and not counted as an error
+			// for the relay.
+			select {
+			case <-slowCancelled:
+			case <-time.After(time.Second):
+				require.Fail(t, "slow relay request not cancelled")
+			}
+			time.Sleep(100 * time.Millisecond)
+			rate, _, _ := s.errorRate.record(records[0].Abandoned[0], false)
+			require.Equal(t, 0.0, rate)
+		})
+	}
+}
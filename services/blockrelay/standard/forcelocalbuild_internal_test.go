@@ -0,0 +1,66 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/vouch/services/blockrelay"
+	v2 "github.com/attestantio/vouch/services/blockrelay/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuctionBlockForceLocalBuild(t *testing.T) {
+	ctx := context.Background()
+	bidding := newTestBidRelay(t)
+
+	s, pubkey := testProposerAuctionService(t)
+	noBidHandler := &recordingNoBidHandler{}
+	s.noBidHandler = noBidHandler
+	s.builderBidsCache = make(map[string]map[string]*builderspec.VersionedSignedBuilderBid)
+
+	parentHash := testParentHash()
+
+	// Relay is queried without force local build.
+	s.setExecutionConfig(&v2.ExecutionConfig{
+		Relays: map[string]*v2.BaseRelayConfig{
+			bidding.URL: {},
+		},
+	})
+	res, err := s.AuctionBlock(ctx, 0, parentHash, pubkey)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, 1, bidding.Requests())
+
+	// Relay is not queried with force local build.
+	s.setExecutionConfig(&v2.ExecutionConfig{
+		Relays: map[string]*v2.BaseRelayConfig{
+			bidding.URL: {},
+		},
+		Proposers: []*v2.ProposerConfig{
+			{
+				Validator:       pubkey,
+				ForceLocalBuild: true,
+			},
+		},
+	})
+	res, err = s.AuctionBlock(ctx, 0, parentHash, pubkey)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, 1, bidding.Requests())
+	require.Equal(t, 1, noBidHandler.calls)
+	require.Equal(t, blockrelay.NoBidReasonForceLocalBuild, noBidHandler.reason)
+}
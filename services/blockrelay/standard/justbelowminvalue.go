@@ -0,0 +1,102 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// justBelowMinValueDetector tracks how the bids provided by relays compare to
+// their minimum values.  If every bid from a relay over the window is below the
+// minimum value but within the margin of it the relay is considered to be
+// consistently bidding just below its minimum value.
+type justBelowMinValueDetector struct {
+	mu        sync.Mutex
+	window    int
+	margin    float64
+	history   map[string][]bool
+	justBelow map[string]bool
+}
+
+// newJustBelowMinValueDetector creates a new just below minimum value detector.
+func newJustBelowMinValueDetector(window int, margin float64) *justBelowMinValueDetector {
+	return &justBelowMinValueDetector{
+		window:    window,
+		margin:    margin,
+		history:   make(map[string][]bool),
+		justBelow: make(map[string]bool),
+	}
+}
+
+// isJustBelow returns true if the value is below the minimum value, but within
+// the margin of it.
+func (d *justBelowMinValueDetector) isJustBelow(value *big.Int, minValue *big.Int) bool {
+	if minValue.Sign() <= 0 || value.Cmp(minValue) >= 0 {
+		return false
+	}
+	ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(value), new(big.Float).SetInt(minValue)).Float64()
+
+	return ratio >= 1-d.margin
+}
+
+// record records the value of a bid from a relay against its minimum value.
+// It returns whether the relay is consistently bidding just below its minimum
+// value, and if the state has changed.
+func (d *justBelowMinValueDetector) record(provider string, value *big.Int, minValue *big.Int) (bool, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	provider = strings.ToLower(provider)
+	history := append(d.history[provider], d.isJustBelow(value, minValue))
+	if len(history) > d.window {
+		history = history[len(history)-d.window:]
+	}
+	d.history[provider] = history
+
+	justBelow := len(history) == d.window
+	for _, entry := range history {
+		if !entry {
+			justBelow = false
+			break
+		}
+	}
+
+	changed := justBelow != d.justBelow[provider]
+	d.justBelow[provider] = justBelow
+
+	return justBelow, changed
+}
+
+// trackJustBelowMinValue records the value of a bid from a relay against its minimum value.
+func (s *Service) trackJustBelowMinValue(provider string, value *big.Int, minValue *big.Int) {
+	if s.justBelowMinValue == nil || minValue.Sign() <= 0 {
+		// Not tracking, or nothing to track against.
+		return
+	}
+
+	justBelow, changed := s.justBelowMinValue.record(provider, value, minValue)
+	if !changed {
+		return
+	}
+	if label, labelled := s.relayGaugeLabel(provider); labelled {
+		monitorRelayJustBelowMinValue(label, justBelow)
+	}
+	if justBelow {
+		log.Warn().Str("provider", provider).Stringer("min_value", minValue).Float64("margin", s.justBelowMinValue.margin).Int("bids", s.justBelowMinValue.window).Msg("Relay consistently bidding just below minimum value; minimum value may be misconfigured")
+	} else {
+		log.Info().Str("provider", provider).Msg("Relay no longer consistently bidding just below minimum value")
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJustBelowMinValueDetector(t *testing.T) {
+	d := newJustBelowMinValueDetector(3, 0.1)
+	minValue := big.NewInt(1000)
+
+	require.False(t, d.isJustBelow(big.NewInt(1000), minValue))
+	require.True(t, d.isJustBelow(big.NewInt(999), minValue))
+	require.True(t, d.isJustBelow(big.NewInt(900), minValue))
+	require.False(t, d.isJustBelow(big.NewInt(899), minValue))
+	require.False(t, d.isJustBelow(big.NewInt(999), big.NewInt(0)))
+
+	// Bids just below are not enough until the window is full.
+	justBelow, changed := d.record("https://relay1.example.com/", big.NewInt(950), minValue)
+	require.False(t, justBelow)
+	require.False(t, changed)
+	justBelow, changed = d.record("https://relay1.example.com/", big.NewInt(990), minValue)
+	require.False(t, justBelow)
+	require.False(t, changed)
+
+	// Filling the window flags the relay.
+	justBelow, changed = d.record("https://RELAY1.example.com/", big.NewInt(999), minValue)
+	require.True(t, justBelow)
+	require.True(t, changed)
+
+	// Other relays are tracked separately.
+	justBelow, changed = d.record("https://relay2.example.com/", big.NewInt(999), minValue)
+	require.False(t, justBelow)
+	require.False(t, changed)
+
+	// A bid that clears the minimum value clears the state.
+	justBelow, changed = d.record("https://relay1.example.com/", big.NewInt(1001), minValue)
+	require.False(t, justBelow)
+	require.True(t, changed)
+}
+
+func TestBuilderBidJustBelowMinValue(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		minValue decimal.Decimal
+		flagged  bool
+	}{
+		{
+			// Bid value is 52499999853000, just below this minimum value.
+			name:     "JustBelow",
+			minValue: decimal.New(53, 12),
+			flagged:  true,
+		},
+		{
+			name:     "FarBelow",
+			minValue: decimal.New(1, 18),
+		},
+		{
+			name:     "Above",
+			minValue: decimal.New(1, 12),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewModuleLogCapture(t, &log)
+
+			s := testAuctionService(t)
+			s.justBelowMinValue = newJustBelowMinValueDetector(3, 0.05)
+			provider := &mock.BuilderClient{
+				MockAddress: "relay",
+				MockBid:     testBid(t),
+			}
+			for i := 0; i < 3; i++ {
+				_, err := runBuilderBid(ctx, s, provider, &beaconblockproposer.RelayConfig{
+					MinValue: test.minValue,
+				})
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.flagged, capture.HasLog(map[string]interface{}{
+				"message":  "Relay consistently bidding just below minimum value; minimum value may be misconfigured",
+				"provider": "relay",
+			}))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+)
+
+// otherRelayLabel is the metric label used for relays that are not individually labelled.
+const otherRelayLabel = "other"
+
+// unknownCohortLabel is the metric label used for proposers whose cohort is not known.
+const unknownCohortLabel = "unknown"
+
+// proposerCohorts are the metric labels for proposer cohorts.
+var proposerCohorts = []string{
+	string(beaconblockproposer.FeeRecipientSourceFallback),
+	string(beaconblockproposer.FeeRecipientSourceDefault),
+	string(beaconblockproposer.FeeRecipientSourceAccount),
+	string(beaconblockproposer.FeeRecipientSourceValidator),
+	unknownCohortLabel,
+}
+
+// proposerCohortLabel returns the metric label for the cohort of a proposer,
+// which is the part of the execution configuration that supplied its
+// configuration.  This bounds the cardinality of proposer metrics, while
+// still showing if proposers are falling through to the fallback.
+func proposerCohortLabel(proposerConfig *beaconblockproposer.ProposerConfig) string {
+	if proposerConfig == nil {
+		return unknownCohortLabel
+	}
+	switch proposerConfig.FeeRecipientSource {
+	case beaconblockproposer.FeeRecipientSourceFallback,
+		beaconblockproposer.FeeRecipientSourceDefault,
+		beaconblockproposer.FeeRecipientSourceAccount,
+		beaconblockproposer.FeeRecipientSourceValidator:
+		return string(proposerConfig.FeeRecipientSource)
+	default:
+		return unknownCohortLabel
+	}
+}
+
+// relayHost returns the lower-cased host of a relay address, which may or may not
+// have a scheme, path or credentials.
+func relayHost(address string) string {
+	if !strings.Contains(address, "://") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	base, err := url.Parse(address)
+	if err != nil {
+		return strings.ToLower(address)
+	}
+
+	return strings.ToLower(base.Hostname())
+}
+
+// relayLabel returns the metric label for a relay.  If metric relays have
+// been configured then relays that are not among them are labelled "other",
+// to bound the cardinality of relay metrics.
+func (s *Service) relayLabel(address string) string {
+	if address == "" || len(s.metricRelays) == 0 {
+		return address
+	}
+	if _, exists := s.metricRelays[relayHost(address)]; exists {
+		return address
+	}
+
+	return otherRelayLabel
+}
+
+// relayGaugeLabel returns the metric label for a relay's gauges, and true if
+// the relay's gauges should be set.  Gauges are not reported for relays that
+// would be labelled "other", as the relays sharing the label would overwrite
+// each other's values.
+func (s *Service) relayGaugeLabel(address string) (string, bool) {
+	label := s.relayLabel(address)
+	if label == otherRelayLabel {
+		return "", false
+	}
+
+	return label, true
+}
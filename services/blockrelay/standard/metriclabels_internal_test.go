@@ -0,0 +1,137 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"testing"
+
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayLabel(t *testing.T) {
+	tests := []struct {
+		name         string
+		metricRelays []string
+		address      string
+		expected     string
+	}{
+		{
+			name:     "NoMetricRelays",
+			address:  "https://relay1.example.com/",
+			expected: "https://relay1.example.com/",
+		},
+		{
+			name:         "Empty",
+			metricRelays: []string{"relay1.example.com"},
+			expected:     "",
+		},
+		{
+			name:         "Listed",
+			metricRelays: []string{"relay1.example.com"},
+			address:      "https://relay1.example.com/",
+			expected:     "https://relay1.example.com/",
+		},
+		{
+			name:         "ListedWithCredentials",
+			metricRelays: []string{"https://RELAY1.example.com"},
+			address:      "https://0xac6e77dfe25ecd6110b8e780608cce0dab71fdd5ebea22a16c0205200f2f8e2e3ad3b71d3499c54ad14d6c21b41a37ae@relay1.example.com/",
+			expected:     "https://0xac6e77dfe25ecd6110b8e780608cce0dab71fdd5ebea22a16c0205200f2f8e2e3ad3b71d3499c54ad14d6c21b41a37ae@relay1.example.com/",
+		},
+		{
+			name:         "NotListed",
+			metricRelays: []string{"relay1.example.com"},
+			address:      "https://relay2.example.com/",
+			expected:     "other",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				metricRelays: make(map[string]struct{}),
+			}
+			for _, relay := range test.metricRelays {
+				s.metricRelays[relayHost(relay)] = struct{}{}
+			}
+			require.Equal(t, test.expected, s.relayLabel(test.address))
+		})
+	}
+}
+
+func TestRelayGaugeLabel(t *testing.T) {
+	s := &Service{
+		metricRelays: map[string]struct{}{
+			"relay1.example.com": {},
+		},
+	}
+
+	label, labelled := s.relayGaugeLabel("https://relay1.example.com/")
+	require.True(t, labelled)
+	require.Equal(t, "https://relay1.example.com/", label)
+
+	_, labelled = s.relayGaugeLabel("https://relay2.example.com/")
+	require.False(t, labelled)
+
+	// All relays are labelled if metric relays have not been configured.
+	s.metricRelays = make(map[string]struct{})
+	label, labelled = s.relayGaugeLabel("https://relay2.example.com/")
+	require.True(t, labelled)
+	require.Equal(t, "https://relay2.example.com/", label)
+}
+
+func TestProposerCohortLabel(t *testing.T) {
+	tests := []struct {
+		name           string
+		proposerConfig *beaconblockproposer.ProposerConfig
+		expected       string
+	}{
+		{
+			name:     "Nil",
+			expected: "unknown",
+		},
+		{
+			name:           "SourceMissing",
+			proposerConfig: &beaconblockproposer.ProposerConfig{},
+			expected:       "unknown",
+		},
+		{
+			name: "SourceUnknown",
+			proposerConfig: &beaconblockproposer.ProposerConfig{
+				FeeRecipientSource: "invalid",
+			},
+			expected: "unknown",
+		},
+		{
+			name: "Fallback",
+			proposerConfig: &beaconblockproposer.ProposerConfig{
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceFallback,
+			},
+			expected: "fallback",
+		},
+		{
+			name: "Validator",
+			proposerConfig: &beaconblockproposer.ProposerConfig{
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceValidator,
+			},
+			expected: "validator",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, proposerCohortLabel(test.proposerConfig))
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/attestantio/vouch/services/blockrelay"
 	"github.com/attestantio/vouch/services/metrics"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -25,11 +26,31 @@ import (
 var (
 	auctionBlockUsed                 *prometheus.CounterVec
 	auctionBlockTimer                prometheus.Histogram
+	proposalPhaseTimer               *prometheus.HistogramVec
 	builderBidCounter                *prometheus.CounterVec
 	builderBidTimer                  prometheus.Histogram
 	builderBidDeltas                 *prometheus.HistogramVec
+	belowMinValueCounter             *prometheus.CounterVec
+	versionMismatchCounter           *prometheus.CounterVec
+	relayUncompetitive               *prometheus.GaugeVec
+	relayErrorRate                   *prometheus.GaugeVec
+	relayEqualBidRate                *prometheus.GaugeVec
+	relayJustBelowMinValue           *prometheus.GaugeVec
+	relayGrace                       *prometheus.HistogramVec
+	relayPostGraceBudget             *prometheus.HistogramVec
+	clockSkewSuspected               prometheus.Gauge
 	executionConfigCounter           *prometheus.CounterVec
 	executionConfigTimer             prometheus.Histogram
+	lateBidsCounter                  prometheus.Counter
+	auctionRetriesCounter            *prometheus.CounterVec
+	auctionAbandonedCounter          *prometheus.CounterVec
+	auctionWinnerTimingCounter       *prometheus.CounterVec
+	salvagedBidsCounter              *prometheus.CounterVec
+	blockCanonicalCounter            *prometheus.CounterVec
+	winningBidGasUsed                prometheus.Gauge
+	winningBidGasLimit               prometheus.Gauge
+	winningBidGasRatio               prometheus.Histogram
+	proposerConfigCounter            *prometheus.CounterVec
 	validatorRegistrationsCounter    *prometheus.CounterVec
 	validatorRegistrationsGeneration *prometheus.CounterVec
 	validatorRegistrationsTimer      prometheus.Histogram
@@ -77,6 +98,20 @@ func registerPrometheusMetrics(_ context.Context) error {
 		return err
 	}
 
+	proposalPhaseTimer = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_auction_block",
+		Name:      "phase_duration_seconds",
+		Help:      "The time vouch spends in each phase of the proposal path.",
+		Buckets: []float64{
+			0.01, 0.02, 0.05, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1.0,
+			1.2, 1.4, 1.6, 1.8, 2.0, 2.5, 3.0, 3.5, 4.0,
+		},
+	}, []string{"phase"})
+	if err := prometheus.Register(proposalPhaseTimer); err != nil {
+		return err
+	}
+
 	executionConfigCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "vouch",
 		Subsystem: "relay_execution_config",
@@ -105,6 +140,22 @@ func registerPrometheusMetrics(_ context.Context) error {
 		return err
 	}
 
+	proposerConfigCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_proposer_config",
+		Name:      "total",
+		Help:      "The number of proposer configuration resolutions for auctions",
+	}, []string{"result", "cohort"})
+	if err := prometheus.Register(proposerConfigCounter); err != nil {
+		return err
+	}
+	proposerConfigCounter.WithLabelValues("failed", unknownCohortLabel).Add(0)
+	for _, cohort := range proposerCohorts {
+		proposerConfigCounter.WithLabelValues("succeeded", cohort).Add(0)
+		proposerConfigCounter.WithLabelValues("no_relays", cohort).Add(0)
+		proposerConfigCounter.WithLabelValues("force_local_build", cohort).Add(0)
+	}
+
 	builderBidCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "vouch",
 		Subsystem: "relay_builder_bid",
@@ -144,6 +195,200 @@ func registerPrometheusMetrics(_ context.Context) error {
 		return err
 	}
 
+	belowMinValueCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "relay",
+		Name:      "below_min_value_total",
+		Help:      "The number of bids from the relay that were below the minimum value.",
+	}, []string{"relay"})
+	if err := prometheus.Register(belowMinValueCounter); err != nil {
+		return err
+	}
+
+	versionMismatchCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "relay",
+		Name:      "version_mismatch_total",
+		Help:      "The number of bids from the relay that did not have the expected version.",
+	}, []string{"relay"})
+	if err := prometheus.Register(versionMismatchCounter); err != nil {
+		return err
+	}
+
+	relayUncompetitive = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "relay",
+		Name:      "uncompetitive",
+		Help:      "Set to 1 if the relay's bids have been consistently uncompetitive.",
+	}, []string{"relay"})
+	if err := prometheus.Register(relayUncompetitive); err != nil {
+		return err
+	}
+
+	relayErrorRate = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "relay",
+		Name:      "error_rate",
+		Help:      "The proportion of recent bid requests to the relay that errored.",
+	}, []string{"relay"})
+	if err := prometheus.Register(relayErrorRate); err != nil {
+		return err
+	}
+
+	relayEqualBidRate = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "relay",
+		Name:      "equal_bid_rate",
+		Help:      "The proportion of recent auctions in which a pair of relays returned equal bids.",
+	}, []string{"relay", "other_relay"})
+	if err := prometheus.Register(relayEqualBidRate); err != nil {
+		return err
+	}
+
+	relayJustBelowMinValue = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "relay",
+		Name:      "just_below_min_value",
+		Help:      "Set to 1 if the relay's bids have been consistently just below its minimum value.",
+	}, []string{"relay"})
+	if err := prometheus.Register(relayJustBelowMinValue); err != nil {
+		return err
+	}
+
+	relayGrace = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "vouch",
+		Subsystem: "relay",
+		Name:      "grace_seconds",
+		Help:      "The grace period applied before requesting a bid from the relay.",
+		Buckets: []float64{
+			0.0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9,
+			1.0, 1.1, 1.2, 1.3, 1.4, 1.5, 1.6, 1.7, 1.8, 1.9,
+			2.0,
+		},
+	}, []string{"relay"})
+	if err := prometheus.Register(relayGrace); err != nil {
+		return err
+	}
+
+	relayPostGraceBudget = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "vouch",
+		Subsystem: "relay",
+		Name:      "post_grace_budget_seconds",
+		Help:      "The time remaining to request a bid from the relay once the grace period is over.",
+		Buckets: []float64{
+			0.0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9,
+			1.0, 1.1, 1.2, 1.3, 1.4, 1.5, 1.6, 1.7, 1.8, 1.9,
+			2.0,
+		},
+	}, []string{"relay"})
+	if err := prometheus.Register(relayPostGraceBudget); err != nil {
+		return err
+	}
+
+	clockSkewSuspected = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Name:      "clock_skew_suspected",
+		Help:      "Set to 1 if relay bid timestamps suggest that the local clock is skewed.",
+	})
+	if err := prometheus.Register(clockSkewSuspected); err != nil {
+		return err
+	}
+
+	lateBidsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_auction_block",
+		Name:      "late_bids_total",
+		Help:      "The number of late bids that improved the result of an auction.",
+	})
+	if err := prometheus.Register(lateBidsCounter); err != nil {
+		return err
+	}
+
+	auctionRetriesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_auction_block",
+		Name:      "retries_total",
+		Help:      "The number of auctions retried after every relay failed, by result.",
+	}, []string{"result"})
+	if err := prometheus.Register(auctionRetriesCounter); err != nil {
+		return err
+	}
+	auctionRetriesCounter.WithLabelValues("succeeded").Add(0)
+	auctionRetriesCounter.WithLabelValues("failed").Add(0)
+
+	auctionAbandonedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_auction_block",
+		Name:      "abandoned_total",
+		Help:      "The number of requests to a relay abandoned as the auction had been decided by the first acceptable bid.",
+	}, []string{"relay"})
+	if err := prometheus.Register(auctionAbandonedCounter); err != nil {
+		return err
+	}
+
+	auctionWinnerTimingCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_auction_block",
+		Name:      "winner_timing_total",
+		Help:      "The number of auctions by when their winning bid was decided relative to the soft timeout.",
+	}, []string{"timing"})
+	if err := prometheus.Register(auctionWinnerTimingCounter); err != nil {
+		return err
+	}
+
+	salvagedBidsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_auction_block",
+		Name:      "salvaged_bids_total",
+		Help:      "The number of bids salvaged by relaxing validations, by validation.",
+	}, []string{"validation"})
+	if err := prometheus.Register(salvagedBidsCounter); err != nil {
+		return err
+	}
+
+	blockCanonicalCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_block",
+		Name:      "canonical_total",
+		Help:      "The number of blocks proposed via a relay, by whether they became canonical.",
+	}, []string{"relay", "result"})
+	if err := prometheus.Register(blockCanonicalCounter); err != nil {
+		return err
+	}
+
+	winningBidGasUsed = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_auction_block",
+		Name:      "winning_bid_gas_used",
+		Help:      "The gas used by the execution payload of the most recent winning bid.",
+	})
+	if err := prometheus.Register(winningBidGasUsed); err != nil {
+		return err
+	}
+
+	winningBidGasLimit = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_auction_block",
+		Name:      "winning_bid_gas_limit",
+		Help:      "The gas limit of the execution payload of the most recent winning bid.",
+	})
+	if err := prometheus.Register(winningBidGasLimit); err != nil {
+		return err
+	}
+
+	winningBidGasRatio = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "vouch",
+		Subsystem: "relay_auction_block",
+		Name:      "winning_bid_gas_ratio",
+		Help:      "The ratio of gas used to gas limit of the execution payloads of winning bids.",
+		Buckets: []float64{
+			0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1.0,
+		},
+	})
+	if err := prometheus.Register(winningBidGasRatio); err != nil {
+		return err
+	}
+
 	validatorRegistrationsTimer = prometheus.NewHistogram(prometheus.HistogramOpts{
 		Namespace: "vouch",
 		Subsystem: "relay_validator_registrations",
@@ -198,6 +443,16 @@ func monitorAuctionBlock(provider string, succeeded bool, duration time.Duration
 	}
 }
 
+// monitorProposalPhase provides metrics for a phase of the proposal path.
+func monitorProposalPhase(phase blockrelay.ProposalPhase, duration time.Duration) {
+	if proposalPhaseTimer == nil {
+		// Not yet registered.
+		return
+	}
+
+	proposalPhaseTimer.WithLabelValues(string(phase)).Observe(duration.Seconds())
+}
+
 // monitorBuilderBid provides metrics for a builder bid operation.
 func monitorBuilderBid(duration time.Duration, succeeded bool) {
 	if builderBidTimer == nil {
@@ -228,6 +483,16 @@ func monitorExecutionConfig(duration time.Duration, succeeded bool) {
 	}
 }
 
+// monitorProposerConfig provides metrics for the resolution of a proposer configuration.
+func monitorProposerConfig(result string, cohort string) {
+	if proposerConfigCounter == nil {
+		// Not yet registered.
+		return
+	}
+
+	proposerConfigCounter.WithLabelValues(result, cohort).Inc()
+}
+
 // monitorValidatorRegistrations provides metrics for a validator registrations operation.
 func monitorValidatorRegistrations(succeeded bool, duration time.Duration) {
 	if validatorRegistrationsTimer == nil {
@@ -256,3 +521,145 @@ func monitorBuilderBidDelta(source string, delta *big.Int) {
 	}
 	builderBidDeltas.WithLabelValues(source).Observe(float64(delta.Uint64()) / 1e15)
 }
+
+// monitorClockSkewSuspected sets the clock skew suspected metric.
+func monitorClockSkewSuspected(suspected bool) {
+	if clockSkewSuspected == nil {
+		return
+	}
+	if suspected {
+		clockSkewSuspected.Set(1)
+	} else {
+		clockSkewSuspected.Set(0)
+	}
+}
+
+// monitorLateBid increments the late bids counter.
+func monitorLateBid() {
+	if lateBidsCounter == nil {
+		return
+	}
+	lateBidsCounter.Inc()
+}
+
+// monitorAuctionRetry increments the auction retries counter.
+func monitorAuctionRetry(succeeded bool) {
+	if auctionRetriesCounter == nil {
+		return
+	}
+	if succeeded {
+		auctionRetriesCounter.WithLabelValues("succeeded").Inc()
+	} else {
+		auctionRetriesCounter.WithLabelValues("failed").Inc()
+	}
+}
+
+// monitorAuctionAbandoned increments the abandoned requests counter for a relay.
+func monitorAuctionAbandoned(relay string) {
+	if auctionAbandonedCounter == nil {
+		return
+	}
+	auctionAbandonedCounter.WithLabelValues(relay).Inc()
+}
+
+// monitorAuctionWinnerTiming increments the auction winner timing counter.
+func monitorAuctionWinnerTiming(timing string) {
+	if auctionWinnerTimingCounter == nil {
+		return
+	}
+	auctionWinnerTimingCounter.WithLabelValues(timing).Inc()
+}
+
+// monitorSalvagedBid increments the salvaged bids counter for a validation.
+func monitorSalvagedBid(validation string) {
+	if salvagedBidsCounter == nil {
+		return
+	}
+	salvagedBidsCounter.WithLabelValues(validation).Inc()
+}
+
+// monitorBlockCanonical increments the block canonical counter for a relay.
+func monitorBlockCanonical(relay string, result string) {
+	if blockCanonicalCounter == nil {
+		return
+	}
+	blockCanonicalCounter.WithLabelValues(relay, result).Inc()
+}
+
+// monitorWinningBidGas records the gas used and gas limit of a winning bid.
+func monitorWinningBidGas(gasUsed uint64, gasLimit uint64) {
+	if winningBidGasUsed == nil {
+		return
+	}
+	winningBidGasUsed.Set(float64(gasUsed))
+	winningBidGasLimit.Set(float64(gasLimit))
+	if gasLimit > 0 {
+		winningBidGasRatio.Observe(float64(gasUsed) / float64(gasLimit))
+	}
+}
+
+// monitorBelowMinValue increments the below minimum value counter for a relay.
+func monitorBelowMinValue(relay string) {
+	if belowMinValueCounter == nil {
+		return
+	}
+	belowMinValueCounter.WithLabelValues(relay).Inc()
+}
+
+// monitorVersionMismatch increments the version mismatch counter for a relay.
+func monitorVersionMismatch(relay string) {
+	if versionMismatchCounter == nil {
+		return
+	}
+	versionMismatchCounter.WithLabelValues(relay).Inc()
+}
+
+// monitorRelayUncompetitive sets the uncompetitive state for a relay.
+func monitorRelayUncompetitive(relay string, uncompetitive bool) {
+	if relayUncompetitive == nil {
+		return
+	}
+	if uncompetitive {
+		relayUncompetitive.WithLabelValues(relay).Set(1)
+	} else {
+		relayUncompetitive.WithLabelValues(relay).Set(0)
+	}
+}
+
+// monitorRelayErrorRate sets the error rate for a relay.
+func monitorRelayErrorRate(relay string, rate float64) {
+	if relayErrorRate == nil {
+		return
+	}
+	relayErrorRate.WithLabelValues(relay).Set(rate)
+}
+
+// monitorRelayEqualBidRate sets the equal bid rate for a pair of relays.
+func monitorRelayEqualBidRate(relay string, otherRelay string, rate float64) {
+	if relayEqualBidRate == nil {
+		return
+	}
+	relayEqualBidRate.WithLabelValues(relay, otherRelay).Set(rate)
+}
+
+// monitorRelayJustBelowMinValue sets the just below minimum value state for a relay.
+func monitorRelayJustBelowMinValue(relay string, justBelow bool) {
+	if relayJustBelowMinValue == nil {
+		return
+	}
+	if justBelow {
+		relayJustBelowMinValue.WithLabelValues(relay).Set(1)
+	} else {
+		relayJustBelowMinValue.WithLabelValues(relay).Set(0)
+	}
+}
+
+// monitorRelayGrace records the grace period applied to a relay, and the time
+// remaining to obtain its bid once the grace period is over.
+func monitorRelayGrace(relay string, grace time.Duration, budget time.Duration) {
+	if relayGrace == nil || relayPostGraceBudget == nil {
+		return
+	}
+	relayGrace.WithLabelValues(relay).Observe(grace.Seconds())
+	relayPostGraceBudget.WithLabelValues(relay).Observe(budget.Seconds())
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"testing"
+
+	"github.com/attestantio/vouch/services/blockrelay"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoBidReason(t *testing.T) {
+	tests := []struct {
+		name          string
+		responded     int
+		belowMinValue int
+		reason        blockrelay.NoBidReason
+		str           string
+	}{
+		{
+			name:   "AllErrored",
+			reason: blockrelay.NoBidReasonAllErrored,
+			str:    "all errored",
+		},
+		{
+			name:      "NoBids",
+			responded: 2,
+			reason:    blockrelay.NoBidReasonNoBids,
+			str:       "no bids",
+		},
+		{
+			name:          "BelowMinValue",
+			responded:     2,
+			belowMinValue: 1,
+			reason:        blockrelay.NoBidReasonBelowMinValue,
+			str:           "below min value",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reason := noBidReason(test.responded, test.belowMinValue)
+			require.Equal(t, test.reason, reason)
+			require.Equal(t, test.str, reason.String())
+		})
+	}
+}
+
+func TestNoBidReasonString(t *testing.T) {
+	require.Equal(t, "no relays", blockrelay.NoBidReasonNoRelays.String())
+	require.Equal(t, "force local build", blockrelay.NoBidReasonForceLocalBuild.String())
+	require.Equal(t, "unknown", blockrelay.NoBidReasonUnknown.String())
+	require.Equal(t, "unknown", blockrelay.NoBidReason(-1).String())
+	require.Equal(t, "unknown", blockrelay.NoBidReason(100).String())
+}
@@ -21,6 +21,7 @@ import (
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/vouch/services/accountmanager"
+	"github.com/attestantio/vouch/services/blockrelay"
 	"github.com/attestantio/vouch/services/chaintime"
 	"github.com/attestantio/vouch/services/metrics"
 	"github.com/attestantio/vouch/services/scheduler"
@@ -51,6 +52,36 @@ type parameters struct {
 	specProvider                              consensusclient.SpecProvider
 	domainProvider                            consensusclient.DomainProvider
 	timeout                                   time.Duration
+	bidValidator                              blockrelay.BidValidator
+	maxMatchingProviders                      int
+	maxRelays                                 int
+	noBidHandler                              blockrelay.NoBidHandler
+	postSelectionValidator                    blockrelay.PostSelectionValidator
+	randomSource                              blockrelay.RandomSource
+	metricRelays                              []string
+	auditSink                                 blockrelay.AuditSink
+	auditLog                                  string
+	weightedSelectionMargin                   float64
+	lateBidWindow                             time.Duration
+	rejectEmptyBlocks                         bool
+	recordBelowMinValueBids                   bool
+	requireRelays                             bool
+	zeroValueAsNoBid                          bool
+	preferTargetGasLimit                      bool
+	firstAcceptableBid                        bool
+	auctionHistorySize                        int
+	auctionRetries                            int
+	auctionRetryBudget                        time.Duration
+	builderClientIdleTimeout                  time.Duration
+	uncompetitiveWindow                       int
+	errorRateWindow                           int
+	errorRateThreshold                        float64
+	equalBidWindow                            int
+	equalBidThreshold                         float64
+	baseFeeFloorGas                           uint64
+	justBelowMinValueMargin                   float64
+	justBelowMinValueWindow                   int
+	salvageValidations                        []string
 }
 
 // Parameter is the interface for service parameters.
@@ -204,10 +235,261 @@ func WithDomainProvider(provider consensusclient.DomainProvider) Parameter {
 	})
 }
 
+// WithBidValidator sets a custom validator for bids.
+func WithBidValidator(validator blockrelay.BidValidator) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bidValidator = validator
+	})
+}
+
+// WithMaxMatchingProviders sets the maximum number of relays retained for a winning bid
+// when multiple relays provide the same bid.
+func WithMaxMatchingProviders(maxMatchingProviders int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxMatchingProviders = maxMatchingProviders
+	})
+}
+
+// WithMaxRelays sets the maximum number of relays used for a proposer.  Relays
+// beyond this in a proposer configuration are ignored.  This is a guard against
+// misconfiguration; 0 removes the limit.
+func WithMaxRelays(maxRelays int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxRelays = maxRelays
+	})
+}
+
+// WithNoBidHandler sets a handler to be called when an auction selects no bid.
+func WithNoBidHandler(handler blockrelay.NoBidHandler) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.noBidHandler = handler
+	})
+}
+
+// WithPostSelectionValidator sets a validator to be called on the result of an
+// auction before it is used.
+func WithPostSelectionValidator(validator blockrelay.PostSelectionValidator) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.postSelectionValidator = validator
+	})
+}
+
+// WithRandomSource sets the source of randomness for auctions.
+func WithRandomSource(source blockrelay.RandomSource) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.randomSource = source
+	})
+}
+
+// WithMetricRelays sets the relays that are labelled individually in metrics.
+// If set, all other relays are labelled "other".
+func WithMetricRelays(relays []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.metricRelays = relays
+	})
+}
+
+// WithAuditSink sets a sink to record audit information about proposals.
+func WithAuditSink(sink blockrelay.AuditSink) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.auditSink = sink
+	})
+}
+
+// WithAuditLog sets the path of a file to which audit information about proposals is appended.
+func WithAuditLog(path string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.auditLog = path
+	})
+}
+
+// WithWeightedSelectionMargin sets the margin, as a proportion of the best bid, within which
+// bids are selected at random weighted by value.  A margin of 0 disables weighted selection.
+func WithWeightedSelectionMargin(margin float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.weightedSelectionMargin = margin
+	})
+}
+
+// WithLateBidWindow sets the time after the soft timeout for which the auction
+// continues to accept better bids from relays that have yet to respond.
+func WithLateBidWindow(window time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.lateBidWindow = window
+	})
+}
+
+// WithRejectEmptyBlocks sets if bids for empty blocks are rejected.
+func WithRejectEmptyBlocks(reject bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.rejectEmptyBlocks = reject
+	})
+}
+
+// WithRecordBelowMinValueBids records the values of bids below the minimum value in the auction results.
+func WithRecordBelowMinValueBids(record bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.recordBelowMinValueBids = record
+	})
+}
+
+// WithZeroValueAsNoBid treats bids with a value of zero as if the relay had
+// returned no bid, rather than as an error.
+func WithZeroValueAsNoBid(noBid bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.zeroValueAsNoBid = noBid
+	})
+}
+
+// WithPreferTargetGasLimit prefers, among bids of equal value, a bid whose gas
+// limit matches the gas limit configured for the relay over one whose gas
+// limit does not.
+func WithPreferTargetGasLimit(prefer bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.preferTargetGasLimit = prefer
+	})
+}
+
+// WithFirstAcceptableBid ends auctions as soon as any bid passes validation
+// and clears the minimum value, abandoning the requests to the remaining
+// relays.  This trades the chance of a higher value bid for lower latency.
+func WithFirstAcceptableBid(firstAcceptable bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.firstAcceptableBid = firstAcceptable
+	})
+}
+
+// WithAuctionHistorySize sets the number of recent auction decisions to hold
+// in memory for inspection.  A value of 0 disables the auction history.
+func WithAuctionHistorySize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.auctionHistorySize = size
+	})
+}
+
+// WithAuctionRetries sets the number of times that an auction is retried if
+// every relay fails to provide a bid.
+func WithAuctionRetries(retries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.auctionRetries = retries
+	})
+}
+
+// WithAuctionRetryBudget sets the time from the start of the slot by which a
+// retried auction must complete.
+func WithAuctionRetryBudget(budget time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.auctionRetryBudget = budget
+	})
+}
+
+// WithRequireRelays requires that validating accounts have at least one relay configured at startup.
+func WithRequireRelays(require bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.requireRelays = require
+	})
+}
+
+// WithBuilderClientIdleTimeout sets the time for which a builder client can go
+// unused before it is discarded.  A value of 0 keeps clients indefinitely.
+func WithBuilderClientIdleTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.builderClientIdleTimeout = timeout
+	})
+}
+
+// WithUncompetitiveWindow sets the number of auctions over which a relay's bids must
+// exceed its uncompetitive delta for it to be considered uncompetitive.
+func WithUncompetitiveWindow(window int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.uncompetitiveWindow = window
+	})
+}
+
+// WithErrorRateWindow sets the number of requests to a relay over which its
+// error rate is calculated.
+func WithErrorRateWindow(window int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.errorRateWindow = window
+	})
+}
+
+// WithErrorRateThreshold sets the proportion of requests to a relay that must
+// error over the error rate window for the relay to be flagged.
+func WithErrorRateThreshold(threshold float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.errorRateThreshold = threshold
+	})
+}
+
+// WithEqualBidWindow sets the number of auctions over which the rate of equal
+// bids from a pair of relays is calculated.
+func WithEqualBidWindow(window int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.equalBidWindow = window
+	})
+}
+
+// WithEqualBidThreshold sets the proportion of auctions in which a pair of relays
+// must return equal bids over the equal bid window for the pair to be flagged.
+func WithEqualBidThreshold(threshold float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.equalBidThreshold = threshold
+	})
+}
+
+// WithBaseFeeFloorGas sets the amount of gas which, multiplied by the base fee
+// per gas of a bid, provides a minimum value for the bid.  A value of 0 means no
+// base fee floor is applied.
+func WithBaseFeeFloorGas(gas uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.baseFeeFloorGas = gas
+	})
+}
+
+// WithJustBelowMinValueMargin sets the proportion of a relay's minimum value within
+// which bids below the minimum value are considered to be just below it.  A value
+// of 0 disables tracking of bids just below the minimum value.
+func WithJustBelowMinValueMargin(margin float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.justBelowMinValueMargin = margin
+	})
+}
+
+// WithJustBelowMinValueWindow sets the number of bids from a relay that must be just
+// below its minimum value for it to be flagged.
+func WithJustBelowMinValueWindow(window int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.justBelowMinValueWindow = window
+	})
+}
+
+// WithSalvageValidations sets the validations that can be relaxed to salvage a
+// bid if an auction would otherwise have no valid bids.  By default no
+// validations are relaxed.
+func WithSalvageValidations(validations []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.salvageValidations = validations
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
+		logLevel:                zerolog.GlobalLevel(),
+		bidValidator:            &nullBidValidator{},
+		maxMatchingProviders:    3,
+		maxRelays:               32,
+		noBidHandler:            &nullNoBidHandler{},
+		postSelectionValidator:  &nullPostSelectionValidator{},
+		randomSource:            &slotRandomSource{},
+		uncompetitiveWindow:     10,
+		errorRateWindow:         20,
+		errorRateThreshold:      0.5,
+		equalBidWindow:          20,
+		equalBidThreshold:       0.9,
+		justBelowMinValueWindow: 10,
+		auctionRetryBudget:      2 * time.Second,
 	}
 	for _, p := range params {
 		p.apply(&parameters)
@@ -256,6 +538,77 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.domainProvider == nil {
 		return nil, errors.New("no domain provider specified")
 	}
+	if parameters.bidValidator == nil {
+		return nil, errors.New("no bid validator specified")
+	}
+	if parameters.noBidHandler == nil {
+		return nil, errors.New("no no bid handler specified")
+	}
+	if parameters.postSelectionValidator == nil {
+		return nil, errors.New("no post-selection validator specified")
+	}
+	if parameters.randomSource == nil {
+		return nil, errors.New("no random source specified")
+	}
+	if parameters.auditSink != nil && parameters.auditLog != "" {
+		return nil, errors.New("cannot specify both audit sink and audit log")
+	}
+	if parameters.weightedSelectionMargin < 0 || parameters.weightedSelectionMargin >= 1 {
+		return nil, errors.New("weighted selection margin must be at least 0 and less than 1")
+	}
+	if parameters.lateBidWindow < 0 {
+		return nil, errors.New("late bid window cannot be negative")
+	}
+	if parameters.lateBidWindow >= parameters.timeout/2 {
+		return nil, errors.New("late bid window must be less than half of the timeout")
+	}
+	if parameters.firstAcceptableBid && parameters.lateBidWindow > 0 {
+		return nil, errors.New("late bid window cannot be used with first acceptable bid")
+	}
+	if parameters.auctionRetries < 0 {
+		return nil, errors.New("auction retries cannot be negative")
+	}
+	if parameters.auctionRetries > 0 && parameters.auctionRetryBudget < parameters.timeout {
+		return nil, errors.New("auction retry budget must be at least the timeout")
+	}
+	if parameters.builderClientIdleTimeout < 0 {
+		return nil, errors.New("builder client idle timeout cannot be negative")
+	}
+	if parameters.uncompetitiveWindow < 1 {
+		return nil, errors.New("uncompetitive window must be at least 1")
+	}
+	if parameters.errorRateWindow < 1 {
+		return nil, errors.New("error rate window must be at least 1")
+	}
+	if parameters.errorRateThreshold <= 0 || parameters.errorRateThreshold > 1 {
+		return nil, errors.New("error rate threshold must be greater than 0 and at most 1")
+	}
+	if parameters.equalBidWindow < 1 {
+		return nil, errors.New("equal bid window must be at least 1")
+	}
+	if parameters.equalBidThreshold <= 0 || parameters.equalBidThreshold > 1 {
+		return nil, errors.New("equal bid threshold must be greater than 0 and at most 1")
+	}
+	if parameters.justBelowMinValueMargin < 0 || parameters.justBelowMinValueMargin >= 1 {
+		return nil, errors.New("just below min value margin must be at least 0 and less than 1")
+	}
+	if parameters.justBelowMinValueWindow < 1 {
+		return nil, errors.New("just below min value window must be at least 1")
+	}
+	for _, validation := range parameters.salvageValidations {
+		if !salvageValidations[validation] {
+			return nil, errors.Errorf("unknown salvage validation %s", validation)
+		}
+	}
+	if parameters.auctionHistorySize < 0 {
+		return nil, errors.New("auction history size cannot be negative")
+	}
+	if parameters.maxMatchingProviders < 1 {
+		return nil, errors.New("max matching providers must be at least 1")
+	}
+	if parameters.maxRelays < 0 {
+		return nil, errors.New("max relays cannot be negative")
+	}
 
 	return &parameters, nil
 }
@@ -0,0 +1,75 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	v1 "github.com/attestantio/vouch/services/blockrelay/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// rejectingPostSelectionValidator rejects all results.
+type rejectingPostSelectionValidator struct {
+	calls int
+}
+
+func (v *rejectingPostSelectionValidator) PostSelection(_ context.Context, _ *blockauctioneer.Results) error {
+	v.calls++
+	return errors.New("rejected")
+}
+
+func TestPostSelection(t *testing.T) {
+	ctx := context.Background()
+	bidding := newTestBidRelay(t)
+
+	s, pubkey := testProposerAuctionService(t)
+	s.setExecutionConfig(&v1.ExecutionConfig{
+		DefaultConfig: &v1.ProposerConfig{
+			FeeRecipient: bellatrix.ExecutionAddress{0x02},
+			Builder: &v1.BuilderConfig{
+				Enabled: true,
+				Relays:  []string{bidding.URL},
+			},
+		},
+	})
+
+	parentHash := testParentHash()
+
+	// Accepted result is returned.
+	s.builderBidsCache = make(map[string]map[string]*builderspec.VersionedSignedBuilderBid)
+	res, err := s.AuctionBlock(ctx, 0, parentHash, pubkey)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.NotNil(t, res.Bid)
+	require.Len(t, s.builderBidsCache, 1)
+
+	// Rejected result is discarded.
+	validator := &rejectingPostSelectionValidator{}
+	noBidHandler := &recordingNoBidHandler{}
+	s.postSelectionValidator = validator
+	s.noBidHandler = noBidHandler
+	s.builderBidsCache = make(map[string]map[string]*builderspec.VersionedSignedBuilderBid)
+	res, err = s.AuctionBlock(ctx, 0, parentHash, pubkey)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, 1, validator.calls)
+	require.Equal(t, 1, noBidHandler.calls)
+	require.Empty(t, s.builderBidsCache)
+}
@@ -0,0 +1,86 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
+	"github.com/pkg/errors"
+)
+
+// precomputedProposerConfig is a proposer configuration resolved ahead of an auction.
+type precomputedProposerConfig struct {
+	// executionConfig is the execution configuration from which the proposer
+	// configuration was resolved.
+	executionConfig *blockrelay.ExecutionConfigurator
+	proposerConfig  *beaconblockproposer.ProposerConfig
+}
+
+// PrecomputeProposerConfig resolves the proposer configuration for the validator
+// with the given public key, and holds it for its next auction.
+func (s *Service) PrecomputeProposerConfig(ctx context.Context, pubkey phase0.BLSPubKey) error {
+	executionConfig := s.executionConfig.Load()
+	proposerConfig, err := s.ResolveProposerConfig(ctx, pubkey)
+	if err != nil {
+		return errors.Wrap(err, "failed to precompute proposer configuration")
+	}
+
+	s.precomputedProposerConfigsMu.Lock()
+	if s.precomputedProposerConfigs == nil {
+		s.precomputedProposerConfigs = make(map[phase0.BLSPubKey]*precomputedProposerConfig)
+	}
+	s.precomputedProposerConfigs[pubkey] = &precomputedProposerConfig{
+		executionConfig: executionConfig,
+		proposerConfig:  proposerConfig,
+	}
+	s.precomputedProposerConfigsMu.Unlock()
+
+	return nil
+}
+
+// auctionProposerConfig returns the proposer configuration for an auction, using
+// the precomputed configuration if available and resolving it otherwise.
+func (s *Service) auctionProposerConfig(ctx context.Context,
+	pubkey phase0.BLSPubKey,
+) (
+	*beaconblockproposer.ProposerConfig,
+	error,
+) {
+	s.precomputedProposerConfigsMu.Lock()
+	precomputed, exists := s.precomputedProposerConfigs[pubkey]
+	if exists {
+		// Precomputed configuration is only used for a single auction.
+		delete(s.precomputedProposerConfigs, pubkey)
+	}
+	s.precomputedProposerConfigsMu.Unlock()
+
+	// Only use the precomputed configuration if the execution configuration has
+	// not been refreshed since it was resolved.
+	if exists && precomputed.executionConfig == s.executionConfig.Load() {
+		log.Trace().Msg("Using precomputed proposer configuration")
+		return precomputed.proposerConfig, nil
+	}
+
+	return s.ResolveProposerConfig(ctx, pubkey)
+}
+
+// clearPrecomputedProposerConfigs removes all precomputed proposer configurations.
+func (s *Service) clearPrecomputedProposerConfigs() {
+	s.precomputedProposerConfigsMu.Lock()
+	s.precomputedProposerConfigs = make(map[phase0.BLSPubKey]*precomputedProposerConfig)
+	s.precomputedProposerConfigsMu.Unlock()
+}
@@ -0,0 +1,71 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v2 "github.com/attestantio/vouch/services/blockrelay/v2"
+	"github.com/stretchr/testify/require"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+func TestPrecomputeProposerConfig(t *testing.T) {
+	ctx := context.Background()
+
+	s := testAuctionService(t)
+	account, pubkey := testAccount(t)
+	withAccount := &testAccountsProvider{
+		accounts: map[phase0.BLSPubKey]e2wtypes.Account{
+			pubkey: account,
+		},
+	}
+	withoutAccount := &testAccountsProvider{
+		accounts: map[phase0.BLSPubKey]e2wtypes.Account{},
+	}
+	s.fallbackFeeRecipient = bellatrix.ExecutionAddress{0x01}
+	s.fallbackGasLimit = 30000000
+	s.noBidHandler = &recordingNoBidHandler{}
+	s.setExecutionConfig(&v2.ExecutionConfig{Version: 2})
+
+	// Precomputing populates the cache ahead of the proposal.
+	s.accountsProvider = withAccount
+	require.NoError(t, s.PrecomputeProposerConfig(ctx, pubkey))
+	require.Len(t, s.precomputedProposerConfigs, 1)
+
+	// The auction uses the precomputed configuration, so does not need the account.
+	s.accountsProvider = withoutAccount
+	_, err := s.AuctionBlock(ctx, 0, phase0.Hash32{}, pubkey)
+	require.NoError(t, err)
+	require.Empty(t, s.precomputedProposerConfigs)
+
+	// The precomputed configuration is only used once.
+	_, err = s.AuctionBlock(ctx, 0, phase0.Hash32{}, pubkey)
+	require.EqualError(t, err, "no account found for public key")
+
+	// Refreshing the execution configuration invalidates precomputed configurations.
+	s.accountsProvider = withAccount
+	require.NoError(t, s.PrecomputeProposerConfig(ctx, pubkey))
+	s.setExecutionConfig(&v2.ExecutionConfig{Version: 2})
+	require.Empty(t, s.precomputedProposerConfigs)
+	s.accountsProvider = withoutAccount
+	_, err = s.AuctionBlock(ctx, 0, phase0.Hash32{}, pubkey)
+	require.EqualError(t, err, "no account found for public key")
+
+	// Failure to resolve is reported.
+	require.EqualError(t, s.PrecomputeProposerConfig(ctx, pubkey), "failed to precompute proposer configuration: no account found for public key")
+}
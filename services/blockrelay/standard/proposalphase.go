@@ -0,0 +1,46 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/vouch/services/blockrelay"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordProposalPhase records the latency of a phase of the proposal path, as
+// both a span event and a metric.
+func (*Service) recordProposalPhase(ctx context.Context,
+	span trace.Span,
+	phase blockrelay.ProposalPhase,
+	duration time.Duration,
+	attrs ...attribute.KeyValue,
+) {
+	blockrelay.AddProposalPhaseEvent(span, phase, duration, attrs...)
+	if !isDiagnostic(ctx) {
+		monitorProposalPhase(phase, duration)
+	}
+}
+
+// RecordProposalPhase records the latency of a phase of the proposal path
+// that takes place outside of the auction.
+func (*Service) RecordProposalPhase(_ context.Context,
+	phase blockrelay.ProposalPhase,
+	duration time.Duration,
+) {
+	monitorProposalPhase(phase, duration)
+}
@@ -0,0 +1,104 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/vouch/services/blockrelay"
+	v2 "github.com/attestantio/vouch/services/blockrelay/v2"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// proposalPhaseEvents returns the attributes of the proposal phase events of
+// the named spans, keyed by phase.
+func proposalPhaseEvents(spans []sdktrace.ReadOnlySpan, name string) map[string]map[attribute.Key]attribute.Value {
+	events := make(map[string]map[attribute.Key]attribute.Value)
+	for _, span := range spans {
+		if span.Name() != name {
+			continue
+		}
+		for _, event := range span.Events() {
+			if event.Name != blockrelay.ProposalPhaseEvent {
+				continue
+			}
+			attrs := make(map[attribute.Key]attribute.Value)
+			for _, attr := range event.Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+			events[attrs["phase"].AsString()] = attrs
+		}
+	}
+
+	return events
+}
+
+func TestAuctionBlockProposalPhases(t *testing.T) {
+	ctx := context.Background()
+	setTestTimeout(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previousProvider)
+
+	parentHash := testParentHash()
+
+	relay := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(testBidJSON)
+	}))
+	defer relay.Close()
+
+	s, pubkey := testProposerAuctionService(t)
+	s.timeout = 10 * time.Second
+	s.setExecutionConfig(&v2.ExecutionConfig{
+		Relays: map[string]*v2.BaseRelayConfig{
+			relay.URL: {},
+		},
+	})
+
+	res, err := s.AuctionBlock(ctx, 0, parentHash, pubkey)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	spans := recorder.Ended()
+
+	// The relay request and validation are recorded against the bid request.
+	bidEvents := proposalPhaseEvents(spans, "builderBid")
+	require.Contains(t, bidEvents, string(blockrelay.ProposalPhaseRelayRequest))
+	require.Contains(t, bidEvents, string(blockrelay.ProposalPhaseValidation))
+	for _, attrs := range bidEvents {
+		require.Equal(t, relay.URL, strings.TrimSuffix(attrs["relay"].AsString(), "/"))
+		require.GreaterOrEqual(t, attrs["duration_ms"].AsInt64(), int64(0))
+	}
+	require.GreaterOrEqual(t, bidEvents[string(blockrelay.ProposalPhaseRelayRequest)]["duration_ms"].AsInt64(), int64(20))
+
+	// The auction as a whole is recorded against the auction.
+	auctionEvents := proposalPhaseEvents(spans, "AuctionBlock")
+	require.Len(t, auctionEvents, 1)
+	require.Contains(t, auctionEvents, string(blockrelay.ProposalPhaseAuction))
+	require.GreaterOrEqual(t, auctionEvents[string(blockrelay.ProposalPhaseAuction)]["duration_ms"].AsInt64(),
+		bidEvents[string(blockrelay.ProposalPhaseRelayRequest)]["duration_ms"].AsInt64())
+}
@@ -15,9 +15,13 @@ package standard
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/services/blockrelay"
+	"github.com/attestantio/vouch/util"
+	"github.com/pkg/errors"
 	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
 )
 
@@ -29,14 +33,153 @@ func (s *Service) ProposerConfig(ctx context.Context,
 	*beaconblockproposer.ProposerConfig,
 	error,
 ) {
-	s.executionConfigMu.RLock()
-	defer s.executionConfigMu.RUnlock()
-	if s.executionConfig == nil {
+	executionConfig := s.currentExecutionConfig()
+	if executionConfig == nil {
 		log.Warn().Msg("No execution configuration available; using fallback information")
 		return &beaconblockproposer.ProposerConfig{
-			FeeRecipient: s.fallbackFeeRecipient,
-			Relays:       make([]*beaconblockproposer.RelayConfig, 0),
+			FeeRecipient:       s.fallbackFeeRecipient,
+			FeeRecipientSource: beaconblockproposer.FeeRecipientSourceFallback,
+			Relays:             make([]*beaconblockproposer.RelayConfig, 0),
 		}, nil
 	}
-	return s.executionConfig.ProposerConfig(ctx, account, pubkey, s.fallbackFeeRecipient, s.fallbackGasLimit)
+	proposerConfig, err := executionConfig.ProposerConfig(ctx, account, pubkey, s.fallbackFeeRecipient, s.fallbackGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	proposerConfig.Relays = s.dedupeRelays(ctx, pubkey, proposerConfig.Relays)
+	proposerConfig.Relays = s.limitRelays(proposerConfig.Relays)
+
+	return proposerConfig, nil
+}
+
+// limitRelays truncates the relays to the maximum number of relays, so that a
+// misconfigured list of relays cannot result in connections to all of them.
+// Relays should be deduplicated beforehand, so that duplicates do not count
+// towards the limit.
+func (s *Service) limitRelays(relays []*beaconblockproposer.RelayConfig) []*beaconblockproposer.RelayConfig {
+	if s.maxRelays == 0 || len(relays) <= s.maxRelays {
+		return relays
+	}
+
+	return relays[:s.maxRelays]
+}
+
+// checkMaxRelays checks the number of relays used by the given accounts,
+// warning about any that have more than the maximum.  This is carried out when
+// the execution configuration is obtained, so that the warning is not given
+// on the proposal path.
+func (s *Service) checkMaxRelays(ctx context.Context,
+	accounts map[phase0.ValidatorIndex]e2wtypes.Account,
+	executionConfig blockrelay.ExecutionConfigurator,
+) {
+	if s.maxRelays == 0 || executionConfig == nil {
+		return
+	}
+
+	for _, account := range accounts {
+		var pubkey phase0.BLSPubKey
+		if provider, isProvider := account.(e2wtypes.AccountCompositePublicKeyProvider); isProvider {
+			copy(pubkey[:], provider.CompositePublicKey().Marshal())
+		} else {
+			copy(pubkey[:], account.PublicKey().Marshal())
+		}
+		proposerConfig, err := executionConfig.ProposerConfig(ctx, account, pubkey, s.fallbackFeeRecipient, s.fallbackGasLimit)
+		if err != nil {
+			log.Debug().Str("pubkey", fmt.Sprintf("%#x", pubkey)).Err(err).Msg("Failed to obtain proposer configuration; not checking number of relays")
+			continue
+		}
+
+		// Duplicates are ignored on the proposal path, so do not count them here.
+		addresses := make([]string, 0, len(proposerConfig.Relays))
+		seen := make(map[string]struct{}, len(proposerConfig.Relays))
+		for _, relay := range proposerConfig.Relays {
+			address := s.relayAddress(ctx, relay)
+			if _, exists := seen[address]; exists {
+				continue
+			}
+			seen[address] = struct{}{}
+			addresses = append(addresses, relay.Address)
+		}
+		if len(addresses) <= s.maxRelays {
+			continue
+		}
+
+		log.Warn().
+			Str("pubkey", fmt.Sprintf("%#x", pubkey)).
+			Int("relays", len(addresses)).
+			Int("max_relays", s.maxRelays).
+			Strs("ignored", addresses[s.maxRelays:]).
+			Msg("Proposer configuration has more relays than the maximum; ignoring excess relays.  Check the execution configuration")
+	}
+}
+
+// dedupeRelays removes relays that duplicate an earlier relay in the list.
+// Relays are compared by the address used by their builder client, so
+// addresses that differ only in formatting are considered to be the same.
+func (s *Service) dedupeRelays(ctx context.Context,
+	pubkey phase0.BLSPubKey,
+	relays []*beaconblockproposer.RelayConfig,
+) []*beaconblockproposer.RelayConfig {
+	if len(relays) < 2 {
+		return relays
+	}
+
+	seen := make(map[string]*beaconblockproposer.RelayConfig, len(relays))
+	res := make([]*beaconblockproposer.RelayConfig, 0, len(relays))
+	for _, relay := range relays {
+		address := s.relayAddress(ctx, relay)
+		existing, exists := seen[address]
+		if !exists {
+			seen[address] = relay
+			res = append(res, relay)
+			continue
+		}
+		if !relayPubkeysMatch(existing.PublicKey, relay.PublicKey) {
+			log.Warn().Str("pubkey", fmt.Sprintf("%#x", pubkey)).Str("relay", address).Msg("Relay configured multiple times with different public keys; using the first")
+		} else {
+			log.Warn().Str("pubkey", fmt.Sprintf("%#x", pubkey)).Str("relay", address).Msg("Relay configured multiple times; ignoring duplicate")
+		}
+	}
+
+	return res
+}
+
+// relayAddress returns the address used by the builder client for the relay,
+// falling back to the configured address if the client is unavailable.
+func (s *Service) relayAddress(ctx context.Context, relay *beaconblockproposer.RelayConfig) string {
+	if builderClient, err := util.FetchBuilderClient(ctx, relay.Address, s.monitor, s.builderClientIdleTimeout); err == nil {
+		return builderClient.Address()
+	}
+
+	return relay.Address
+}
+
+// relayPubkeysMatch returns true if the two relay public keys are the same.
+func relayPubkeysMatch(a *phase0.BLSPubKey, b *phase0.BLSPubKey) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// ResolveProposerConfig returns the proposer configuration for the validator with the given
+// public key, resolved in the same way as for an auction.  The returned configuration contains
+// the fee recipient along with the relays, each of which holds its own gas limit.
+func (s *Service) ResolveProposerConfig(ctx context.Context,
+	pubkey phase0.BLSPubKey,
+) (
+	*beaconblockproposer.ProposerConfig,
+	error,
+) {
+	account, err := s.accountsProvider.AccountByPublicKey(ctx, pubkey)
+	if err != nil || account == nil {
+		return nil, errors.New("no account found for public key")
+	}
+	proposerConfig, err := s.ProposerConfig(ctx, account, pubkey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposer configuration")
+	}
+
+	return proposerConfig, nil
 }
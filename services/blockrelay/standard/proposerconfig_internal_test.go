@@ -0,0 +1,223 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	v1 "github.com/attestantio/vouch/services/blockrelay/v1"
+	v2 "github.com/attestantio/vouch/services/blockrelay/v2"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// testAccountsProvider provides accounts from a fixed set.
+type testAccountsProvider struct {
+	accounts map[phase0.BLSPubKey]e2wtypes.Account
+}
+
+func (p *testAccountsProvider) AccountByPublicKey(_ context.Context, pubkey phase0.BLSPubKey) (e2wtypes.Account, error) {
+	account, exists := p.accounts[pubkey]
+	if !exists {
+		return nil, errors.New("not found")
+	}
+	return account, nil
+}
+
+func TestResolveProposerConfig(t *testing.T) {
+	ctx := context.Background()
+
+	account, pubkey := testAccount(t)
+
+	feeRecipient := bellatrix.ExecutionAddress{0x02}
+	proposerFeeRecipient := bellatrix.ExecutionAddress{0x03}
+
+	tests := []struct {
+		name            string
+		executionConfig *v2.ExecutionConfig
+		pubkey          phase0.BLSPubKey
+		expected        string
+		err             string
+	}{
+		{
+			name:   "UnknownAccount",
+			pubkey: phase0.BLSPubKey{0x01},
+			err:    "no account found for public key",
+		},
+		{
+			name:     "Fallback",
+			pubkey:   pubkey,
+			expected: `{"fee_recipient":"0x0100000000000000000000000000000000000000","relays":[]}`,
+		},
+		{
+			name: "Default",
+			executionConfig: &v2.ExecutionConfig{
+				Version:      2,
+				FeeRecipient: &feeRecipient,
+				Relays: map[string]*v2.BaseRelayConfig{
+					"https://relay1.com/": {},
+				},
+			},
+			pubkey:   pubkey,
+			expected: `{"fee_recipient":"0x0200000000000000000000000000000000000000","relays":[{"address":"https://relay1.com/","fee_recipient":"0x0200000000000000000000000000000000000000","gas_limit":"30000000"}]}`,
+		},
+		{
+			name: "Proposer",
+			executionConfig: &v2.ExecutionConfig{
+				Version:      2,
+				FeeRecipient: &feeRecipient,
+				Relays: map[string]*v2.BaseRelayConfig{
+					"https://relay1.com/": {},
+				},
+				Proposers: []*v2.ProposerConfig{
+					{
+						Validator:    pubkey,
+						FeeRecipient: &proposerFeeRecipient,
+					},
+				},
+			},
+			pubkey:   pubkey,
+			expected: `{"fee_recipient":"0x0300000000000000000000000000000000000000","relays":[{"address":"https://relay1.com/","fee_recipient":"0x0300000000000000000000000000000000000000","gas_limit":"30000000"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				accountsProvider: &testAccountsProvider{
+					accounts: map[phase0.BLSPubKey]e2wtypes.Account{
+						pubkey: account,
+					},
+				},
+				fallbackFeeRecipient: bellatrix.ExecutionAddress{0x01},
+				fallbackGasLimit:     30000000,
+			}
+			if test.executionConfig != nil {
+				s.setExecutionConfig(test.executionConfig)
+			}
+			proposerConfig, err := s.ResolveProposerConfig(ctx, test.pubkey)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				data, err := json.Marshal(proposerConfig)
+				require.NoError(t, err)
+				require.Equal(t, test.expected, string(data))
+			}
+		})
+	}
+}
+
+func TestProposerConfigDuplicateRelays(t *testing.T) {
+	ctx := context.Background()
+
+	srv := newTestBidRelay(t)
+	capture := logger.NewModuleLogCapture(t, &log)
+
+	s := testAuctionService(t)
+	s.setExecutionConfig(&v1.ExecutionConfig{
+		DefaultConfig: &v1.ProposerConfig{
+			FeeRecipient: bellatrix.ExecutionAddress{0x02},
+			Builder: &v1.BuilderConfig{
+				Enabled: true,
+				// The same relay, with and without a trailing slash.
+				Relays: []string{srv.URL, fmt.Sprintf("%s/", srv.URL)},
+			},
+		},
+	})
+
+	proposerConfig, err := s.ProposerConfig(ctx, nil, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.Len(t, proposerConfig.Relays, 1)
+	capture.AssertHasEntry(t, "Relay configured multiple times; ignoring duplicate")
+
+	parentHash := testParentHash()
+	res := s.bestBuilderBid(ctx, 0, parentHash, phase0.BLSPubKey{}, proposerConfig, nil)
+	require.NotNil(t, res)
+	require.Equal(t, 1, srv.Requests())
+	require.Len(t, res.Providers, 1)
+	require.Len(t, res.Values, 1)
+}
+
+func TestProposerConfigMaxRelays(t *testing.T) {
+	ctx := context.Background()
+
+	relays := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		relays = append(relays, newTestBidRelay(t).URL)
+	}
+	// Duplicate the first relay, which should not count towards the limit.
+	configuredRelays := append([]string{relays[0], relays[0]}, relays[1:]...)
+
+	capture := logger.NewModuleLogCapture(t, &log)
+
+	account, _ := testAccount(t)
+	accounts := map[phase0.ValidatorIndex]e2wtypes.Account{
+		1: account,
+	}
+	executionConfig := &v1.ExecutionConfig{
+		DefaultConfig: &v1.ProposerConfig{
+			FeeRecipient: bellatrix.ExecutionAddress{0x02},
+			Builder: &v1.BuilderConfig{
+				Enabled: true,
+				Relays:  configuredRelays,
+			},
+		},
+	}
+	warnings := func() int {
+		count := 0
+		for _, entry := range capture.Entries() {
+			if entry["message"] == "Proposer configuration has more relays than the maximum; ignoring excess relays.  Check the execution configuration" {
+				count++
+			}
+		}
+		return count
+	}
+
+	s := testAuctionService(t)
+	s.maxRelays = 3
+	s.setExecutionConfig(executionConfig)
+
+	// The warning is given when the configuration is checked.
+	s.checkMaxRelays(ctx, accounts, executionConfig)
+	require.Equal(t, 1, warnings())
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"relays":     float64(5),
+		"max_relays": float64(3),
+	}))
+
+	// The warning is not given on the proposal path.
+	proposerConfig, err := s.ProposerConfig(ctx, nil, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.Len(t, proposerConfig.Relays, 3)
+	for i, relay := range proposerConfig.Relays {
+		require.Equal(t, relays[i], relay.Address)
+	}
+	require.Equal(t, 1, warnings())
+
+	// No limit.
+	s.maxRelays = 0
+	s.checkMaxRelays(ctx, accounts, executionConfig)
+	require.Equal(t, 1, warnings())
+	proposerConfig, err = s.ProposerConfig(ctx, nil, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.Len(t, proposerConfig.Relays, 5)
+}
@@ -0,0 +1,34 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// slotRandomSource is a random source seeded by the slot, so gives the same
+// results for the same slot.
+type slotRandomSource struct{}
+
+// Int returns a random value in the range [0, limit) for the given slot.
+func (*slotRandomSource) Int(slot phase0.Slot, limit *big.Int) *big.Int {
+	// Randomness is deliberately deterministic, being seeded by the slot.
+	//nolint:gosec
+	rng := rand.New(rand.NewSource(int64(slot)))
+
+	return new(big.Int).Rand(rng, limit)
+}
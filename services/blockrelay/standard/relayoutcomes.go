@@ -0,0 +1,78 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"math/big"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	"github.com/attestantio/vouch/services/blockrelay"
+)
+
+// relayOutcomes completes the outcomes of an auction for each relay queried.
+// Outcomes already set, for relays that did not provide a usable bid, are
+// retained; candidates are classified against the selected bid, and relays
+// that never settled are marked as abandoned or timed out.
+func relayOutcomes(queried []string,
+	outcomes map[string]blockrelay.RelayOutcome,
+	candidates []*builderBidResponse,
+	res *blockauctioneer.Results,
+	abandoned []string,
+) map[string]blockrelay.RelayOutcome {
+	selected := make(map[string]struct{}, len(res.Providers))
+	for _, provider := range res.Providers {
+		selected[provider.Address()] = struct{}{}
+	}
+
+	// Obtain the score of the selected bid, which may not be the best
+	// score if weighted selection is in use.
+	var selectedScore *big.Int
+	for _, candidate := range candidates {
+		if _, isSelected := selected[candidate.provider.Address()]; isSelected {
+			selectedScore = candidate.score
+			break
+		}
+	}
+
+	for _, candidate := range candidates {
+		address := candidate.provider.Address()
+		switch {
+		case selectedScore == nil:
+			outcomes[address] = blockrelay.RelayOutcomeLowerValue
+		case candidate.score.Cmp(selectedScore) > 0:
+			outcomes[address] = blockrelay.RelayOutcomeOutweighed
+		case candidate.score.Cmp(selectedScore) == 0:
+			outcomes[address] = blockrelay.RelayOutcomeEqualValue
+		default:
+			outcomes[address] = blockrelay.RelayOutcomeLowerValue
+		}
+	}
+
+	// Selected providers include those whose bid was salvaged, which are
+	// not candidates.
+	for address := range selected {
+		outcomes[address] = blockrelay.RelayOutcomeSelected
+	}
+
+	for _, relay := range abandoned {
+		outcomes[relay] = blockrelay.RelayOutcomeAbandoned
+	}
+	for _, relay := range queried {
+		if _, exists := outcomes[relay]; !exists {
+			outcomes[relay] = blockrelay.RelayOutcomeTimedOut
+		}
+	}
+
+	return outcomes
+}
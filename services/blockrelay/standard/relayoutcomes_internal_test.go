@@ -0,0 +1,217 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/blockrelay"
+	v2 "github.com/attestantio/vouch/services/blockrelay/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayOutcomes(t *testing.T) {
+	candidate := func(address string, score int64) *builderBidResponse {
+		return &builderBidResponse{
+			provider: &mock.BuilderClient{MockAddress: address},
+			score:    big.NewInt(score),
+		}
+	}
+	results := func(providers ...string) *blockauctioneer.Results {
+		res := &blockauctioneer.Results{
+			Providers: make([]builderclient.BuilderBidProvider, 0, len(providers)),
+		}
+		for _, provider := range providers {
+			res.Providers = append(res.Providers, &mock.BuilderClient{MockAddress: provider})
+		}
+		return res
+	}
+
+	tests := []struct {
+		name       string
+		queried    []string
+		outcomes   map[string]blockrelay.RelayOutcome
+		candidates []*builderBidResponse
+		res        *blockauctioneer.Results
+		abandoned  []string
+		expected   map[string]blockrelay.RelayOutcome
+	}{
+		{
+			name:       "SelectedAndLowerValue",
+			queried:    []string{"a", "b"},
+			outcomes:   map[string]blockrelay.RelayOutcome{},
+			candidates: []*builderBidResponse{candidate("a", 10), candidate("b", 5)},
+			res:        results("a"),
+			expected: map[string]blockrelay.RelayOutcome{
+				"a": blockrelay.RelayOutcomeSelected,
+				"b": blockrelay.RelayOutcomeLowerValue,
+			},
+		},
+		{
+			name:       "EqualValue",
+			queried:    []string{"a", "b"},
+			outcomes:   map[string]blockrelay.RelayOutcome{},
+			candidates: []*builderBidResponse{candidate("a", 10), candidate("b", 10)},
+			res:        results("a"),
+			expected: map[string]blockrelay.RelayOutcome{
+				"a": blockrelay.RelayOutcomeSelected,
+				"b": blockrelay.RelayOutcomeEqualValue,
+			},
+		},
+		{
+			name:       "Outweighed",
+			queried:    []string{"a", "b"},
+			outcomes:   map[string]blockrelay.RelayOutcome{},
+			candidates: []*builderBidResponse{candidate("a", 10), candidate("b", 9)},
+			res:        results("b"),
+			expected: map[string]blockrelay.RelayOutcome{
+				"a": blockrelay.RelayOutcomeOutweighed,
+				"b": blockrelay.RelayOutcomeSelected,
+			},
+		},
+		{
+			name:    "Retained",
+			queried: []string{"a", "b", "c", "d"},
+			outcomes: map[string]blockrelay.RelayOutcome{
+				"b": blockrelay.RelayOutcomeBelowMinValue,
+				"c": blockrelay.RelayOutcomeNoBid,
+				"d": blockrelay.RelayOutcomeErrored,
+			},
+			candidates: []*builderBidResponse{candidate("a", 10)},
+			res:        results("a"),
+			expected: map[string]blockrelay.RelayOutcome{
+				"a": blockrelay.RelayOutcomeSelected,
+				"b": blockrelay.RelayOutcomeBelowMinValue,
+				"c": blockrelay.RelayOutcomeNoBid,
+				"d": blockrelay.RelayOutcomeErrored,
+			},
+		},
+		{
+			name:       "TimedOut",
+			queried:    []string{"a", "b"},
+			outcomes:   map[string]blockrelay.RelayOutcome{},
+			candidates: []*builderBidResponse{candidate("a", 10)},
+			res:        results("a"),
+			expected: map[string]blockrelay.RelayOutcome{
+				"a": blockrelay.RelayOutcomeSelected,
+				"b": blockrelay.RelayOutcomeTimedOut,
+			},
+		},
+		{
+			name:       "Abandoned",
+			queried:    []string{"a", "b"},
+			outcomes:   map[string]blockrelay.RelayOutcome{},
+			candidates: []*builderBidResponse{candidate("a", 10)},
+			res:        results("a"),
+			abandoned:  []string{"b"},
+			expected: map[string]blockrelay.RelayOutcome{
+				"a": blockrelay.RelayOutcomeSelected,
+				"b": blockrelay.RelayOutcomeAbandoned,
+			},
+		},
+		{
+			name:    "Salvaged",
+			queried: []string{"a", "b"},
+			outcomes: map[string]blockrelay.RelayOutcome{
+				"a": blockrelay.RelayOutcomeErrored,
+				"b": blockrelay.RelayOutcomeErrored,
+			},
+			candidates: []*builderBidResponse{},
+			res:        results("a"),
+			expected: map[string]blockrelay.RelayOutcome{
+				"a": blockrelay.RelayOutcomeSelected,
+				"b": blockrelay.RelayOutcomeErrored,
+			},
+		},
+		{
+			name:       "NoWinner",
+			queried:    []string{"a"},
+			outcomes:   map[string]blockrelay.RelayOutcome{"a": blockrelay.RelayOutcomeNoBid},
+			candidates: []*builderBidResponse{},
+			res:        results(),
+			expected: map[string]blockrelay.RelayOutcome{
+				"a": blockrelay.RelayOutcomeNoBid,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			outcomes := relayOutcomes(test.queried, test.outcomes, test.candidates, test.res, test.abandoned)
+			require.Equal(t, test.expected, outcomes)
+		})
+	}
+}
+
+func TestRelayOutcomeString(t *testing.T) {
+	require.Equal(t, "selected", blockrelay.RelayOutcomeSelected.String())
+	require.Equal(t, "below min value", blockrelay.RelayOutcomeBelowMinValue.String())
+	require.Equal(t, "unknown", blockrelay.RelayOutcome(-1).String())
+	require.Equal(t, "unknown", blockrelay.RelayOutcome(100).String())
+}
+
+func TestAuctionBlockRelayOutcomes(t *testing.T) {
+	ctx := context.Background()
+	setTestTimeout(t)
+
+	parentHash := testParentHash()
+
+	bidding := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(testBidJSON)
+	}))
+	defer bidding.Close()
+	noBid := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer noBid.Close()
+	erroring := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer erroring.Close()
+
+	s, pubkey := testProposerAuctionService(t)
+	s.auctionHistory = newAuctionHistory(1)
+	s.setExecutionConfig(&v2.ExecutionConfig{
+		Relays: map[string]*v2.BaseRelayConfig{
+			bidding.URL:  {},
+			noBid.URL:    {},
+			erroring.URL: {},
+		},
+	})
+
+	res, err := s.AuctionBlock(ctx, 0, parentHash, pubkey)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	records := s.AuctionHistory(ctx)
+	require.Len(t, records, 1)
+	outcomes := make(map[string]blockrelay.RelayOutcome)
+	for relay, outcome := range records[0].Outcomes {
+		outcomes[strings.TrimSuffix(relay, "/")] = outcome
+	}
+	require.Equal(t, map[string]blockrelay.RelayOutcome{
+		bidding.URL:  blockrelay.RelayOutcomeSelected,
+		noBid.URL:    blockrelay.RelayOutcomeNoBid,
+		erroring.URL: blockrelay.RelayOutcomeErrored,
+	}, outcomes)
+}
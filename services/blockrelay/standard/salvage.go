@@ -0,0 +1,84 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// salvageValidationEmptyBlock is the validation that a bid is not for an empty block.
+const salvageValidationEmptyBlock = "empty-block"
+
+// salvageValidations are the validations that can be relaxed to salvage a bid.
+// Only validations of operator policy are present; validations of the bid's
+// integrity, such as its signature and proposer, are never relaxed as a bid
+// that fails them is unlikely to be unblinded by the relay.
+var salvageValidations = map[string]bool{
+	salvageValidationEmptyBlock: true,
+}
+
+// salvageableBidError is an error for a bid that failed only validations that
+// can be relaxed, allowing the bid to be salvaged if no other bid is available.
+type salvageableBidError struct {
+	resp        *builderBidResponse
+	validations []string
+}
+
+// Error provides the error string.
+func (e *salvageableBidError) Error() string {
+	return fmt.Sprintf("%s: failed validations %s", e.resp.provider.Address(), strings.Join(e.validations, ","))
+}
+
+// salvageCandidate returns the salvageable bid from an error, if present.
+func salvageCandidate(err error) *salvageableBidError {
+	var salvageable *salvageableBidError
+	if errors.As(err, &salvageable) {
+		return salvageable
+	}
+
+	return nil
+}
+
+// salvageBid selects the best of the salvageable bids, for use when an auction
+// has no valid bids.
+func (s *Service) salvageBid(ctx context.Context,
+	log zerolog.Logger,
+	res *blockauctioneer.Results,
+	candidates []*salvageableBidError,
+) {
+	var best *salvageableBidError
+	for _, candidate := range candidates {
+		if best == nil || candidate.resp.score.Cmp(best.resp.score) > 0 {
+			best = candidate
+		}
+	}
+	if best == nil {
+		return
+	}
+
+	log.Warn().Str("provider", best.resp.provider.Address()).Stringer("value", best.resp.score).Strs("relaxed_validations", best.validations).Msg("No valid bids received; salvaging bid that failed relaxed validations")
+	s.processBidResponse(log, res, big.NewInt(0), best.resp)
+	if !isDiagnostic(ctx) {
+		for _, validation := range best.validations {
+			monitorSalvagedBid(validation)
+		}
+	}
+}
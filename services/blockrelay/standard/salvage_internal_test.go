@@ -0,0 +1,132 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	builderclient "github.com/attestantio/go-builder-client"
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/stretchr/testify/require"
+)
+
+// testWrongSignatureBid returns a copy of the test bid with a valid signature from the wrong key.
+func testWrongSignatureBid(t *testing.T) *builderspec.VersionedSignedBuilderBid {
+	t.Helper()
+	bid := testBid(t)
+	data, err := hex.DecodeString(strings.TrimPrefix("0xa73233d802d26e59489bc8d67b56465b0807e90a3a2e457c500a3f612e46d302d5b0a1c02dc2f51a11747ab0bb129ef416bee4e74b3710052f366ce2200cdc56fcf69ca68476874d4a2c8dc7afde78ff93e3e7f145e742d1abb800c3b11327b5", "0x"))
+	require.NoError(t, err)
+	copy(bid.Bellatrix.Signature[:], data)
+
+	return bid
+}
+
+func TestSalvageEmptyBlock(t *testing.T) {
+	ctx := context.Background()
+
+	bid := testBid(t)
+	bid.Bellatrix.Message.Header.TransactionsRoot = emptyTransactionsRoot
+	provider := &mock.BuilderClient{
+		MockAddress: "relay1",
+		MockBid:     bid,
+	}
+	relayConfig := &beaconblockproposer.RelayConfig{}
+
+	// Strict behavior rejects the bid outright.
+	s := testAuctionService(t)
+	s.rejectEmptyBlocks = true
+	resp, err := runBuilderBid(ctx, s, provider, relayConfig)
+	require.Nil(t, resp)
+	require.EqualError(t, err, "relay1: no transactions")
+	require.Nil(t, salvageCandidate(err))
+
+	// Relaxed behavior holds the bid for salvage.
+	s.salvageValidations = map[string]bool{salvageValidationEmptyBlock: true}
+	resp, err = runBuilderBid(ctx, s, provider, relayConfig)
+	require.Nil(t, resp)
+	require.Error(t, err)
+	candidate := salvageCandidate(err)
+	require.NotNil(t, candidate)
+	require.Equal(t, []string{salvageValidationEmptyBlock}, candidate.validations)
+	require.Equal(t, provider.MockBid, candidate.resp.bid)
+
+	// Salvaging selects the bid.
+	res := &blockauctioneer.Results{
+		Values:    make(map[string]*big.Int),
+		Providers: make([]builderclient.BuilderBidProvider, 0),
+	}
+	s.salvageBid(ctx, log, res, []*salvageableBidError{candidate})
+	require.Equal(t, provider.MockBid, res.Bid)
+	require.Len(t, res.Providers, 1)
+	require.Equal(t, "relay1", res.Providers[0].Address())
+	require.Equal(t, big.NewInt(52499999853000), res.Values["relay1"])
+}
+
+func TestSalvageNeverRelaxesSignature(t *testing.T) {
+	ctx := context.Background()
+
+	// The bid fails both a relaxed validation and its signature.
+	bid := testWrongSignatureBid(t)
+	bid.Bellatrix.Message.Header.TransactionsRoot = emptyTransactionsRoot
+	provider := &mock.BuilderClient{
+		MockAddress: "relay1",
+		MockPubkey:  pubkey("0x845bd072b7cd566f02faeb0a4033ce9399e42839ced64e8b2adcfc859ed1e8e1a5a293336a49feac6d9a5edb779be53a"),
+		MockBid:     bid,
+	}
+
+	s := testAuctionService(t)
+	s.applicationBuilderDomain = domain("0x00000001d3010778cd08ee514b08fe67b6c503b510987a4ce43f42306d97c67c")
+	s.rejectEmptyBlocks = true
+	s.salvageValidations = map[string]bool{salvageValidationEmptyBlock: true}
+	_, err := runBuilderBid(ctx, s, provider, &beaconblockproposer.RelayConfig{})
+	require.EqualError(t, err, "relay1: invalid signature")
+	require.Nil(t, salvageCandidate(err))
+}
+
+func TestSalvageBidHighestValue(t *testing.T) {
+	ctx := context.Background()
+
+	low := testBid(t)
+	high := testBid(t)
+	high.Bellatrix.Message.Header.GasUsed++
+
+	lowProvider := &mock.BuilderClient{MockAddress: "relay1"}
+	highProvider := &mock.BuilderClient{MockAddress: "relay2"}
+	candidates := []*salvageableBidError{
+		{
+			resp:        &builderBidResponse{provider: lowProvider, bid: low, score: big.NewInt(1)},
+			validations: []string{salvageValidationEmptyBlock},
+		},
+		{
+			resp:        &builderBidResponse{provider: highProvider, bid: high, score: big.NewInt(2)},
+			validations: []string{salvageValidationEmptyBlock},
+		},
+	}
+
+	s := testAuctionService(t)
+	res := &blockauctioneer.Results{
+		Values:    make(map[string]*big.Int),
+		Providers: make([]builderclient.BuilderBidProvider, 0),
+	}
+	s.salvageBid(ctx, log, res, candidates)
+	require.Equal(t, high, res.Bid)
+	require.Equal(t, "relay2", res.Providers[0].Address())
+}
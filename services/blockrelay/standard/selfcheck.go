@@ -0,0 +1,82 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// selfCheck checks the configuration of the service, logging a summary.
+// An error is returned if the configuration is not usable.
+func (s *Service) selfCheck(ctx context.Context, applicationBuilderDomainType phase0.DomainType) error {
+	domainErr := checkApplicationBuilderDomain(applicationBuilderDomainType, s.applicationBuilderDomain)
+
+	accounts := 0
+	relays := make(map[string]bool)
+	validatingAccounts, err := s.validatingAccountsProvider.ValidatingAccountsForEpoch(ctx, s.chainTime.CurrentEpoch())
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to obtain validating accounts; cannot check relays")
+	} else {
+		accounts = len(validatingAccounts)
+		executionConfig := s.currentExecutionConfig()
+		var pubkey phase0.BLSPubKey
+		for _, account := range validatingAccounts {
+			if provider, isProvider := account.(e2wtypes.AccountCompositePublicKeyProvider); isProvider {
+				copy(pubkey[:], provider.CompositePublicKey().Marshal())
+			} else {
+				copy(pubkey[:], account.PublicKey().Marshal())
+			}
+			config, err := executionConfig.ProposerConfig(ctx, account, pubkey, s.fallbackFeeRecipient, s.fallbackGasLimit)
+			if err != nil {
+				log.Warn().Str("account", account.Name()).Err(err).Msg("Failed to obtain proposer configuration for account")
+				continue
+			}
+			for _, relay := range config.Relays {
+				relays[relay.Address] = relays[relay.Address] || relay.PublicKey != nil
+			}
+		}
+	}
+	relaysWithPubkeys := 0
+	for _, hasPubkey := range relays {
+		if hasPubkey {
+			relaysWithPubkeys++
+		}
+	}
+
+	log.Info().
+		Int("validating_accounts", accounts).
+		Int("relays", len(relays)).
+		Int("relays_with_pubkeys", relaysWithPubkeys).
+		Dur("soft_timeout", s.timeout/2).
+		Dur("hard_timeout", s.timeout).
+		Bool("builder_domain_valid", domainErr == nil).
+		Msg("Block relay self check")
+
+	if domainErr != nil {
+		// Without a valid domain every bid signature would fail verification, so refuse to start.
+		return domainErr
+	}
+	if len(relays) > relaysWithPubkeys {
+		log.Warn().Int("relays", len(relays)-relaysWithPubkeys).Msg("Some relays do not have public keys configured; their bids cannot be verified")
+	}
+	if s.requireRelays && accounts > 0 && len(relays) == 0 {
+		return errors.New("relays are required but no relays are configured for validating accounts")
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	v2 "github.com/attestantio/vouch/services/blockrelay/v2"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfCheck(t *testing.T) {
+	ctx := context.Background()
+
+	account, _ := testAccount(t)
+
+	domainType := phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+	goodDomain := domain("0x00000001d3010778cd08ee514b08fe67b6c503b510987a4ce43f42306d97c67c")
+
+	tests := []struct {
+		name            string
+		domain          phase0.Domain
+		accounts        bool
+		executionConfig *v2.ExecutionConfig
+		requireRelays   bool
+		relays          int
+		relaysWithKeys  int
+		err             string
+	}{
+		{
+			name: "DomainZero",
+			err:  "application builder domain is zero",
+		},
+		{
+			name:          "NoAccounts",
+			domain:        goodDomain,
+			requireRelays: true,
+		},
+		{
+			name:     "NoRelays",
+			domain:   goodDomain,
+			accounts: true,
+		},
+		{
+			name:          "NoRelaysRequired",
+			domain:        goodDomain,
+			accounts:      true,
+			requireRelays: true,
+			err:           "relays are required but no relays are configured for validating accounts",
+		},
+		{
+			name:     "Relays",
+			domain:   goodDomain,
+			accounts: true,
+			executionConfig: &v2.ExecutionConfig{
+				Version: 2,
+				Relays: map[string]*v2.BaseRelayConfig{
+					"https://relay1.com/": {
+						PublicKey: pubkey("0xac6e77dfe25ecd6110b8e780608cce0dab71fdd5ebea22a16c0205200f2f8e2e3ad3b71d3499c54ad14d6c21b41a37ae"),
+					},
+					"https://relay2.com/": {},
+				},
+			},
+			requireRelays:  true,
+			relays:         2,
+			relaysWithKeys: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewModuleLogCapture(t, &log)
+
+			validatingAccountsProvider := mockaccountmanager.NewValidatingAccountsProvider()
+			if test.accounts {
+				validatingAccountsProvider.AddAccount(1, account)
+			}
+			s := testAuctionService(t)
+			s.applicationBuilderDomain = test.domain
+			s.validatingAccountsProvider = validatingAccountsProvider
+			s.fallbackFeeRecipient = bellatrix.ExecutionAddress{0x01}
+			s.fallbackGasLimit = 30000000
+			s.requireRelays = test.requireRelays
+			if test.executionConfig != nil {
+				s.setExecutionConfig(test.executionConfig)
+			} else {
+				s.setExecutionConfig(&v2.ExecutionConfig{Version: 2})
+			}
+
+			err := s.selfCheck(ctx, domainType)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.True(t, capture.HasLog(map[string]interface{}{
+				"message":             "Block relay self check",
+				"relays":              test.relays,
+				"relays_with_pubkeys": test.relaysWithKeys,
+			}))
+		})
+	}
+}
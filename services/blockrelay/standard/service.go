@@ -14,8 +14,10 @@
 package standard
 
 import (
+	"bytes"
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	restdaemon "github.com/attestantio/go-block-relay/services/daemon/rest"
@@ -59,12 +61,63 @@ type Service struct {
 	secondaryValidatorRegistrationsSubmitters []consensusclient.ValidatorRegistrationsSubmitter
 	logResults                                bool
 	applicationBuilderDomain                  phase0.Domain
+	bidValidator                              blockrelay.BidValidator
+	maxMatchingProviders                      int
+	noBidHandler                              blockrelay.NoBidHandler
+	postSelectionValidator                    blockrelay.PostSelectionValidator
+	randomSource                              blockrelay.RandomSource
+	metricRelays                              map[string]struct{}
+	auditSink                                 blockrelay.AuditSink
+	weightedSelectionMargin                   float64
+	lateBidWindow                             time.Duration
+	rejectEmptyBlocks                         bool
+	baseFeeFloorGas                           uint64
+	recordBelowMinValueBids                   bool
+	requireRelays                             bool
+	zeroValueAsNoBid                          bool
+	preferTargetGasLimit                      bool
+	firstAcceptableBid                        bool
+	auctionRetries                            int
+	auctionRetryBudget                        time.Duration
+	builderClientIdleTimeout                  time.Duration
 
-	executionConfig   blockrelay.ExecutionConfigurator
-	executionConfigMu sync.RWMutex
+	// executionConfig is replaced wholesale on refresh rather than updated
+	// in place, so readers never block waiting for a refresh to complete.
+	executionConfig atomic.Pointer[blockrelay.ExecutionConfigurator]
+
+	// lastExecutionConfigDiff is the most recent set of changes made by a
+	// refresh of the execution configuration.
+	lastExecutionConfigDiff atomic.Pointer[blockrelay.ExecutionConfigDiff]
+
+	precomputedProposerConfigs   map[phase0.BLSPubKey]*precomputedProposerConfig
+	precomputedProposerConfigsMu sync.Mutex
 
 	relayPubkeys   map[phase0.BLSPubKey]*e2types.BLSPublicKey
 	relayPubkeysMu sync.RWMutex
+
+	clockSkew *clockSkewDetector
+
+	uncompetitive *uncompetitiveDetector
+
+	errorRate *errorRateDetector
+
+	equalBids *equalBidDetector
+
+	justBelowMinValue *justBelowMinValueDetector
+
+	// proposedBlocks are blocks proposed via relays, awaiting feedback
+	// about the canonical chain.
+	proposedBlocks   map[phase0.Slot]*proposedBlock
+	proposedBlocksMu sync.Mutex
+
+	// salvageValidations are the validations that can be relaxed to salvage a bid.
+	salvageValidations map[string]bool
+
+	// maxRelays is the maximum number of relays used for a proposer.
+	maxRelays int
+
+	// auctionHistory holds recent auction decisions, if enabled.
+	auctionHistory *auctionHistory
 }
 
 // module-wide log.
@@ -104,6 +157,21 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain application builder domain")
 	}
+	var auditSink blockrelay.AuditSink = &nullAuditSink{}
+	switch {
+	case parameters.auditSink != nil:
+		auditSink = parameters.auditSink
+	case parameters.auditLog != "":
+		auditSink, err = newFileAuditSink(parameters.auditLog)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	metricRelays := make(map[string]struct{}, len(parameters.metricRelays))
+	for _, relay := range parameters.metricRelays {
+		metricRelays[relayHost(relay)] = struct{}{}
+	}
 
 	s := &Service{
 		monitor:                      parameters.monitor,
@@ -123,14 +191,57 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		secondaryValidatorRegistrationsSubmitters: parameters.secondaryValidatorRegistrationsSubmitters,
 		logResults:               parameters.logResults,
 		applicationBuilderDomain: domain,
+		bidValidator:             parameters.bidValidator,
+		maxMatchingProviders:     parameters.maxMatchingProviders,
+		maxRelays:                parameters.maxRelays,
+		noBidHandler:             parameters.noBidHandler,
+		postSelectionValidator:   parameters.postSelectionValidator,
+		randomSource:             parameters.randomSource,
+		metricRelays:             metricRelays,
+		auditSink:                auditSink,
+		weightedSelectionMargin:  parameters.weightedSelectionMargin,
+		lateBidWindow:            parameters.lateBidWindow,
+		rejectEmptyBlocks:        parameters.rejectEmptyBlocks,
+		baseFeeFloorGas:          parameters.baseFeeFloorGas,
+		recordBelowMinValueBids:  parameters.recordBelowMinValueBids,
+		requireRelays:            parameters.requireRelays,
+		zeroValueAsNoBid:         parameters.zeroValueAsNoBid,
+		preferTargetGasLimit:     parameters.preferTargetGasLimit,
+		firstAcceptableBid:       parameters.firstAcceptableBid,
+		auctionRetries:           parameters.auctionRetries,
+		auctionRetryBudget:       parameters.auctionRetryBudget,
+		builderClientIdleTimeout: parameters.builderClientIdleTimeout,
 		builderBidsCache:         make(map[string]map[string]*builderspec.VersionedSignedBuilderBid),
 		relayPubkeys:             make(map[phase0.BLSPubKey]*e2types.BLSPublicKey),
-		executionConfig:          &v2.ExecutionConfig{Version: 2},
+		proposedBlocks:           make(map[phase0.Slot]*proposedBlock),
+		clockSkew:                newClockSkewDetector(),
+		uncompetitive:            newUncompetitiveDetector(parameters.uncompetitiveWindow),
+		errorRate:                newErrorRateDetector(parameters.errorRateWindow, parameters.errorRateThreshold),
+		equalBids:                newEqualBidDetector(parameters.equalBidWindow, parameters.equalBidThreshold),
 	}
 
+	s.salvageValidations = make(map[string]bool, len(parameters.salvageValidations))
+	for _, validation := range parameters.salvageValidations {
+		s.salvageValidations[validation] = true
+	}
+
+	if parameters.auctionHistorySize > 0 {
+		s.auctionHistory = newAuctionHistory(parameters.auctionHistorySize)
+	}
+
+	if parameters.justBelowMinValueMargin > 0 {
+		s.justBelowMinValue = newJustBelowMinValueDetector(parameters.justBelowMinValueWindow, parameters.justBelowMinValueMargin)
+	}
+
+	s.setExecutionConfig(&v2.ExecutionConfig{Version: 2})
+
 	// Carry out initial fetch of execution configuration.
 	// Need to run this inline, as other modules need this information.
 	s.fetchExecutionConfig(ctx, nil)
+	// Check the configuration before going any further.
+	if err := s.selfCheck(ctx, applicationBuilderDomainType); err != nil {
+		return nil, err
+	}
 	// Carry out initial submission of validator registrations.
 	// Can run this in a separate goroutine to avoid blocking.
 	go func(ctx context.Context) {
@@ -176,3 +287,15 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 
 	return s, nil
 }
+
+// checkApplicationBuilderDomain checks that the application builder domain can be used to verify bid signatures.
+func checkApplicationBuilderDomain(domainType phase0.DomainType, domain phase0.Domain) error {
+	if bytes.Equal(domain[:], zeroDomain[:]) {
+		return errors.New("application builder domain is zero")
+	}
+	if !bytes.Equal(domain[:len(domainType)], domainType[:]) {
+		return errors.New("application builder domain does not match its domain type")
+	}
+
+	return nil
+}
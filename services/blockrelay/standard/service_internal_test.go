@@ -0,0 +1,58 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckApplicationBuilderDomain(t *testing.T) {
+	tests := []struct {
+		name       string
+		domainType phase0.DomainType
+		domain     phase0.Domain
+		err        string
+	}{
+		{
+			name:       "Zero",
+			domainType: phase0.DomainType{0x00, 0x00, 0x00, 0x01},
+			err:        "application builder domain is zero",
+		},
+		{
+			name:       "Mismatch",
+			domainType: phase0.DomainType{0x00, 0x00, 0x00, 0x01},
+			domain:     domain("0x00000002d3010778cd08ee514b08fe67b6c503b510987a4ce43f42306d97c67c"),
+			err:        "application builder domain does not match its domain type",
+		},
+		{
+			name:       "Good",
+			domainType: phase0.DomainType{0x00, 0x00, 0x00, 0x01},
+			domain:     domain("0x00000001d3010778cd08ee514b08fe67b6c503b510987a4ce43f42306d97c67c"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkApplicationBuilderDomain(test.domainType, test.domain)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
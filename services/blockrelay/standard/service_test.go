@@ -377,6 +377,329 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no domain provider specified",
 		},
+		{
+			name: "DomainProviderErrors",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(mock.NewErroringDomainProvider()),
+			},
+			err: "failed to obtain application builder domain: error",
+		},
+		{
+			name: "LateBidWindowNegative",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithLateBidWindow(-time.Millisecond),
+			},
+			err: "problem with parameters: late bid window cannot be negative",
+		},
+		{
+			name: "LateBidWindowTooLong",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithLateBidWindow(500 * time.Millisecond),
+			},
+			err: "problem with parameters: late bid window must be less than half of the timeout",
+		},
+		{
+			name: "FirstAcceptableBidWithLateBidWindow",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithLateBidWindow(100 * time.Millisecond),
+				standard.WithFirstAcceptableBid(true),
+			},
+			err: "problem with parameters: late bid window cannot be used with first acceptable bid",
+		},
+		{
+			name: "MaxRelaysNegative",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithMaxRelays(-1),
+			},
+			err: "problem with parameters: max relays cannot be negative",
+		},
+		{
+			name: "SalvageValidationSignature",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithSalvageValidations([]string{"signature"}),
+			},
+			err: "problem with parameters: unknown salvage validation signature",
+		},
+		{
+			name: "UncompetitiveWindowZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithUncompetitiveWindow(0),
+			},
+			err: "problem with parameters: uncompetitive window must be at least 1",
+		},
+		{
+			name: "ErrorRateWindowZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithErrorRateWindow(0),
+			},
+			err: "problem with parameters: error rate window must be at least 1",
+		},
+		{
+			name: "AuctionRetriesNegative",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithAuctionRetries(-1),
+			},
+			err: "problem with parameters: auction retries cannot be negative",
+		},
+		{
+			name: "AuctionRetryBudgetTooShort",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithAuctionRetries(1),
+				standard.WithAuctionRetryBudget(500 * time.Millisecond),
+			},
+			err: "problem with parameters: auction retry budget must be at least the timeout",
+		},
+		{
+			name: "ErrorRateThresholdZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithErrorRateThreshold(0),
+			},
+			err: "problem with parameters: error rate threshold must be greater than 0 and at most 1",
+		},
+		{
+			name: "ErrorRateThresholdTooHigh",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithErrorRateThreshold(1.5),
+			},
+			err: "problem with parameters: error rate threshold must be greater than 0 and at most 1",
+		},
+		{
+			name: "WeightedSelectionMarginNegative",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithWeightedSelectionMargin(-0.1),
+			},
+			err: "problem with parameters: weighted selection margin must be at least 0 and less than 1",
+		},
+		{
+			name: "WeightedSelectionMarginTooHigh",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(prometheusMetrics),
+				standard.WithTimeout(time.Second),
+				standard.WithMajordomo(majordomoSvc),
+				standard.WithScheduler(mockScheduler),
+				standard.WithListenAddress(listenAddress),
+				standard.WithChainTime(chainTime),
+				standard.WithConfigURL(configURL),
+				standard.WithFallbackFeeRecipient(fallbackFeeRecipient),
+				standard.WithFallbackGasLimit(fallbackGasLimit),
+				standard.WithAccountsProvider(mockAccountsProvider),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithValidatorRegistrationSigner(mockSigner),
+				standard.WithLogResults(true),
+				standard.WithSpecProvider(specProvider),
+				standard.WithDomainProvider(domainProvider),
+				standard.WithWeightedSelectionMargin(1),
+			},
+			err: "problem with parameters: weighted selection margin must be at least 0 and less than 1",
+		},
 		{
 			name: "Good",
 			params: []standard.Parameter{
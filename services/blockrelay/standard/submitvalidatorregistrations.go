@@ -89,7 +89,7 @@ func (s *Service) submitValidatorRegistrations(ctx context.Context,
 		log.Debug().Msg("No validating accounts; not submiting validator registrations")
 		return
 	}
-	if s.executionConfig == nil {
+	if s.currentExecutionConfig() == nil {
 		monitorValidatorRegistrations(false, time.Since(started))
 		log.Debug().Msg("No execution config; not submiting validator registrations")
 		return
@@ -108,7 +108,8 @@ func (s *Service) submitValidatorRegistrationsForAccounts(ctx context.Context,
 	ctx, span := otel.Tracer("attestantio.vouch.services.blockrelay.standard").Start(ctx, "submitValidatorRegistrationsForAccounts")
 	defer span.End()
 
-	if s.executionConfig == nil {
+	executionConfig := s.currentExecutionConfig()
+	if executionConfig == nil {
 		return errors.New("no execution configuration; cannot submit validator registrations at current")
 	}
 
@@ -121,7 +122,7 @@ func (s *Service) submitValidatorRegistrationsForAccounts(ctx context.Context,
 		} else {
 			copy(pubkey[:], account.PublicKey().Marshal())
 		}
-		proposerConfig, err := s.executionConfig.ProposerConfig(ctx, account, pubkey, s.fallbackFeeRecipient, s.fallbackGasLimit)
+		proposerConfig, err := executionConfig.ProposerConfig(ctx, account, pubkey, s.fallbackFeeRecipient, s.fallbackGasLimit)
 		if err != nil {
 			return errors.Wrap(err, "No proposer configuration; cannot submit validator registrations")
 		}
@@ -176,7 +177,7 @@ func (s *Service) submitValidatorRegistrationsForAccounts(ctx context.Context,
 			))
 			defer span.End()
 
-			client, err := util.FetchBuilderClient(ctx, builder, monitor)
+			client, err := util.FetchBuilderClient(ctx, builder, monitor, s.builderClientIdleTimeout)
 			if err != nil {
 				log.Error().Err(err).Str("builder", builder).Msg("Failed to fetch builder client")
 				return
@@ -0,0 +1,111 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/attestantio/vouch/services/beaconblockproposer"
+	"github.com/attestantio/vouch/util"
+)
+
+// uncompetitiveDetector tracks the deltas between the bids provided by relays
+// and the winning bids.  If a relay's bids are further than its configured
+// delta from the winning bid for every auction in the window the relay is
+// considered uncompetitive.
+type uncompetitiveDetector struct {
+	mu            sync.Mutex
+	window        int
+	history       map[string][]bool
+	uncompetitive map[string]bool
+}
+
+// newUncompetitiveDetector creates a new uncompetitive detector.
+func newUncompetitiveDetector(window int) *uncompetitiveDetector {
+	return &uncompetitiveDetector{
+		window:        window,
+		history:       make(map[string][]bool),
+		uncompetitive: make(map[string]bool),
+	}
+}
+
+// record records whether the bid from a relay exceeded its delta in an auction.
+// It returns the uncompetitive state of the relay, and if the state has changed.
+func (d *uncompetitiveDetector) record(provider string, exceeded bool) (bool, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	provider = strings.ToLower(provider)
+	history := append(d.history[provider], exceeded)
+	if len(history) > d.window {
+		history = history[len(history)-d.window:]
+	}
+	d.history[provider] = history
+
+	uncompetitive := len(history) == d.window
+	for _, entry := range history {
+		if !entry {
+			uncompetitive = false
+			break
+		}
+	}
+
+	changed := uncompetitive != d.uncompetitive[provider]
+	d.uncompetitive[provider] = uncompetitive
+
+	return uncompetitive, changed
+}
+
+// trackUncompetitive records the deltas of the bids in an auction for those
+// relays that have an uncompetitive delta configured.
+func (s *Service) trackUncompetitive(ctx context.Context,
+	relays []*beaconblockproposer.RelayConfig,
+	values map[string]*big.Int,
+	winningValue *big.Int,
+) {
+	for _, relay := range relays {
+		if relay.UncompetitiveDelta.IsZero() {
+			continue
+		}
+		// Values are keyed by the address as provided by the builder client, so use that.
+		builderClient, err := util.FetchBuilderClient(ctx, relay.Address, s.monitor, s.builderClientIdleTimeout)
+		if err != nil {
+			continue
+		}
+		provider := builderClient.Address()
+		value, exists := values[provider]
+		if !exists {
+			// The relay did not take part in the auction.
+			continue
+		}
+
+		delta := new(big.Int).Sub(winningValue, value)
+		exceeded := delta.Cmp(relay.UncompetitiveDelta.BigInt()) > 0
+		uncompetitive, changed := s.uncompetitive.record(provider, exceeded)
+		if !changed {
+			continue
+		}
+		if label, labelled := s.relayGaugeLabel(provider); labelled {
+			monitorRelayUncompetitive(label, uncompetitive)
+		}
+		if uncompetitive {
+			log.Warn().Str("provider", provider).Int("auctions", s.uncompetitive.window).Msg("Relay consistently uncompetitive")
+		} else {
+			log.Info().Str("provider", provider).Msg("Relay no longer consistently uncompetitive")
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUncompetitiveDetector(t *testing.T) {
+	d := newUncompetitiveDetector(3)
+
+	// Exceeding the delta is not enough until the window is full.
+	uncompetitive, changed := d.record("https://relay1.example.com/", true)
+	require.False(t, uncompetitive)
+	require.False(t, changed)
+	uncompetitive, changed = d.record("https://relay1.example.com/", true)
+	require.False(t, uncompetitive)
+	require.False(t, changed)
+
+	// Filling the window results in the relay being uncompetitive.
+	uncompetitive, changed = d.record("https://RELAY1.example.com/", true)
+	require.True(t, uncompetitive)
+	require.True(t, changed)
+
+	// Remaining uncompetitive is not a change.
+	uncompetitive, changed = d.record("https://relay1.example.com/", true)
+	require.True(t, uncompetitive)
+	require.False(t, changed)
+
+	// Other relays are tracked separately.
+	uncompetitive, changed = d.record("https://relay2.example.com/", true)
+	require.False(t, uncompetitive)
+	require.False(t, changed)
+
+	// A single competitive bid clears the state.
+	uncompetitive, changed = d.record("https://relay1.example.com/", false)
+	require.False(t, uncompetitive)
+	require.True(t, changed)
+
+	// The competitive bid must leave the window before the relay is uncompetitive again.
+	uncompetitive, _ = d.record("https://relay1.example.com/", true)
+	require.False(t, uncompetitive)
+	uncompetitive, _ = d.record("https://relay1.example.com/", true)
+	require.False(t, uncompetitive)
+	uncompetitive, changed = d.record("https://relay1.example.com/", true)
+	require.True(t, uncompetitive)
+	require.True(t, changed)
+}
@@ -0,0 +1,98 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+)
+
+// weightedSelection selects a bid from those within the weighted selection
+// margin of the best score, weighted by score.  This gives up a small amount
+// of value in exchange for spreading proposals over more relays.
+//
+// Selection uses the service's random source, which by default is seeded by
+// the slot so is reproducible.
+func (s *Service) weightedSelection(log zerolog.Logger,
+	slot phase0.Slot,
+	res *blockauctioneer.Results,
+	bestScore *big.Int,
+	candidates []*builderBidResponse,
+) {
+	threshold := decimal.NewFromBigInt(bestScore, 0).
+		Mul(decimal.NewFromInt(1).Sub(decimal.NewFromFloat(s.weightedSelectionMargin))).
+		BigInt()
+
+	eligible := make([]*builderBidResponse, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.score.Cmp(threshold) >= 0 {
+			eligible = append(eligible, candidate)
+		}
+	}
+	if len(eligible) < 2 {
+		// Nothing to choose between.
+		return
+	}
+
+	// Order deterministically prior to selection, so that the order of arrival
+	// does not affect the outcome.
+	ordered := make([]*builderBidResponse, len(eligible))
+	copy(ordered, eligible)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return strings.ToLower(ordered[i].provider.Address()) < strings.ToLower(ordered[j].provider.Address())
+	})
+
+	total := new(big.Int)
+	for _, candidate := range ordered {
+		total.Add(total, candidate.score)
+	}
+	if total.Sign() == 0 {
+		return
+	}
+
+	point := s.randomSource.Int(slot, total)
+	selected := ordered[len(ordered)-1]
+	cumulative := new(big.Int)
+	for _, candidate := range ordered {
+		cumulative.Add(cumulative, candidate.score)
+		if point.Cmp(cumulative) < 0 {
+			selected = candidate
+			break
+		}
+	}
+
+	if bidsEqual(res.Bid, selected.bid) {
+		log.Trace().Str("provider", selected.provider.Address()).Msg("Weighted selection chose best bid")
+		return
+	}
+
+	log.Trace().Str("provider", selected.provider.Address()).Stringer("score", selected.score).Stringer("best_score", bestScore).Msg("Weighted selection chose alternative bid")
+	res.Bid = selected.bid
+	res.Providers = make([]builderclient.BuilderBidProvider, 0, s.maxMatchingProviders)
+	for _, candidate := range eligible {
+		if len(res.Providers) == s.maxMatchingProviders {
+			break
+		}
+		if candidate.score.Cmp(selected.score) == 0 && bidsEqual(selected.bid, candidate.bid) {
+			res.Providers = append(res.Providers, candidate.provider)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	builderclient "github.com/attestantio/go-builder-client"
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/blockrelay"
+	"github.com/stretchr/testify/require"
+)
+
+// testBidWithGasUsed returns a test bid with the given gas used, to provide distinct bids.
+func testBidWithGasUsed(t *testing.T, gasUsed uint64) *builderspec.VersionedSignedBuilderBid {
+	t.Helper()
+	bid := testBid(t)
+	bid.Bellatrix.Message.Header.GasUsed = gasUsed
+	return bid
+}
+
+// runWeightedSelection runs an auction with the given scores over a number of
+// slots, returning the number of times each provider was selected.
+func runWeightedSelection(t *testing.T,
+	margin float64,
+	scores map[string]int64,
+	slots int,
+) map[string]int {
+	t.Helper()
+
+	return runWeightedSelectionWithSource(t, &slotRandomSource{}, margin, scores, slots)
+}
+
+// runWeightedSelectionWithSource runs weighted selection as per runWeightedSelection
+// with the given random source.
+func runWeightedSelectionWithSource(t *testing.T,
+	source blockrelay.RandomSource,
+	margin float64,
+	scores map[string]int64,
+	slots int,
+) map[string]int {
+	t.Helper()
+
+	s := testAuctionService(t)
+	s.weightedSelectionMargin = margin
+	s.randomSource = source
+
+	candidates := make([]*builderBidResponse, 0, len(scores))
+	gasUsed := uint64(1)
+	for address, score := range scores {
+		candidates = append(candidates, &builderBidResponse{
+			provider: &mock.BuilderClient{MockAddress: address},
+			bid:      testBidWithGasUsed(t, gasUsed),
+			score:    big.NewInt(score),
+		})
+		gasUsed++
+	}
+
+	selections := make(map[string]int)
+	for slot := 0; slot < slots; slot++ {
+		res := &blockauctioneer.Results{
+			Values:    make(map[string]*big.Int),
+			Providers: make([]builderclient.BuilderBidProvider, 0),
+		}
+		bestScore := big.NewInt(0)
+		for _, candidate := range candidates {
+			bestScore = s.processBidResponse(log, res, bestScore, candidate)
+		}
+		s.weightedSelection(log, phase0.Slot(slot), res, bestScore, candidates)
+		require.Len(t, res.Providers, 1)
+		selections[res.Providers[0].Address()]++
+	}
+
+	return selections
+}
+
+func TestWeightedSelectionDistribution(t *testing.T) {
+	slots := 10000
+
+	tests := []struct {
+		name      string
+		margin    float64
+		scores    map[string]int64
+		expected  map[string]float64
+		tolerance float64
+	}{
+		{
+			name:   "Equal",
+			margin: 0.1,
+			scores: map[string]int64{
+				"relay1": 1000,
+				"relay2": 1000,
+			},
+			expected: map[string]float64{
+				"relay1": 0.5,
+				"relay2": 0.5,
+			},
+			tolerance: 0.03,
+		},
+		{
+			name:   "Weighted",
+			margin: 0.8,
+			scores: map[string]int64{
+				"relay1": 3000,
+				"relay2": 1000,
+			},
+			expected: map[string]float64{
+				"relay1": 0.75,
+				"relay2": 0.25,
+			},
+			tolerance: 0.03,
+		},
+		{
+			name:   "OutsideMargin",
+			margin: 0.01,
+			scores: map[string]int64{
+				"relay1": 1000,
+				"relay2": 995,
+				"relay3": 980,
+			},
+			expected: map[string]float64{
+				"relay1": 0.5,
+				"relay2": 0.5,
+				"relay3": 0,
+			},
+			tolerance: 0.03,
+		},
+		{
+			name:   "SingleEligible",
+			margin: 0.01,
+			scores: map[string]int64{
+				"relay1": 1000,
+				"relay2": 900,
+			},
+			expected: map[string]float64{
+				"relay1": 1,
+				"relay2": 0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			selections := runWeightedSelection(t, test.margin, test.scores, slots)
+			for address, expected := range test.expected {
+				actual := float64(selections[address]) / float64(slots)
+				require.InDelta(t, expected, actual, test.tolerance, address)
+			}
+		})
+	}
+}
+
+func TestWeightedSelectionDeterministic(t *testing.T) {
+	scores := map[string]int64{
+		"relay1": 1000,
+		"relay2": 999,
+		"relay3": 998,
+	}
+
+	// Running the same slots multiple times should give the same results.
+	first := runWeightedSelection(t, 0.05, scores, 100)
+	for i := 0; i < 5; i++ {
+		require.Equal(t, first, runWeightedSelection(t, 0.05, scores, 100))
+	}
+}
+
+// fixedSeedRandomSource is a random source that uses the same seed for every slot.
+type fixedSeedRandomSource struct {
+	seed int64
+}
+
+func (r *fixedSeedRandomSource) Int(_ phase0.Slot, limit *big.Int) *big.Int {
+	//nolint:gosec
+	return new(big.Int).Rand(rand.New(rand.NewSource(r.seed)), limit)
+}
+
+func TestWeightedSelectionRandomSource(t *testing.T) {
+	scores := map[string]int64{
+		"relay1": 1000,
+		"relay2": 999,
+		"relay3": 998,
+	}
+
+	// A fixed seed should select the same relay for every slot.
+	first := runWeightedSelectionWithSource(t, &fixedSeedRandomSource{seed: 12345}, 0.05, scores, 100)
+	require.Len(t, first, 1)
+	for provider := range first {
+		require.Equal(t, 100, first[provider])
+	}
+
+	// Running with the same seed multiple times should give the same results.
+	for i := 0; i < 5; i++ {
+		require.Equal(t, first, runWeightedSelectionWithSource(t, &fixedSeedRandomSource{seed: 12345}, 0.05, scores, 100))
+	}
+}
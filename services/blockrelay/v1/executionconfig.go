@@ -94,13 +94,16 @@ func (e *ExecutionConfig) ProposerConfig(_ context.Context,
 	error,
 ) {
 	// Try proposer-specific config.
+	feeRecipientSource := beaconblockproposer.FeeRecipientSourceValidator
 	proposerConfig, exists := e.ProposerConfigs[pubkey]
 	if !exists {
 		// Try default config.
+		feeRecipientSource = beaconblockproposer.FeeRecipientSourceDefault
 		proposerConfig = e.DefaultConfig
 	}
 	if proposerConfig == nil {
 		// Use fallback config.
+		feeRecipientSource = beaconblockproposer.FeeRecipientSourceFallback
 		proposerConfig = &ProposerConfig{
 			FeeRecipient: fallbackFeeRecipient,
 			GasLimit:     fallbackGasLimit,
@@ -135,8 +138,9 @@ func (e *ExecutionConfig) ProposerConfig(_ context.Context,
 	}
 
 	return &beaconblockproposer.ProposerConfig{
-		FeeRecipient: proposerConfig.FeeRecipient,
-		Relays:       relays,
+		FeeRecipient:       proposerConfig.FeeRecipient,
+		FeeRecipientSource: feeRecipientSource,
+		Relays:             relays,
 	}, nil
 }
 
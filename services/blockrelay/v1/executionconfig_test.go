@@ -150,8 +150,9 @@ func TestECProposerConfig(t *testing.T) {
 			fallbackGasLimit:     12345,
 			input:                []byte(`{"default_config":{"fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213"}}`),
 			pc: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: executionAddress("0x000102030405060708090a0b0c0d0e0f10111213"),
-				Relays:       []*beaconblockproposer.RelayConfig{},
+				FeeRecipient:       executionAddress("0x000102030405060708090a0b0c0d0e0f10111213"),
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceDefault,
+				Relays:             []*beaconblockproposer.RelayConfig{},
 			},
 		},
 		{
@@ -160,7 +161,8 @@ func TestECProposerConfig(t *testing.T) {
 			fallbackGasLimit:     12345,
 			input:                []byte(`{"default_config":{"fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","builder":{"enabled":true,"relays": ["https://relay1.com/"]}}}`),
 			pc: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: executionAddress("0x000102030405060708090a0b0c0d0e0f10111213"),
+				FeeRecipient:       executionAddress("0x000102030405060708090a0b0c0d0e0f10111213"),
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceDefault,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -177,7 +179,8 @@ func TestECProposerConfig(t *testing.T) {
 			fallbackGasLimit:     12345,
 			input:                []byte(`{"default_config":{"fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"23456","builder":{"enabled":true,"relays": ["https://relay1.com/"]}}}`),
 			pc: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: executionAddress("0x000102030405060708090a0b0c0d0e0f10111213"),
+				FeeRecipient:       executionAddress("0x000102030405060708090a0b0c0d0e0f10111213"),
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceDefault,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -195,7 +198,8 @@ func TestECProposerConfig(t *testing.T) {
 			input:                []byte(`{"default_config":{"fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"23456","builder":{"enabled":true,"relays": ["https://relay1.com/"]}},"proposer_configs":{"0x111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111": null}}`),
 			pubkey:               pubkey("0x111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111"),
 			pc: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: executionAddress("0x000102030405060708090a0b0c0d0e0f10111213"),
+				FeeRecipient:       executionAddress("0x000102030405060708090a0b0c0d0e0f10111213"),
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceDefault,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -213,8 +217,9 @@ func TestECProposerConfig(t *testing.T) {
 			input:                []byte(`{"default_config":{"fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"23456","builder":{"enabled":true,"relays": ["https://relay1.com/"]}},"proposer_config":{"0x111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111": {"fee_recipient":"0x0102030405060708090a0b0c0d0e0f1011121314","gas_limit":"34567"}}}`),
 			pubkey:               pubkey("0x111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111"),
 			pc: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: executionAddress("0x0102030405060708090a0b0c0d0e0f1011121314"),
-				Relays:       []*beaconblockproposer.RelayConfig{},
+				FeeRecipient:       executionAddress("0x0102030405060708090a0b0c0d0e0f1011121314"),
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceValidator,
+				Relays:             []*beaconblockproposer.RelayConfig{},
 			},
 		},
 	}
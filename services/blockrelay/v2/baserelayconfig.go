@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -31,19 +32,23 @@ var weiPerETH = decimal.New(1e18, 0)
 
 // BaseRelayConfig are the options for base relays.
 type BaseRelayConfig struct {
-	PublicKey    *phase0.BLSPubKey
-	FeeRecipient *bellatrix.ExecutionAddress
-	GasLimit     *uint64
-	Grace        *time.Duration
-	MinValue     *decimal.Decimal
+	PublicKey          *phase0.BLSPubKey
+	FeeRecipient       *bellatrix.ExecutionAddress
+	GasLimit           *uint64
+	Grace              *time.Duration
+	MinValue           *decimal.Decimal
+	ExpectedVersion    *spec.DataVersion
+	UncompetitiveDelta *decimal.Decimal
 }
 
 type baseRelayConfigJSON struct {
-	PublicKey    string `json:"public_key,omitempty"`
-	FeeRecipient string `json:"fee_recipient,omitempty"`
-	GasLimit     string `json:"gas_limit,omitempty"`
-	Grace        string `json:"grace,omitempty"`
-	MinValue     string `json:"min_value,omitempty"`
+	PublicKey          string `json:"public_key,omitempty"`
+	FeeRecipient       string `json:"fee_recipient,omitempty"`
+	GasLimit           string `json:"gas_limit,omitempty"`
+	Grace              string `json:"grace,omitempty"`
+	MinValue           string `json:"min_value,omitempty"`
+	ExpectedVersion    string `json:"expected_version,omitempty"`
+	UncompetitiveDelta string `json:"uncompetitive_delta,omitempty"`
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -68,12 +73,22 @@ func (c *BaseRelayConfig) MarshalJSON() ([]byte, error) {
 	if c.MinValue != nil {
 		minValue = fmt.Sprintf("%v", c.MinValue.Div(weiPerETH))
 	}
+	expectedVersion := ""
+	if c.ExpectedVersion != nil {
+		expectedVersion = c.ExpectedVersion.String()
+	}
+	uncompetitiveDelta := ""
+	if c.UncompetitiveDelta != nil {
+		uncompetitiveDelta = fmt.Sprintf("%v", c.UncompetitiveDelta.Div(weiPerETH))
+	}
 	return json.Marshal(&baseRelayConfigJSON{
-		PublicKey:    publicKey,
-		FeeRecipient: feeRecipient,
-		GasLimit:     gasLimit,
-		Grace:        grace,
-		MinValue:     minValue,
+		PublicKey:          publicKey,
+		FeeRecipient:       feeRecipient,
+		GasLimit:           gasLimit,
+		Grace:              grace,
+		MinValue:           minValue,
+		ExpectedVersion:    expectedVersion,
+		UncompetitiveDelta: uncompetitiveDelta,
 	})
 }
 
@@ -134,6 +149,24 @@ func (c *BaseRelayConfig) UnmarshalJSON(input []byte) error {
 		minValue = minValue.Mul(weiPerETH)
 		c.MinValue = &minValue
 	}
+	if data.ExpectedVersion != "" {
+		var expectedVersion spec.DataVersion
+		if err := expectedVersion.UnmarshalJSON([]byte(fmt.Sprintf("%q", data.ExpectedVersion))); err != nil {
+			return errors.Wrap(err, "expected version invalid")
+		}
+		c.ExpectedVersion = &expectedVersion
+	}
+	if data.UncompetitiveDelta != "" {
+		uncompetitiveDelta, err := decimal.NewFromString(data.UncompetitiveDelta)
+		if err != nil {
+			return errors.Wrap(err, "uncompetitive delta invalid")
+		}
+		if uncompetitiveDelta.Sign() == -1 {
+			return errors.New("uncompetitive delta cannot be negative")
+		}
+		uncompetitiveDelta = uncompetitiveDelta.Mul(weiPerETH)
+		c.UncompetitiveDelta = &uncompetitiveDelta
+	}
 
 	return nil
 }
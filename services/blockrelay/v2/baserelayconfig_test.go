@@ -97,10 +97,43 @@ func TestBaseRelayConfig(t *testing.T) {
 			input: []byte(`{"fee_recipient":"0x1111111111111111111111111111111111111111","gas_limit":"30000000","grace":"1000","min_value":"-1"}`),
 			err:   "min value cannot be negative",
 		},
+		{
+			name:  "ExpectedVersionWrongType",
+			input: []byte(`{"fee_recipient":"0x1111111111111111111111111111111111111111","gas_limit":"30000000","grace":"1000","min_value":"0.5","expected_version":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field baseRelayConfigJSON.expected_version of type string",
+		},
+		{
+			name:  "ExpectedVersionInvalid",
+			input: []byte(`{"fee_recipient":"0x1111111111111111111111111111111111111111","gas_limit":"30000000","grace":"1000","min_value":"0.5","expected_version":"invalid"}`),
+			err:   "expected version invalid: unrecognised data version \"invalid\"",
+		},
 		{
 			name:  "Good",
 			input: []byte(`{"fee_recipient":"0x1111111111111111111111111111111111111111","gas_limit":"30000000","grace":"1000","min_value":"0.5"}`),
 		},
+		{
+			name:  "UncompetitiveDeltaWrongType",
+			input: []byte(`{"fee_recipient":"0x1111111111111111111111111111111111111111","gas_limit":"30000000","grace":"1000","min_value":"0.5","uncompetitive_delta":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field baseRelayConfigJSON.uncompetitive_delta of type string",
+		},
+		{
+			name:  "UncompetitiveDeltaInvalid",
+			input: []byte(`{"fee_recipient":"0x1111111111111111111111111111111111111111","gas_limit":"30000000","grace":"1000","min_value":"0.5","uncompetitive_delta":"invalid"}`),
+			err:   "uncompetitive delta invalid: can't convert invalid to decimal",
+		},
+		{
+			name:  "UncompetitiveDeltaNegative",
+			input: []byte(`{"fee_recipient":"0x1111111111111111111111111111111111111111","gas_limit":"30000000","grace":"1000","min_value":"0.5","uncompetitive_delta":"-1"}`),
+			err:   "uncompetitive delta cannot be negative",
+		},
+		{
+			name:  "GoodExpectedVersion",
+			input: []byte(`{"fee_recipient":"0x1111111111111111111111111111111111111111","gas_limit":"30000000","grace":"1000","min_value":"0.5","expected_version":"capella"}`),
+		},
+		{
+			name:  "GoodUncompetitiveDelta",
+			input: []byte(`{"fee_recipient":"0x1111111111111111111111111111111111111111","gas_limit":"30000000","grace":"1000","min_value":"0.5","uncompetitive_delta":"0.01"}`),
+		},
 		{
 			name:  "Empty",
 			input: []byte(`{}`),
@@ -158,8 +158,10 @@ func (e *ExecutionConfig) ProposerConfig(_ context.Context,
 
 	if e.FeeRecipient == nil {
 		config.FeeRecipient = fallbackFeeRecipient
+		config.FeeRecipientSource = beaconblockproposer.FeeRecipientSourceFallback
 	} else {
 		config.FeeRecipient = *e.FeeRecipient
+		config.FeeRecipientSource = beaconblockproposer.FeeRecipientSourceDefault
 	}
 
 	// Set initial relay options.
@@ -205,6 +207,11 @@ func (e *ExecutionConfig) ProposerConfig(_ context.Context,
 		// Update from proposer-level info.
 		if proposerConfig.FeeRecipient != nil {
 			config.FeeRecipient = *proposerConfig.FeeRecipient
+			if proposerConfig.Account != nil {
+				config.FeeRecipientSource = beaconblockproposer.FeeRecipientSourceAccount
+			} else {
+				config.FeeRecipientSource = beaconblockproposer.FeeRecipientSourceValidator
+			}
 			for _, configRelay := range config.Relays {
 				configRelay.FeeRecipient = *proposerConfig.FeeRecipient
 			}
@@ -225,6 +232,10 @@ func (e *ExecutionConfig) ProposerConfig(_ context.Context,
 			}
 		}
 
+		if proposerConfig.ForceLocalBuild {
+			config.ForceLocalBuild = true
+		}
+
 		if proposerConfig.ResetRelays {
 			// The proposer wants to start from scratch, remove existing relay info.
 			config.Relays = make([]*beaconblockproposer.RelayConfig, 0)
@@ -307,6 +318,14 @@ func setRelayConfig(config *beaconblockproposer.RelayConfig,
 	if relayConfig.MinValue != nil {
 		config.MinValue = *relayConfig.MinValue
 	}
+
+	if relayConfig.ExpectedVersion != nil {
+		config.ExpectedVersion = relayConfig.ExpectedVersion
+	}
+
+	if relayConfig.UncompetitiveDelta != nil {
+		config.UncompetitiveDelta = *relayConfig.UncompetitiveDelta
+	}
 }
 
 // updateRelayConfig updates the configuration for a relay with proposer-specific overrides.
@@ -332,6 +351,14 @@ func updateRelayConfig(config *beaconblockproposer.RelayConfig,
 	if relayConfig.MinValue != nil {
 		config.MinValue = *relayConfig.MinValue
 	}
+
+	if relayConfig.ExpectedVersion != nil {
+		config.ExpectedVersion = relayConfig.ExpectedVersion
+	}
+
+	if relayConfig.UncompetitiveDelta != nil {
+		config.UncompetitiveDelta = *relayConfig.UncompetitiveDelta
+	}
 }
 
 // String provides a string representation of the struct.
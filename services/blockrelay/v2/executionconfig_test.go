@@ -198,8 +198,9 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient1,
-				Relays:       []*beaconblockproposer.RelayConfig{},
+				FeeRecipient:       feeRecipient1,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceFallback,
+				Relays:             []*beaconblockproposer.RelayConfig{},
 			},
 		},
 		{
@@ -214,7 +215,8 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient1,
+				FeeRecipient:       feeRecipient1,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceFallback,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -238,8 +240,9 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     uint64(12345),
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient2,
-				Relays:       []*beaconblockproposer.RelayConfig{},
+				FeeRecipient:       feeRecipient2,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceDefault,
+				Relays:             []*beaconblockproposer.RelayConfig{},
 			},
 		},
 		{
@@ -259,7 +262,8 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient1,
+				FeeRecipient:       feeRecipient1,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceFallback,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -297,7 +301,8 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient3,
+				FeeRecipient:       feeRecipient3,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceAccount,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -335,7 +340,8 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient1,
+				FeeRecipient:       feeRecipient1,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceFallback,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -377,7 +383,8 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient1,
+				FeeRecipient:       feeRecipient1,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceFallback,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -415,7 +422,8 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient3,
+				FeeRecipient:       feeRecipient3,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceValidator,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -453,7 +461,8 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient1,
+				FeeRecipient:       feeRecipient1,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceFallback,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -499,7 +508,8 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient3,
+				FeeRecipient:       feeRecipient3,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceValidator,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay1.com/",
@@ -538,8 +548,74 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient3,
-				Relays:       []*beaconblockproposer.RelayConfig{},
+				FeeRecipient:       feeRecipient3,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceValidator,
+				Relays:             []*beaconblockproposer.RelayConfig{},
+			},
+		},
+		{
+			name: "ProposerValidatorForceLocalBuild",
+			executionConfig: &v2.ExecutionConfig{
+				Relays: map[string]*v2.BaseRelayConfig{
+					"https://relay1.com/": {},
+				},
+				Proposers: []*v2.ProposerConfig{
+					{
+						Validator:       pubkey1,
+						ForceLocalBuild: true,
+					},
+				},
+			},
+			account:              account1,
+			pubkey:               pubkey1,
+			fallbackFeeRecipient: feeRecipient1,
+			fallbackGasLimit:     gasLimit1,
+			expected: &beaconblockproposer.ProposerConfig{
+				FeeRecipient:       feeRecipient1,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceFallback,
+				Relays: []*beaconblockproposer.RelayConfig{
+					{
+						Address:      "https://relay1.com/",
+						FeeRecipient: feeRecipient1,
+						GasLimit:     gasLimit1,
+						MinValue:     decimal.Zero,
+					},
+				},
+				ForceLocalBuild: true,
+			},
+		},
+		{
+			name: "ProposerValidatorForceLocalBuildMultipleMatches",
+			executionConfig: &v2.ExecutionConfig{
+				Relays: map[string]*v2.BaseRelayConfig{
+					"https://relay1.com/": {},
+				},
+				Proposers: []*v2.ProposerConfig{
+					{
+						Validator:       pubkey1,
+						ForceLocalBuild: true,
+					},
+					{
+						Validator: pubkey1,
+					},
+				},
+			},
+			account:              account1,
+			pubkey:               pubkey1,
+			fallbackFeeRecipient: feeRecipient1,
+			fallbackGasLimit:     gasLimit1,
+			expected: &beaconblockproposer.ProposerConfig{
+				FeeRecipient:       feeRecipient1,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceFallback,
+				Relays: []*beaconblockproposer.RelayConfig{
+					{
+						Address:      "https://relay1.com/",
+						FeeRecipient: feeRecipient1,
+						GasLimit:     gasLimit1,
+						MinValue:     decimal.Zero,
+					},
+				},
+				ForceLocalBuild: true,
 			},
 		},
 		{
@@ -572,7 +648,8 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient3,
+				FeeRecipient:       feeRecipient3,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceValidator,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay3.com/",
@@ -618,7 +695,8 @@ func TestConfig(t *testing.T) {
 			fallbackFeeRecipient: feeRecipient1,
 			fallbackGasLimit:     gasLimit1,
 			expected: &beaconblockproposer.ProposerConfig{
-				FeeRecipient: feeRecipient3,
+				FeeRecipient:       feeRecipient3,
+				FeeRecipientSource: beaconblockproposer.FeeRecipientSourceValidator,
 				Relays: []*beaconblockproposer.RelayConfig{
 					{
 						Address:      "https://relay3.com/",
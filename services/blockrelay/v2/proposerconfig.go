@@ -31,24 +31,26 @@ import (
 // ProposerConfig contains proposer-specific configuration for validators
 // proposing execution payloads.
 type ProposerConfig struct {
-	Validator    phase0.BLSPubKey
-	Account      *regexp.Regexp
-	FeeRecipient *bellatrix.ExecutionAddress
-	GasLimit     *uint64
-	Grace        *time.Duration
-	MinValue     *decimal.Decimal
-	ResetRelays  bool
-	Relays       map[string]*ProposerRelayConfig
+	Validator       phase0.BLSPubKey
+	Account         *regexp.Regexp
+	FeeRecipient    *bellatrix.ExecutionAddress
+	GasLimit        *uint64
+	Grace           *time.Duration
+	MinValue        *decimal.Decimal
+	ResetRelays     bool
+	ForceLocalBuild bool
+	Relays          map[string]*ProposerRelayConfig
 }
 
 type proposerConfigJSON struct {
-	Proposer     string                          `json:"proposer"`
-	FeeRecipient string                          `json:"fee_recipient,omitempty"`
-	GasLimit     string                          `json:"gas_limit,omitempty"`
-	Grace        string                          `json:"grace,omitempty"`
-	MinValue     string                          `json:"min_value,omitempty"`
-	ResetRelays  bool                            `json:"reset_relays,omitempty"`
-	Relays       map[string]*ProposerRelayConfig `json:"relays,omitempty"`
+	Proposer        string                          `json:"proposer"`
+	FeeRecipient    string                          `json:"fee_recipient,omitempty"`
+	GasLimit        string                          `json:"gas_limit,omitempty"`
+	Grace           string                          `json:"grace,omitempty"`
+	MinValue        string                          `json:"min_value,omitempty"`
+	ResetRelays     bool                            `json:"reset_relays,omitempty"`
+	ForceLocalBuild bool                            `json:"force_local_build,omitempty"`
+	Relays          map[string]*ProposerRelayConfig `json:"relays,omitempty"`
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -77,13 +79,14 @@ func (p *ProposerConfig) MarshalJSON() ([]byte, error) {
 	}
 
 	return json.Marshal(&proposerConfigJSON{
-		Proposer:     proposer,
-		FeeRecipient: feeRecipient,
-		GasLimit:     gasLimit,
-		Grace:        grace,
-		MinValue:     minValue,
-		ResetRelays:  p.ResetRelays,
-		Relays:       p.Relays,
+		Proposer:        proposer,
+		FeeRecipient:    feeRecipient,
+		GasLimit:        gasLimit,
+		Grace:           grace,
+		MinValue:        minValue,
+		ResetRelays:     p.ResetRelays,
+		ForceLocalBuild: p.ForceLocalBuild,
+		Relays:          p.Relays,
 	})
 }
 
@@ -159,6 +162,7 @@ func (p *ProposerConfig) UnmarshalJSON(input []byte) error {
 		p.MinValue = &minValue
 	}
 	p.ResetRelays = data.ResetRelays
+	p.ForceLocalBuild = data.ForceLocalBuild
 	p.Relays = data.Relays
 
 	return nil
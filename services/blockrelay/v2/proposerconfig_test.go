@@ -130,6 +130,10 @@ func TestProposerConfig(t *testing.T) {
 			name:  "GoodPubkey",
 			input: []byte(`{"proposer":"0x222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222","fee_recipient":"0x1111111111111111111111111111111111111111","gas_limit":"30000000","grace":"1000","min_value":"0.5"}`),
 		},
+		{
+			name:  "GoodForceLocalBuild",
+			input: []byte(`{"proposer":"^Wallet/Account$","force_local_build":true}`),
+		},
 	}
 
 	for _, test := range tests {
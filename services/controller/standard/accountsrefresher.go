@@ -17,6 +17,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/attestantio/vouch/services/accountmanager"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
 )
@@ -58,4 +59,28 @@ func (s *Service) refreshAccounts(ctx context.Context, _ interface{}) {
 	started := time.Now()
 	s.accountsRefresher.Refresh(ctx)
 	log.Trace().Dur("elapsed", time.Since(started)).Msg("Refreshed accounts")
+
+	if provider, isProvider := s.validatingAccountsProvider.(accountmanager.PendingAccountsProvider); isProvider {
+		s.reportPendingAccounts(ctx, provider)
+	}
+}
+
+// reportPendingAccounts logs the accounts whose validators are pending activation,
+// allowing operators to track their progress through the activation queue.
+func (s *Service) reportPendingAccounts(ctx context.Context, provider accountmanager.PendingAccountsProvider) {
+	pendingAccounts, err := provider.PendingAccountsForEpoch(ctx, s.chainTimeService.CurrentEpoch())
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to obtain accounts pending activation")
+		return
+	}
+
+	for _, pendingAccount := range pendingAccounts {
+		log.Info().
+			Uint64("index", uint64(pendingAccount.Index)).
+			Str("state", pendingAccount.State.String()).
+			Int("queue_position", pendingAccount.QueuePosition).
+			Uint64("activation_eligibility_epoch", uint64(pendingAccount.ActivationEligibilityEpoch)).
+			Uint64("activation_epoch", uint64(pendingAccount.ActivationEpoch)).
+			Msg("Validator pending activation")
+	}
 }
@@ -0,0 +1,95 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/accountmanager"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPendingAccountsProvider returns fixed pending accounts.
+type stubPendingAccountsProvider struct {
+	pendingAccounts []*accountmanager.PendingAccount
+	err             error
+}
+
+func (s *stubPendingAccountsProvider) PendingAccountsForEpoch(_ context.Context, _ phase0.Epoch) ([]*accountmanager.PendingAccount, error) {
+	return s.pendingAccounts, s.err
+}
+
+func TestReportPendingAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		provider *stubPendingAccountsProvider
+		expected map[string]interface{}
+	}{
+		{
+			name:     "Error",
+			provider: &stubPendingAccountsProvider{err: errors.New("error")},
+			expected: map[string]interface{}{
+				"message": "Failed to obtain accounts pending activation",
+			},
+		},
+		{
+			name: "Good",
+			provider: &stubPendingAccountsProvider{
+				pendingAccounts: []*accountmanager.PendingAccount{
+					{
+						Index:                      5,
+						State:                      apiv1.ValidatorStatePendingQueued,
+						ActivationEligibilityEpoch: 2,
+						ActivationEpoch:            10,
+						QueuePosition:              1,
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"message":        "Validator pending activation",
+				"index":          uint64(5),
+				"state":          "pending_queued",
+				"queue_position": 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewModuleLogCapture(t, &log)
+			s := &Service{
+				chainTimeService: chainTime,
+			}
+			s.reportPendingAccounts(ctx, test.provider)
+			require.True(t, capture.HasLog(test.expected))
+		})
+	}
+}
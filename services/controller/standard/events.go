@@ -174,9 +174,15 @@ func (s *Service) refreshProposerDutiesForEpoch(ctx context.Context, epoch phase
 	defer span.End()
 
 	// First thing we do is cancel all scheduled beacon bock proposal jobs for the epoch.
+	currentSlot := s.chainTimeService.CurrentSlot()
 	for slot := s.chainTimeService.FirstSlotOfEpoch(epoch); slot < s.chainTimeService.FirstSlotOfEpoch(epoch+1); slot++ {
 		s.scheduler.CancelJobIfExists(ctx, fmt.Sprintf("Early beacon block proposal for slot %d", slot))
 		s.scheduler.CancelJobIfExists(ctx, fmt.Sprintf("Beacon block proposal for slot %d", slot))
+		// Canonical block checks are rescheduled along with the proposals,
+		// which only happens for future slots.
+		if slot > currentSlot {
+			s.scheduler.CancelJobIfExists(ctx, fmt.Sprintf("Canonical block check for slot %d", slot))
+		}
 	}
 
 	_, validatorIndices, err := s.accountsAndIndicesForEpoch(ctx, epoch)
@@ -0,0 +1,84 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+)
+
+// NextProposals returns the next proposal slot for each managed validator.
+// Proposer duties are only known for the current and next epochs, so validators
+// without a proposal in that window are not present in the returned map.
+func (s *Service) NextProposals(ctx context.Context) (map[phase0.ValidatorIndex]phase0.Slot, error) {
+	ctx, span := otel.Tracer("attestantio.vouch.services.controller.standard").Start(ctx, "NextProposals")
+	defer span.End()
+
+	currentSlot := s.chainTimeService.CurrentSlot()
+	currentEpoch := s.chainTimeService.SlotToEpoch(currentSlot)
+
+	res := make(map[phase0.ValidatorIndex]phase0.Slot)
+	for _, epoch := range []phase0.Epoch{currentEpoch, currentEpoch + 1} {
+		accounts, validatorIndices, err := s.accountsAndIndicesForEpoch(ctx, epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain validating accounts for epoch %d", epoch)
+		}
+		if len(validatorIndices) == 0 {
+			continue
+		}
+
+		duties, err := s.proposerDutiesProvider.ProposerDuties(ctx, epoch, validatorIndices)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain proposer duties for epoch %d", epoch)
+		}
+
+		firstSlot := s.chainTimeService.FirstSlotOfEpoch(epoch)
+		lastSlot := s.chainTimeService.FirstSlotOfEpoch(epoch+1) - 1
+		for _, duty := range duties {
+			if duty.Slot < firstSlot || duty.Slot > lastSlot || duty.Slot < currentSlot {
+				continue
+			}
+			if _, exists := accounts[duty.ValidatorIndex]; !exists {
+				// Not one of ours.
+				continue
+			}
+			if slot, exists := res[duty.ValidatorIndex]; !exists || duty.Slot < slot {
+				res[duty.ValidatorIndex] = duty.Slot
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// updateNextProposal updates the monitor with the slot of the next proposal
+// by a managed validator, or 0 if there is no known upcoming proposal.
+func (s *Service) updateNextProposal(ctx context.Context) {
+	nextProposals, err := s.NextProposals(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to obtain next proposals")
+		return
+	}
+
+	nextProposal := phase0.Slot(0)
+	for _, slot := range nextProposals {
+		if nextProposal == 0 || slot < nextProposal {
+			nextProposal = slot
+		}
+	}
+	s.monitor.NextProposal(nextProposal)
+}
@@ -0,0 +1,219 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/accountmanager"
+	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	"github.com/stretchr/testify/require"
+)
+
+// stubProposerDutiesProvider returns fixed proposer duties for each epoch.
+type stubProposerDutiesProvider struct {
+	duties map[phase0.Epoch][]*apiv1.ProposerDuty
+	err    error
+}
+
+func (s *stubProposerDutiesProvider) ProposerDuties(_ context.Context,
+	epoch phase0.Epoch,
+	_ []phase0.ValidatorIndex,
+) (
+	[]*apiv1.ProposerDuty,
+	error,
+) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return s.duties[epoch], nil
+}
+
+// recordingControllerMonitor records the next proposal slot it is given.
+type recordingControllerMonitor struct {
+	nextProposal phase0.Slot
+}
+
+func (*recordingControllerMonitor) NewEpoch() {}
+
+func (*recordingControllerMonitor) BlockDelay(_ uint, _ time.Duration) {}
+
+func (m *recordingControllerMonitor) NextProposal(slot phase0.Slot) {
+	m.nextProposal = slot
+}
+
+func TestNextProposals(t *testing.T) {
+	ctx := context.Background()
+
+	// Current slot is 40, in epoch 1.
+	slotDuration := 12 * time.Second
+	genesisTime := time.Now().Add(-40*slotDuration - time.Second)
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(genesisTime)),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(slotDuration)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	validatingAccountsProvider := mockaccountmanager.NewValidatingAccountsProvider()
+	for _, index := range []phase0.ValidatorIndex{1, 2, 3, 4} {
+		validatingAccountsProvider.AddAccount(index, nil)
+	}
+
+	tests := []struct {
+		name                       string
+		validatingAccountsProvider accountmanager.ValidatingAccountsProvider
+		duties                     map[phase0.Epoch][]*apiv1.ProposerDuty
+		dutiesErr                  error
+		expected                   map[phase0.ValidatorIndex]phase0.Slot
+		err                        string
+	}{
+		{
+			name:                       "NoDuties",
+			validatingAccountsProvider: validatingAccountsProvider,
+			expected:                   map[phase0.ValidatorIndex]phase0.Slot{},
+		},
+		{
+			name:                       "NoAccounts",
+			validatingAccountsProvider: mockaccountmanager.NewValidatingAccountsProvider(),
+			duties: map[phase0.Epoch][]*apiv1.ProposerDuty{
+				1: {{Slot: 50, ValidatorIndex: 1}},
+			},
+			expected: map[phase0.ValidatorIndex]phase0.Slot{},
+		},
+		{
+			name:                       "AccountsErrors",
+			validatingAccountsProvider: mockaccountmanager.NewErroringValidatingAccountsProvider(),
+			err:                        "failed to obtain validating accounts for epoch 1: failed to obtain accounts: error",
+		},
+		{
+			name:                       "DutiesErrors",
+			validatingAccountsProvider: validatingAccountsProvider,
+			dutiesErr:                  errors.New("duties error"),
+			err:                        "failed to obtain proposer duties for epoch 1: duties error",
+		},
+		{
+			name:                       "Good",
+			validatingAccountsProvider: validatingAccountsProvider,
+			duties: map[phase0.Epoch][]*apiv1.ProposerDuty{
+				1: {
+					{Slot: 35, ValidatorIndex: 1},
+					{Slot: 40, ValidatorIndex: 2},
+					{Slot: 50, ValidatorIndex: 1},
+					{Slot: 55, ValidatorIndex: 99},
+				},
+				2: {
+					{Slot: 64, ValidatorIndex: 1},
+					{Slot: 70, ValidatorIndex: 3},
+				},
+			},
+			expected: map[phase0.ValidatorIndex]phase0.Slot{
+				1: 50,
+				2: 40,
+				3: 70,
+			},
+		},
+		{
+			name:                       "InvalidSlot",
+			validatingAccountsProvider: validatingAccountsProvider,
+			duties: map[phase0.Epoch][]*apiv1.ProposerDuty{
+				1: {{Slot: 70, ValidatorIndex: 1}},
+				2: {{Slot: 100, ValidatorIndex: 2}},
+			},
+			expected: map[phase0.ValidatorIndex]phase0.Slot{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				chainTimeService:           chainTime,
+				validatingAccountsProvider: test.validatingAccountsProvider,
+				proposerDutiesProvider: &stubProposerDutiesProvider{
+					duties: test.duties,
+					err:    test.dutiesErr,
+				},
+			}
+			res, err := s.NextProposals(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestUpdateNextProposal(t *testing.T) {
+	ctx := context.Background()
+
+	// Current slot is 40, in epoch 1.
+	slotDuration := 12 * time.Second
+	genesisTime := time.Now().Add(-40*slotDuration - time.Second)
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(genesisTime)),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(slotDuration)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	validatingAccountsProvider := mockaccountmanager.NewValidatingAccountsProvider()
+	for _, index := range []phase0.ValidatorIndex{1, 2} {
+		validatingAccountsProvider.AddAccount(index, nil)
+	}
+
+	tests := []struct {
+		name     string
+		duties   map[phase0.Epoch][]*apiv1.ProposerDuty
+		expected phase0.Slot
+	}{
+		{
+			name:     "NoDuties",
+			expected: 0,
+		},
+		{
+			name: "Good",
+			duties: map[phase0.Epoch][]*apiv1.ProposerDuty{
+				1: {{Slot: 50, ValidatorIndex: 1}},
+				2: {{Slot: 70, ValidatorIndex: 1}, {Slot: 80, ValidatorIndex: 2}},
+			},
+			expected: 50,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			monitor := &recordingControllerMonitor{nextProposal: 1}
+			s := &Service{
+				monitor:                    monitor,
+				chainTimeService:           chainTime,
+				validatingAccountsProvider: validatingAccountsProvider,
+				proposerDutiesProvider: &stubProposerDutiesProvider{
+					duties: test.duties,
+				},
+			}
+			s.updateNextProposal(ctx)
+			require.Equal(t, test.expected, monitor.nextProposal)
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/attestantio/vouch/services/attester"
 	"github.com/attestantio/vouch/services/beaconblockproposer"
 	"github.com/attestantio/vouch/services/beaconcommitteesubscriber"
+	"github.com/attestantio/vouch/services/blockrelay"
 	"github.com/attestantio/vouch/services/cache"
 	"github.com/attestantio/vouch/services/chaintime"
 	"github.com/attestantio/vouch/services/metrics"
@@ -65,6 +66,7 @@ type parameters struct {
 	maxSyncCommitteeMessageDelay  time.Duration
 	syncCommitteeAggregationDelay time.Duration
 	reorgs                        bool
+	chainHeadFeedback             blockrelay.ChainHeadFeedback
 }
 
 // Parameter is the interface for service parameters.
@@ -281,6 +283,13 @@ func WithReorgs(reorgs bool) Parameter {
 	})
 }
 
+// WithChainHeadFeedback sets the recipient of feedback about the canonical chain for proposed blocks.
+func WithChainHeadFeedback(feedback blockrelay.ChainHeadFeedback) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainHeadFeedback = feedback
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
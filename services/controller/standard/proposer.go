@@ -24,6 +24,10 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
+// canonicalBlockCheckEpochs is the number of epochs after the epoch of a proposal
+// at which the canonical chain is checked for the proposed block.
+const canonicalBlockCheckEpochs = 2
+
 // scheduleProposals schedules proposals for the given epoch and validator indices.
 func (s *Service) scheduleProposals(ctx context.Context,
 	epoch phase0.Epoch,
@@ -106,6 +110,18 @@ func (s *Service) scheduleProposals(ctx context.Context,
 				// Don't return here; we want to try to set up as many proposer jobs as possible.
 				log.Error().Err(err).Msg("Failed to schedule beacon block proposal")
 			}
+			if s.chainHeadFeedback != nil {
+				// Check the canonical chain once the proposal has had time to settle.
+				if err := s.scheduler.ScheduleJob(ctx,
+					"Propose",
+					fmt.Sprintf("Canonical block check for slot %d", duty.Slot()),
+					s.chainTimeService.StartOfEpoch(s.chainTimeService.SlotToEpoch(duty.Slot())+canonicalBlockCheckEpochs),
+					s.checkCanonicalBlock,
+					duty,
+				); err != nil {
+					log.Error().Err(err).Msg("Failed to schedule canonical block check")
+				}
+			}
 		}(duty)
 	}
 	log.Trace().Dur("elapsed", time.Since(started)).Msg("Scheduled beacon block proposals")
@@ -143,3 +159,30 @@ func (s *Service) proposeEarly(ctx context.Context, data interface{}) {
 		log.Trace().Uint64("slot", uint64(duty.Slot())).Uint64("header_slot", uint64(header.Header.Message.Slot)).Uint64("validator_index", uint64(duty.ValidatorIndex())).Str("header", header.String()).Msg("Head of chain is not up to date; not proposing immediately")
 	}
 }
+
+// checkCanonicalBlock provides feedback about the canonical block at the slot of a proposal.
+func (s *Service) checkCanonicalBlock(ctx context.Context, data interface{}) {
+	ctx, span := otel.Tracer("attestantio.vouch.services.controller.standard").Start(ctx, "checkCanonicalBlock")
+	defer span.End()
+
+	duty, ok := data.(*beaconblockproposer.Duty)
+	if !ok {
+		log.Error().Msg("Invalid duty data for canonical block check")
+		return
+	}
+	span.SetAttributes(attribute.Int64("slot", int64(duty.Slot())))
+
+	header, err := s.beaconBlockHeadersProvider.BeaconBlockHeader(ctx, fmt.Sprintf("%d", duty.Slot()))
+	if err != nil {
+		log.Error().Uint64("slot", uint64(duty.Slot())).Err(err).Msg("Failed to obtain beacon block header for canonical block check")
+		return
+	}
+
+	var root *phase0.Root
+	if header != nil {
+		// The header's root is that of the canonical block at the slot.
+		root = &header.Root
+	}
+
+	s.chainHeadFeedback.CanonicalBlock(ctx, duty.Slot(), root)
+}
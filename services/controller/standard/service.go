@@ -26,6 +26,7 @@ import (
 	"github.com/attestantio/vouch/services/attester"
 	"github.com/attestantio/vouch/services/beaconblockproposer"
 	"github.com/attestantio/vouch/services/beaconcommitteesubscriber"
+	"github.com/attestantio/vouch/services/blockrelay"
 	"github.com/attestantio/vouch/services/cache"
 	"github.com/attestantio/vouch/services/chaintime"
 	"github.com/attestantio/vouch/services/metrics"
@@ -80,6 +81,7 @@ type Service struct {
 	maxSyncCommitteeMessageDelay  time.Duration
 	syncCommitteeAggregationDelay time.Duration
 	reorgs                        bool
+	chainHeadFeedback             blockrelay.ChainHeadFeedback
 
 	// Hard fork control
 	handlingAltair     bool
@@ -191,6 +193,7 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		maxSyncCommitteeMessageDelay:  parameters.maxSyncCommitteeMessageDelay,
 		syncCommitteeAggregationDelay: parameters.syncCommitteeAggregationDelay,
 		reorgs:                        parameters.reorgs,
+		chainHeadFeedback:             parameters.chainHeadFeedback,
 		subscriptionInfos:             make(map[phase0.Epoch]map[phase0.Slot]map[phase0.CommitteeIndex]*beaconcommitteesubscriber.Subscription),
 		handlingAltair:                handlingAltair,
 		altairForkEpoch:               altairForkEpoch,
@@ -368,6 +371,7 @@ func (s *Service) epochTicker(ctx context.Context, data interface{}) {
 	cancel()
 
 	go s.scheduleProposals(ctx, currentEpoch, validatorIndices, false /* notCurrentSlot */)
+	go s.updateNextProposal(ctx)
 	if s.handlingAltair {
 		// Handle the Altair hard fork transition epoch.
 		if currentEpoch == s.altairForkEpoch {
@@ -585,6 +589,16 @@ func (s *Service) HasPendingAttestations(_ context.Context,
 	return s.pendingAttestations[slot]
 }
 
+// StopSyncCommitteeAggregations stops new sync committee aggregations from
+// starting, and waits for in-flight aggregations to complete.
+func (s *Service) StopSyncCommitteeAggregations(ctx context.Context) error {
+	if s.syncCommitteeAggregator == nil {
+		return nil
+	}
+
+	return s.syncCommitteeAggregator.Stop(ctx)
+}
+
 func obtainSpecValues(ctx context.Context,
 	specProvider eth2client.SpecProvider,
 ) (
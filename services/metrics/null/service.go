@@ -52,6 +52,9 @@ func (*Service) NewEpoch() {}
 // BlockDelay provides the delay between the start of a slot and vouch receiving its block.
 func (*Service) BlockDelay(_ uint, _ time.Duration) {}
 
+// NextProposal provides the slot of the next proposal by a managed validator.
+func (*Service) NextProposal(_ phase0.Slot) {}
+
 // BeaconBlockProposalCompleted is called when a block proposal process has completed.
 func (*Service) BeaconBlockProposalCompleted(_ time.Time, _ phase0.Slot, _ string) {}
 
@@ -81,6 +84,15 @@ func (*Service) BeaconCommitteeAggregators(_ int) {}
 // Accounts sets the number of accounts in a given state.
 func (*Service) Accounts(_ string, _ uint64) {}
 
+// AccountSlashed is called when a managed account is first found to have been slashed.
+func (*Service) AccountSlashed() {}
+
+// WalletAccounts sets the number of accounts in a given load state for a wallet.
+func (*Service) WalletAccounts(_ string, _ string, _ uint64) {}
+
+// ValidatorIndexChanged is called when a beacon node reports a different index for a known validator.
+func (*Service) ValidatorIndexChanged() {}
+
 // ClientOperation provides a generic monitor for client operations.
 func (*Service) ClientOperation(_ string, _ string, _ bool, _ time.Duration) {
 }
@@ -97,10 +109,53 @@ func (*Service) SyncCommitteeAggregationsCompleted(_ time.Time, _ phase0.Slot, _
 func (*Service) SyncCommitteeAggregationCoverage(_ float64) {
 }
 
+// SyncCommitteeContributionRejected is called when a contribution returned by a beacon node is rejected.
+func (*Service) SyncCommitteeContributionRejected(_ string) {
+}
+
+// SyncCommitteeContributionsSubmittedAfterDeadline is called when contributions are submitted after the submission deadline.
+func (*Service) SyncCommitteeContributionsSubmittedAfterDeadline() {
+}
+
+// SyncCommitteeContributionFetchTimedOut is called when fetching contributions does not complete before the fetch deadline.
+func (*Service) SyncCommitteeContributionFetchTimedOut() {
+}
+
+// SyncCommitteeAggregationSignerDegraded is called when the signer used for
+// sync committee contributions becomes degraded or recovers.
+func (*Service) SyncCommitteeAggregationSignerDegraded(_ bool) {
+}
+
+// SyncCommitteeAggregationPeriodCoverage is called with the coverage of the contributions
+// submitted over a sync committee period, once that period has finished.
+func (*Service) SyncCommitteeAggregationPeriodCoverage(_ float64, _ float64, _ float64) {
+}
+
 // SyncCommitteeMessagesCompleted is called when a sync committee message process has completed.
 func (*Service) SyncCommitteeMessagesCompleted(_ time.Time, _ phase0.Slot, _ int, _ string) {
 }
 
+// SyncCommitteeDutyGaps is called when managed validators in the sync committee
+// did not receive sync committee duties for an epoch.
+func (*Service) SyncCommitteeDutyGaps(_ int) {
+}
+
+// SyncCommitteeAggregatorSelections is called when the aggregator selection
+// proofs for a sync committee duty have been checked.
+func (*Service) SyncCommitteeAggregatorSelections(_ float64, _ int, _ int) {
+}
+
+// SyncCommitteeParticipationRewards is called when the sync committee
+// participation for an epoch is complete, with the estimated ratio of
+// participation rewards earned to those available for each validator.
+func (*Service) SyncCommitteeParticipationRewards(_ map[phase0.ValidatorIndex]float64) {
+}
+
+// SyncCommitteeMessageSignerDegraded is called when the signer used for
+// sync committee messages becomes degraded or recovers.
+func (*Service) SyncCommitteeMessageSignerDegraded(_ bool) {
+}
+
 // SyncCommitteeSubscriptionCompleted is called when a sync committee subscription process has completed.
 func (*Service) SyncCommitteeSubscriptionCompleted(_ time.Time, _ string) {
 }
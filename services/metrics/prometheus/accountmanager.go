@@ -24,10 +24,40 @@ func (s *Service) setupAccountManagerMetrics() error {
 		Name:      "accounts_total",
 		Help:      "The number of accounts managed by Vouch.",
 	}, []string{"state"})
-	return prometheus.Register(s.accountManagerAccounts)
+	if err := prometheus.Register(s.accountManagerAccounts); err != nil {
+		return err
+	}
+
+	s.accountManagerSlashedAccounts = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "accountmanager",
+		Name:      "slashed_accounts_total",
+		Help:      "The number of accounts managed by Vouch found to have been slashed.",
+	})
+	if err := prometheus.Register(s.accountManagerSlashedAccounts); err != nil {
+		return err
+	}
+
+	s.accountManagerWalletAccounts = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "accountmanager",
+		Name:      "wallet_accounts",
+		Help:      "The number of accounts in each wallet by load state, as of the last refresh.",
+	}, []string{"wallet", "state"})
+	return prometheus.Register(s.accountManagerWalletAccounts)
 }
 
 // Accounts sets the number of accounts in a given state.
 func (s *Service) Accounts(state string, count uint64) {
 	s.accountManagerAccounts.WithLabelValues(state).Set(float64(count))
 }
+
+// AccountSlashed is called when a managed account is first found to have been slashed.
+func (s *Service) AccountSlashed() {
+	s.accountManagerSlashedAccounts.Inc()
+}
+
+// WalletAccounts sets the number of accounts in a given load state for a wallet.
+func (s *Service) WalletAccounts(wallet string, state string, count uint64) {
+	s.accountManagerWalletAccounts.WithLabelValues(wallet, state).Set(float64(count))
+}
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -49,7 +50,16 @@ func (s *Service) setupControllerMetrics() error {
 			11.1, 11.2, 11.3, 11.4, 11.5, 11.6, 11.7, 11.8, 11.9, 12.0,
 		},
 	}, []string{"epoch_slot"})
-	return prometheus.Register(s.blockReceiptDelay)
+	if err := prometheus.Register(s.blockReceiptDelay); err != nil {
+		return err
+	}
+
+	s.nextProposalSlot = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Name:      "next_proposal_slot",
+		Help:      "The slot of the next proposal by a managed validator.",
+	})
+	return prometheus.Register(s.nextProposalSlot)
 }
 
 // NewEpoch is called when vouch starts processing a new epoch.
@@ -61,3 +71,8 @@ func (s *Service) NewEpoch() {
 func (s *Service) BlockDelay(epochSlot uint, delay time.Duration) {
 	s.blockReceiptDelay.WithLabelValues(fmt.Sprintf("%d", epochSlot)).Observe(delay.Seconds())
 }
+
+// NextProposal provides the slot of the next proposal by a managed validator.
+func (s *Service) NextProposal(slot phase0.Slot) {
+	s.nextProposalSlot.Set(float64(slot))
+}
@@ -29,6 +29,7 @@ import (
 // Service is a metrics service exposing metrics via prometheus.
 type Service struct {
 	chainTime chaintime.Service
+	mux       *http.ServeMux
 
 	schedulerJobsScheduled *prometheus.CounterVec
 	schedulerJobsCancelled *prometheus.CounterVec
@@ -36,6 +37,7 @@ type Service struct {
 
 	epochsProcessed   prometheus.Counter
 	blockReceiptDelay *prometheus.HistogramVec
+	nextProposalSlot  prometheus.Gauge
 
 	attestationProcessTimer      prometheus.Histogram
 	attestationProcessRequests   *prometheus.CounterVec
@@ -50,12 +52,22 @@ type Service struct {
 
 	syncCommitteeMessageProcessTimer      prometheus.Histogram
 	syncCommitteeMessageProcessRequests   *prometheus.CounterVec
+	syncCommitteeMessageDutyGaps          prometheus.Counter
+	syncCommitteeAggregatorSelections     *prometheus.CounterVec
+	syncCommitteeAggregatorSelectionRatio prometheus.Gauge
+	syncCommitteeParticipationRewards     *prometheus.GaugeVec
+	syncCommitteeMessageSignerDegraded    prometheus.Gauge
 	syncCommitteeMessageMarkTimer         prometheus.Histogram
 	syncCommitteeMessageProcessLatestSlot prometheus.Gauge
 
 	syncCommitteeAggregationProcessTimer      prometheus.Histogram
 	syncCommitteeAggregationProcessRequests   *prometheus.CounterVec
 	syncCommitteeAggregationCoverageRatio     prometheus.Histogram
+	syncCommitteeContributionsRejected        *prometheus.CounterVec
+	syncCommitteeContributionsLate            prometheus.Counter
+	syncCommitteeContributionFetchTimeouts    prometheus.Counter
+	syncCommitteeAggregationSignerDegraded    prometheus.Gauge
+	syncCommitteeAggregationPeriodCoverage    *prometheus.GaugeVec
 	syncCommitteeAggregationMarkTimer         prometheus.Histogram
 	syncCommitteeAggregationProcessLatestSlot prometheus.Gauge
 
@@ -68,7 +80,11 @@ type Service struct {
 	syncCommitteeSubscriptionProcessRequests *prometheus.CounterVec
 	syncCommitteeSubscribers                 prometheus.Gauge
 
-	accountManagerAccounts *prometheus.GaugeVec
+	accountManagerAccounts        *prometheus.GaugeVec
+	accountManagerWalletAccounts  *prometheus.GaugeVec
+	accountManagerSlashedAccounts prometheus.Counter
+
+	validatorsManagerIndexChanges prometheus.Counter
 
 	clientOperationCounter   *prometheus.CounterVec
 	clientOperationTimer     *prometheus.HistogramVec
@@ -94,7 +110,10 @@ func New(_ context.Context, params ...Parameter) (*Service, error) {
 
 	s := &Service{
 		chainTime: parameters.chainTime,
+		mux:       http.NewServeMux(),
 	}
+	// Handlers registered with the default mux, such as profiling, continue to be served.
+	s.mux.Handle("/", http.DefaultServeMux)
 
 	if err := s.setupSchedulerMetrics(); err != nil {
 		return nil, errors.Wrap(err, "failed to set up scheduler metrics")
@@ -123,6 +142,9 @@ func New(_ context.Context, params ...Parameter) (*Service, error) {
 	if err := s.setupAccountManagerMetrics(); err != nil {
 		return nil, errors.Wrap(err, "failed to set up account manager metrics")
 	}
+	if err := s.setupValidatorsManagerMetrics(); err != nil {
+		return nil, errors.Wrap(err, "failed to set up validators manager metrics")
+	}
 	if err := s.setupClientMetrics(); err != nil {
 		return nil, errors.Wrap(err, "failed to set up client metrics")
 	}
@@ -131,6 +153,7 @@ func New(_ context.Context, params ...Parameter) (*Service, error) {
 		http.Handle("/metrics", promhttp.Handler())
 		server := &http.Server{
 			Addr:              parameters.address,
+			Handler:           s.mux,
 			ReadHeaderTimeout: 5 * time.Second,
 		}
 		if err := server.ListenAndServe(); err != nil {
@@ -141,6 +164,11 @@ func New(_ context.Context, params ...Parameter) (*Service, error) {
 	return s, nil
 }
 
+// Handle registers an additional handler with the metrics server.
+func (s *Service) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
 // Presenter returns the presenter for the events.
 func (*Service) Presenter() string {
 	return "prometheus"
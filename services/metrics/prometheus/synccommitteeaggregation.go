@@ -86,7 +86,57 @@ func (s *Service) setupSyncCommitteeAggregationMetrics() error {
 		Help:      "The ratio of included to possible messages in the aggregate.",
 		Buckets:   []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1.0},
 	})
-	return prometheus.Register(s.syncCommitteeAggregationCoverageRatio)
+	if err := prometheus.Register(s.syncCommitteeAggregationCoverageRatio); err != nil {
+		return err
+	}
+
+	s.syncCommitteeContributionsRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "synccommitteeaggregation",
+		Name:      "contributions_rejected_total",
+		Help:      "The number of sync committee contributions rejected due to inconsistent data.",
+	}, []string{"reason"})
+	if err := prometheus.Register(s.syncCommitteeContributionsRejected); err != nil {
+		return err
+	}
+
+	s.syncCommitteeContributionsLate = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "synccommitteeaggregation",
+		Name:      "submitted_after_deadline_total",
+		Help:      "The number of sync committee contribution submissions that completed after the submission deadline.",
+	})
+	if err := prometheus.Register(s.syncCommitteeContributionsLate); err != nil {
+		return err
+	}
+
+	s.syncCommitteeContributionFetchTimeouts = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "synccommitteeaggregation",
+		Name:      "contribution_fetch_timeouts_total",
+		Help:      "The number of sync committee aggregation processes that did not fetch all contributions before the fetch deadline.",
+	})
+	if err := prometheus.Register(s.syncCommitteeContributionFetchTimeouts); err != nil {
+		return err
+	}
+
+	s.syncCommitteeAggregationSignerDegraded = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "synccommitteeaggregation",
+		Name:      "signer_degraded",
+		Help:      "1 if the signer used for sync committee contributions is degraded, otherwise 0.",
+	})
+	if err := prometheus.Register(s.syncCommitteeAggregationSignerDegraded); err != nil {
+		return err
+	}
+
+	s.syncCommitteeAggregationPeriodCoverage = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "synccommitteeaggregation",
+		Name:      "period_coverage_ratio",
+		Help:      "The coverage of the contributions submitted over the last completed sync committee period.",
+	}, []string{"statistic"})
+	return prometheus.Register(s.syncCommitteeAggregationPeriodCoverage)
 }
 
 // SyncCommitteeAggregationsCompleted is called when a sync committee aggregation process has completed.
@@ -108,3 +158,36 @@ func (s *Service) SyncCommitteeAggregationsCompleted(started time.Time, slot pha
 func (s *Service) SyncCommitteeAggregationCoverage(frac float64) {
 	s.syncCommitteeAggregationCoverageRatio.Observe(frac)
 }
+
+// SyncCommitteeContributionRejected is called when a contribution returned by a beacon node is rejected.
+func (s *Service) SyncCommitteeContributionRejected(reason string) {
+	s.syncCommitteeContributionsRejected.WithLabelValues(reason).Inc()
+}
+
+// SyncCommitteeContributionsSubmittedAfterDeadline is called when contributions are submitted after the submission deadline.
+func (s *Service) SyncCommitteeContributionsSubmittedAfterDeadline() {
+	s.syncCommitteeContributionsLate.Inc()
+}
+
+// SyncCommitteeContributionFetchTimedOut is called when fetching contributions does not complete before the fetch deadline.
+func (s *Service) SyncCommitteeContributionFetchTimedOut() {
+	s.syncCommitteeContributionFetchTimeouts.Inc()
+}
+
+// SyncCommitteeAggregationSignerDegraded is called when the signer used for
+// sync committee contributions becomes degraded or recovers.
+func (s *Service) SyncCommitteeAggregationSignerDegraded(degraded bool) {
+	if degraded {
+		s.syncCommitteeAggregationSignerDegraded.Set(1)
+	} else {
+		s.syncCommitteeAggregationSignerDegraded.Set(0)
+	}
+}
+
+// SyncCommitteeAggregationPeriodCoverage is called with the coverage of the contributions
+// submitted over a sync committee period, once that period has finished.
+func (s *Service) SyncCommitteeAggregationPeriodCoverage(mean float64, minimum float64, maximum float64) {
+	s.syncCommitteeAggregationPeriodCoverage.WithLabelValues("mean").Set(mean)
+	s.syncCommitteeAggregationPeriodCoverage.WithLabelValues("min").Set(minimum)
+	s.syncCommitteeAggregationPeriodCoverage.WithLabelValues("max").Set(maximum)
+}
@@ -14,6 +14,7 @@
 package prometheus
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -75,7 +76,57 @@ func (s *Service) setupSyncCommitteeMessageMetrics() error {
 		Name:      "requests_total",
 		Help:      "The number of sync committee message processes.",
 	}, []string{"result"})
-	return prometheus.Register(s.syncCommitteeMessageProcessRequests)
+	if err := prometheus.Register(s.syncCommitteeMessageProcessRequests); err != nil {
+		return err
+	}
+
+	s.syncCommitteeMessageDutyGaps = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "synccommitteemessage",
+		Name:      "duty_gaps_total",
+		Help:      "The number of times a managed sync committee member did not receive a duty for an epoch.",
+	})
+	if err := prometheus.Register(s.syncCommitteeMessageDutyGaps); err != nil {
+		return err
+	}
+
+	s.syncCommitteeAggregatorSelections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "synccommitteemessage",
+		Name:      "aggregator_selections_total",
+		Help:      "The number of sync committee aggregator selection proofs checked.",
+	}, []string{"result"})
+	if err := prometheus.Register(s.syncCommitteeAggregatorSelections); err != nil {
+		return err
+	}
+
+	s.syncCommitteeAggregatorSelectionRatio = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "synccommitteemessage",
+		Name:      "aggregator_selection_expected_ratio",
+		Help:      "The expected probability that a sync committee aggregator selection proof is selected.",
+	})
+	if err := prometheus.Register(s.syncCommitteeAggregatorSelectionRatio); err != nil {
+		return err
+	}
+
+	s.syncCommitteeParticipationRewards = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "synccommitteemessage",
+		Name:      "participation_reward_ratio",
+		Help:      "The estimated ratio of sync committee participation rewards earned to those available for each validator, for the last complete epoch.",
+	}, []string{"validator"})
+	if err := prometheus.Register(s.syncCommitteeParticipationRewards); err != nil {
+		return err
+	}
+
+	s.syncCommitteeMessageSignerDegraded = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vouch",
+		Subsystem: "synccommitteemessage",
+		Name:      "signer_degraded",
+		Help:      "1 if the signer used for sync committee messages is degraded, otherwise 0.",
+	})
+	return prometheus.Register(s.syncCommitteeMessageSignerDegraded)
 }
 
 // SyncCommitteeMessagesCompleted is called when a sync committee message process has completed.
@@ -92,3 +143,39 @@ func (s *Service) SyncCommitteeMessagesCompleted(started time.Time, slot phase0.
 	}
 	s.syncCommitteeMessageProcessRequests.WithLabelValues(result).Add(float64(count))
 }
+
+// SyncCommitteeDutyGaps is called when managed validators in the sync committee
+// did not receive sync committee duties for an epoch.
+func (s *Service) SyncCommitteeDutyGaps(count int) {
+	s.syncCommitteeMessageDutyGaps.Add(float64(count))
+}
+
+// SyncCommitteeAggregatorSelections is called when the aggregator selection
+// proofs for a sync committee duty have been checked, with the expected
+// probability of selection for each proof.
+func (s *Service) SyncCommitteeAggregatorSelections(expected float64, checked int, selected int) {
+	s.syncCommitteeAggregatorSelectionRatio.Set(expected)
+	s.syncCommitteeAggregatorSelections.WithLabelValues("selected").Add(float64(selected))
+	s.syncCommitteeAggregatorSelections.WithLabelValues("not_selected").Add(float64(checked - selected))
+}
+
+// SyncCommitteeParticipationRewards is called when the sync committee
+// participation for an epoch is complete, with the estimated ratio of
+// participation rewards earned to those available for each validator.
+func (s *Service) SyncCommitteeParticipationRewards(rewards map[phase0.ValidatorIndex]float64) {
+	// Validators leave the sync committee, so remove those from earlier epochs.
+	s.syncCommitteeParticipationRewards.Reset()
+	for index, reward := range rewards {
+		s.syncCommitteeParticipationRewards.WithLabelValues(strconv.FormatUint(uint64(index), 10)).Set(reward)
+	}
+}
+
+// SyncCommitteeMessageSignerDegraded is called when the signer used for
+// sync committee messages becomes degraded or recovers.
+func (s *Service) SyncCommitteeMessageSignerDegraded(degraded bool) {
+	if degraded {
+		s.syncCommitteeMessageSignerDegraded.Set(1)
+	} else {
+		s.syncCommitteeMessageSignerDegraded.Set(0)
+	}
+}
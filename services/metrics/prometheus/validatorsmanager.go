@@ -0,0 +1,33 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func (s *Service) setupValidatorsManagerMetrics() error {
+	s.validatorsManagerIndexChanges = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "validatorsmanager",
+		Name:      "index_changes_total",
+		Help:      "The number of times a beacon node reported a changed index for a known validator.",
+	})
+	return prometheus.Register(s.validatorsManagerIndexChanges)
+}
+
+// ValidatorIndexChanged is called when a beacon node reports a different index for a known validator.
+func (s *Service) ValidatorIndexChanged() {
+	s.validatorsManagerIndexChanges.Inc()
+}
@@ -15,6 +15,7 @@
 package metrics
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -26,6 +27,12 @@ type Service interface {
 	Presenter() string
 }
 
+// HandlerRegistrar provides methods to serve additional information alongside metrics.
+type HandlerRegistrar interface {
+	// Handle registers an additional handler with the metrics server.
+	Handle(pattern string, handler http.Handler)
+}
+
 // SchedulerMonitor provides methods to monitor the scheduler service.
 type SchedulerMonitor interface {
 	// JobScheduled is called when a job is scheduled.
@@ -44,6 +51,8 @@ type ControllerMonitor interface {
 	NewEpoch()
 	// BlockDelay provides the delay between the start of a slot and vouch receiving its block.
 	BlockDelay(epochSlot uint, delay time.Duration)
+	// NextProposal provides the slot of the next proposal by a managed validator.
+	NextProposal(slot phase0.Slot)
 }
 
 // BeaconBlockProposalMonitor provides methods to monitor the block proposal process.
@@ -74,6 +83,24 @@ type AttestationAggregationMonitor interface {
 type SyncCommitteeMessageMonitor interface {
 	// SyncCommitteeMessagesCompleted is called when a sync committee message process has completed.
 	SyncCommitteeMessagesCompleted(started time.Time, slot phase0.Slot, count int, result string)
+
+	// SyncCommitteeDutyGaps is called when managed validators in the sync committee
+	// did not receive sync committee duties for an epoch.
+	SyncCommitteeDutyGaps(count int)
+
+	// SyncCommitteeAggregatorSelections is called when the aggregator selection
+	// proofs for a sync committee duty have been checked, with the expected
+	// probability of selection for each proof.
+	SyncCommitteeAggregatorSelections(expected float64, checked int, selected int)
+
+	// SyncCommitteeParticipationRewards is called when the sync committee
+	// participation for an epoch is complete, with the estimated ratio of
+	// participation rewards earned to those available for each validator.
+	SyncCommitteeParticipationRewards(rewards map[phase0.ValidatorIndex]float64)
+
+	// SyncCommitteeMessageSignerDegraded is called when the signer used for
+	// sync committee messages becomes degraded or recovers.
+	SyncCommitteeMessageSignerDegraded(degraded bool)
 }
 
 // SyncCommitteeAggregationMonitor provides methods to monitor the sync committee aggregation process.
@@ -83,6 +110,23 @@ type SyncCommitteeAggregationMonitor interface {
 
 	// SyncCommitteeAggregationCoverage measures the contribution ratio of the sync committee aggregation.
 	SyncCommitteeAggregationCoverage(frac float64)
+
+	// SyncCommitteeContributionRejected is called when a contribution returned by a beacon node is rejected.
+	SyncCommitteeContributionRejected(reason string)
+
+	// SyncCommitteeContributionsSubmittedAfterDeadline is called when contributions are submitted after the submission deadline.
+	SyncCommitteeContributionsSubmittedAfterDeadline()
+
+	// SyncCommitteeContributionFetchTimedOut is called when fetching contributions does not complete before the fetch deadline.
+	SyncCommitteeContributionFetchTimedOut()
+
+	// SyncCommitteeAggregationSignerDegraded is called when the signer used for
+	// sync committee contributions becomes degraded or recovers.
+	SyncCommitteeAggregationSignerDegraded(degraded bool)
+
+	// SyncCommitteeAggregationPeriodCoverage is called with the coverage of the contributions
+	// submitted over a sync committee period, once that period has finished.
+	SyncCommitteeAggregationPeriodCoverage(mean float64, minimum float64, maximum float64)
 }
 
 // BeaconCommitteeSubscriptionMonitor provides methods to monitor the outcome of beacon committee subscriptions.
@@ -107,6 +151,10 @@ type SyncCommitteeSubscriptionMonitor interface {
 type AccountManagerMonitor interface {
 	// Accounts sets the number of accounts in a given state.
 	Accounts(state string, count uint64)
+	// AccountSlashed is called when a managed account is first found to have been slashed.
+	AccountSlashed()
+	// WalletAccounts sets the number of accounts in a given load state for a wallet.
+	WalletAccounts(wallet string, state string, count uint64)
 }
 
 // ClientMonitor provides methods to monitor client connections.
@@ -118,7 +166,10 @@ type ClientMonitor interface {
 }
 
 // ValidatorsManagerMonitor provides methods to monitor the validators manager.
-type ValidatorsManagerMonitor interface{}
+type ValidatorsManagerMonitor interface {
+	// ValidatorIndexChanged is called when a beacon node reports a different index for a known validator.
+	ValidatorIndexChanged()
+}
 
 // SignerMonitor provides methods to monitor signers.
 type SignerMonitor interface{}
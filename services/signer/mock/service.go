@@ -126,6 +126,17 @@ func (*Service) SignContributionAndProof(_ context.Context,
 	return phase0.BLSSignature{}, nil
 }
 
+// SignContributionAndProofs signs multiple sync committee contributions.
+func (*Service) SignContributionAndProofs(_ context.Context,
+	accounts []e2wtypes.Account,
+	_ []*altair.ContributionAndProof,
+) (
+	[]phase0.BLSSignature,
+	error,
+) {
+	return make([]phase0.BLSSignature, len(accounts)), nil
+}
+
 // SignSyncCommitteeRoot returns a root signature.
 // This signs a beacon block root with the "sync committee" domain.
 func (*Service) SignSyncCommitteeRoot(_ context.Context,
@@ -152,6 +163,19 @@ func (*Service) SignSyncCommitteeSelection(_ context.Context,
 	return phase0.BLSSignature{}, nil
 }
 
+// SignSyncCommitteeSelections returns multiple sync committee selection signatures.
+// This signs slot and subcommittee pairs with the "sync committee selection proof" domain.
+func (*Service) SignSyncCommitteeSelections(_ context.Context,
+	accounts []e2wtypes.Account,
+	_ phase0.Slot,
+	_ []uint64,
+) (
+	[]phase0.BLSSignature,
+	error,
+) {
+	return make([]phase0.BLSSignature, len(accounts)), nil
+}
+
 // SignValidatorRegistration signs a validator registration.
 func (*Service) SignValidatorRegistration(_ context.Context,
 	_ e2wtypes.Account,
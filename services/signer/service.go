@@ -145,6 +145,23 @@ type SyncCommitteeSelectionSigner interface {
 	)
 }
 
+// SyncCommitteeSelectionsSigner provides methods to sign multiple sync committee selections.
+type SyncCommitteeSelectionsSigner interface {
+	// SignSyncCommitteeSelections returns multiple sync committee selection signatures.
+	// This signs slot and subcommittee pairs with the "sync committee selection proof" domain.
+	// It is a convenience wrapper rather than a batch operation: the selections
+	// are signed individually, so the number of requests to a remote signer is
+	// not reduced.
+	SignSyncCommitteeSelections(ctx context.Context,
+		accounts []e2wtypes.Account,
+		slot phase0.Slot,
+		subcommitteeIndices []uint64,
+	) (
+		[]phase0.BLSSignature,
+		error,
+	)
+}
+
 // ContributionAndProofSigner provides methods to sign contribution and proofs.
 type ContributionAndProofSigner interface {
 	// SignContributionAndProof signs a sync committee contribution for given slot and root.
@@ -157,6 +174,22 @@ type ContributionAndProofSigner interface {
 	)
 }
 
+// ContributionAndProofsSigner provides methods to sign multiple contribution and proofs.
+type ContributionAndProofsSigner interface {
+	// SignContributionAndProofs signs multiple sync committee contributions.
+	// Signatures are returned in the same order as the contribution and proofs.
+	// It is a convenience wrapper rather than a batch operation: the
+	// contributions are signed individually, so the number of requests to a
+	// remote signer is not reduced.
+	SignContributionAndProofs(ctx context.Context,
+		accounts []e2wtypes.Account,
+		contributionAndProofs []*altair.ContributionAndProof,
+	) (
+		[]phase0.BLSSignature,
+		error,
+	)
+}
+
 // ValidatorRegistrationSigner provides methods to sign validator registrations.
 type ValidatorRegistrationSigner interface {
 	// SignValidatorRegistration signs a validator registration.
@@ -168,3 +201,13 @@ type ValidatorRegistrationSigner interface {
 		error,
 	)
 }
+
+// SelfChecker provides methods to check that the signer is usable.
+type SelfChecker interface {
+	// SelfCheck checks that the signer can sign for the given account.
+	// It never carries out a slashable signing operation.
+	SelfCheck(ctx context.Context,
+		account e2wtypes.Account,
+		epoch phase0.Epoch,
+	) error
+}
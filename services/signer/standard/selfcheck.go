@@ -0,0 +1,78 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	e2types "github.com/wealdtech/go-eth2-types/v2"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+	"go.opentelemetry.io/otel"
+)
+
+// selfCheckRoot is the root signed by the self check.  It is not the root of
+// any beacon block, so the resultant signature cannot be used on chain.
+var selfCheckRoot = phase0.Root{
+	'v', 'o', 'u', 'c', 'h', ' ', 's', 'i', 'g', 'n', 'e', 'r', ' ', 's', 'e', 'l',
+	'f', ' ', 'c', 'h', 'e', 'c', 'k', 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+}
+
+// SelfCheck checks that the signer can sign for the given account, by
+// signing a throwaway root and verifying the resultant signature.
+// The root is signed with the sync committee domain, as signatures with this
+// domain are never slashable.
+func (s *Service) SelfCheck(ctx context.Context,
+	account e2wtypes.Account,
+	epoch phase0.Epoch,
+) error {
+	ctx, span := otel.Tracer("attestantio.vouch.services.signer.standard").Start(ctx, "SelfCheck")
+	defer span.End()
+
+	sig, err := s.SignSyncCommitteeRoot(ctx, account, epoch, selfCheckRoot)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign")
+	}
+
+	if s.syncCommitteeDomainType == nil {
+		return errors.New("no sync committee domain type available; cannot verify")
+	}
+	domain, err := s.domainProvider.Domain(ctx, *s.syncCommitteeDomainType, epoch)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain signature domain for sync committee")
+	}
+	container := phase0.SigningData{
+		ObjectRoot: selfCheckRoot,
+		Domain:     domain,
+	}
+	signingRoot, err := container.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate hash tree root")
+	}
+
+	signature, err := e2types.BLSSignatureFromBytes(sig[:])
+	if err != nil {
+		return errors.Wrap(err, "invalid signature")
+	}
+	pubKey := account.PublicKey()
+	if provider, isProvider := account.(e2wtypes.AccountCompositePublicKeyProvider); isProvider {
+		pubKey = provider.CompositePublicKey()
+	}
+	if !signature.Verify(signingRoot[:], pubKey) {
+		return errors.New("signature does not verify against account public key")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SignContributionAndProofs signs multiple sync committee contributions.
+// Signatures are returned in the same order as the contribution and proofs.
+// The wallets only provide multi-signing for attestations, so each contribution
+// and proof is signed individually.
+func (s *Service) SignContributionAndProofs(ctx context.Context,
+	accounts []e2wtypes.Account,
+	contributionAndProofs []*altair.ContributionAndProof,
+) (
+	[]phase0.BLSSignature,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.vouch.services.signer.standard").Start(ctx, "SignContributionAndProofs", trace.WithAttributes(
+		attribute.Int("contributions", len(contributionAndProofs)),
+	))
+	defer span.End()
+
+	if s.contributionAndProofDomainType == nil {
+		return nil, errors.New("no contribution and proof domain type available; cannot sign")
+	}
+	if len(accounts) != len(contributionAndProofs) {
+		return nil, errors.New("mismatch between number of accounts and contribution and proofs")
+	}
+	if len(accounts) == 0 {
+		return []phase0.BLSSignature{}, nil
+	}
+
+	// Contributions are almost always for the same epoch, so only calculate each domain once.
+	domains := make(map[phase0.Epoch]phase0.Domain)
+	sigs := make([]phase0.BLSSignature, len(accounts))
+	for i := range contributionAndProofs {
+		epoch := phase0.Epoch(contributionAndProofs[i].Contribution.Slot / s.slotsPerEpoch)
+		domain, exists := domains[epoch]
+		if !exists {
+			var err error
+			domain, err = s.domainProvider.Domain(ctx, *s.contributionAndProofDomainType, epoch)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to obtain signature domain for contribution and proof")
+			}
+			domains[epoch] = domain
+		}
+
+		root, err := contributionAndProofs[i].HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate hash tree root")
+		}
+
+		sigs[i], err = s.sign(ctx, accounts[i], root, domain)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign contribution and proof")
+		}
+	}
+
+	return sigs, nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SignSyncCommitteeSelections returns multiple sync committee selection signatures.
+// This signs slot and subcommittee pairs with the "sync committee selection proof" domain.
+// The wallets only provide multi-signing for attestations, so each selection is
+// signed individually; only the domain is shared between them.
+func (s *Service) SignSyncCommitteeSelections(ctx context.Context,
+	accounts []e2wtypes.Account,
+	slot phase0.Slot,
+	subcommitteeIndices []uint64,
+) (
+	[]phase0.BLSSignature,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.vouch.services.signer.standard").Start(ctx, "SignSyncCommitteeSelections", trace.WithAttributes(
+		attribute.Int("selections", len(accounts)),
+	))
+	defer span.End()
+
+	if s.syncCommitteeSelectionProofDomainType == nil {
+		return nil, errors.New("no sync committee selection proof domain type, cannot sign")
+	}
+	if len(accounts) != len(subcommitteeIndices) {
+		return nil, errors.New("mismatch between number of accounts and subcommittee indices")
+	}
+	if len(accounts) == 0 {
+		return []phase0.BLSSignature{}, nil
+	}
+
+	// The domain is the same for all selections, so only calculate it once.
+	domain, err := s.domainProvider.Domain(ctx,
+		*s.syncCommitteeSelectionProofDomainType,
+		phase0.Epoch(slot/s.slotsPerEpoch))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain signature domain for sync committee selection proof")
+	}
+
+	sigs := make([]phase0.BLSSignature, len(accounts))
+	for i := range accounts {
+		selectionData := &altair.SyncAggregatorSelectionData{
+			Slot:              slot,
+			SubcommitteeIndex: subcommitteeIndices[i],
+		}
+		root, err := selectionData.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain hash tree root of sync aggregator selection data")
+		}
+
+		sigs[i], err = s.sign(ctx, accounts[i], root, domain)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign sync committee selection proof")
+		}
+	}
+
+	return sigs, nil
+}
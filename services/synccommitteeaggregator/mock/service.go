@@ -34,3 +34,8 @@ func (*Service) SetBeaconBlockRoot(_ phase0.Slot, _ phase0.Root) {
 // Aggregate carries out aggregation for a slot and committee.
 func (*Service) Aggregate(_ context.Context, _ interface{}) {
 }
+
+// Stop stops the service.
+func (*Service) Stop(_ context.Context) error {
+	return nil
+}
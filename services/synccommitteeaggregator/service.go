@@ -42,4 +42,8 @@ type Service interface {
 
 	// Aggregate carries out aggregation for a slot and committee.
 	Aggregate(ctx context.Context, details interface{})
+
+	// Stop stops the service from starting new aggregations, and waits for
+	// in-flight aggregations to complete.
+	Stop(ctx context.Context) error
 }
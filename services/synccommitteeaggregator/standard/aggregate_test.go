@@ -0,0 +1,635 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	mocketh2client "github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	"github.com/attestantio/vouch/services/signer"
+	mocksigner "github.com/attestantio/vouch/services/signer/mock"
+	"github.com/attestantio/vouch/services/synccommitteeaggregator"
+	"github.com/attestantio/vouch/services/synccommitteeaggregator/standard"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// recordingContributionsSubmitter records submitted contributions.
+type recordingContributionsSubmitter struct {
+	submitted []*altair.SignedContributionAndProof
+}
+
+func (r *recordingContributionsSubmitter) SubmitSyncCommitteeContributions(_ context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error {
+	r.submitted = append(r.submitted, contributionAndProofs...)
+	return nil
+}
+
+func TestAggregateContributionConsistency(t *testing.T) {
+	ctx := context.Background()
+
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	root := phase0.Root{0x01}
+	otherRoot := phase0.Root{0x02}
+
+	tests := []struct {
+		name                 string
+		contributionProvider eth2client.SyncCommitteeContributionProvider
+		submitted            int
+		logEntry             string
+	}{
+		{
+			name:                 "Good",
+			contributionProvider: mock.NewSyncCommitteeContributionProvider(),
+			submitted:            1,
+		},
+		{
+			name:                 "MismatchedSlot",
+			contributionProvider: mock.NewMismatchedSyncCommitteeContributionProvider(1, nil),
+			logEntry:             "Returned contribution for incorrect slot; rejecting",
+		},
+		{
+			name:                 "MismatchedBeaconBlockRoot",
+			contributionProvider: mock.NewMismatchedSyncCommitteeContributionProvider(0, &otherRoot),
+			logEntry:             "Returned contribution for incorrect beacon block root; rejecting",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewLogCapture()
+			submitter := &recordingContributionsSubmitter{}
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.TraceLevel),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithSpecProvider(mock.NewSpecProvider()),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithContributionAndProofSigner(mocksigner.New()),
+				standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+				standard.WithSyncCommitteeContributionProvider(test.contributionProvider),
+				standard.WithSyncCommitteeContributionsSubmitter(submitter),
+				standard.WithChainTime(chainTime),
+			)
+			require.NoError(t, err)
+
+			s.SetBeaconBlockRoot(10, root)
+			s.Aggregate(ctx, &synccommitteeaggregator.Duty{
+				Slot:             10,
+				ValidatorIndices: []phase0.ValidatorIndex{1},
+				SelectionProofs: map[phase0.ValidatorIndex]map[uint64]phase0.BLSSignature{
+					1: {0: phase0.BLSSignature{}},
+				},
+			})
+
+			require.Len(t, submitter.submitted, test.submitted)
+			if test.logEntry != "" {
+				capture.AssertHasEntry(t, test.logEntry)
+			}
+		})
+	}
+}
+
+func TestAggregateSubmissionDeadline(t *testing.T) {
+	ctx := context.Background()
+
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	// Genesis a minute ago, so the deadline for slot 1 has already passed.
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now().Add(-time.Minute))),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	capture := logger.NewLogCapture()
+	submitter := &recordingContributionsSubmitter{}
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.TraceLevel),
+		standard.WithMonitor(nullmetrics.New(ctx)),
+		standard.WithSpecProvider(mock.NewSpecProvider()),
+		standard.WithBeaconBlockRootProvider(mockETH2Client),
+		standard.WithContributionAndProofSigner(mocksigner.New()),
+		standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+		standard.WithSyncCommitteeContributionProvider(mock.NewSyncCommitteeContributionProvider()),
+		standard.WithSyncCommitteeContributionsSubmitter(submitter),
+		standard.WithChainTime(chainTime),
+		standard.WithSubmissionDeadline(0.75),
+	)
+	require.NoError(t, err)
+
+	s.SetBeaconBlockRoot(1, phase0.Root{0x01})
+	s.Aggregate(ctx, &synccommitteeaggregator.Duty{
+		Slot:             1,
+		ValidatorIndices: []phase0.ValidatorIndex{1},
+		SelectionProofs: map[phase0.ValidatorIndex]map[uint64]phase0.BLSSignature{
+			1: {0: phase0.BLSSignature{}},
+		},
+	})
+
+	capture.AssertHasEntry(t, "Submission of signed contribution and proofs passed deadline")
+}
+
+// staticBeaconBlockRootProvider returns a fixed beacon block root or error.
+type staticBeaconBlockRootProvider struct {
+	root *phase0.Root
+	err  error
+}
+
+func (p *staticBeaconBlockRootProvider) BeaconBlockRoot(_ context.Context, _ string) (*phase0.Root, error) {
+	return p.root, p.err
+}
+
+func TestAggregateHeadRootFailover(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	root := phase0.Root{0x01}
+	failing := &staticBeaconBlockRootProvider{err: errors.New("unavailable")}
+	empty := &staticBeaconBlockRootProvider{}
+	working := &staticBeaconBlockRootProvider{root: &root}
+
+	tests := []struct {
+		name      string
+		primary   eth2client.BeaconBlockRootProvider
+		fallbacks map[string]eth2client.BeaconBlockRootProvider
+		policy    synccommitteemessenger.BeaconBlockRootPolicy
+		submitted int
+		logEntry  map[string]interface{}
+	}{
+		{
+			name:      "Primary",
+			primary:   working,
+			submitted: 1,
+			logEntry: map[string]interface{}{
+				"message":  "Obtained beacon block root from primary provider",
+				"provider": "primary",
+			},
+		},
+		{
+			name:      "Finalized",
+			primary:   working,
+			policy:    synccommitteemessenger.BeaconBlockRootPolicyFinalized,
+			submitted: 1,
+			logEntry: map[string]interface{}{
+				"message":  "Obtained beacon block root from primary provider",
+				"block_id": "finalized",
+			},
+		},
+		{
+			name:    "Secondary",
+			primary: failing,
+			fallbacks: map[string]eth2client.BeaconBlockRootProvider{
+				"node1": empty,
+				"node2": working,
+			},
+			submitted: 1,
+			logEntry: map[string]interface{}{
+				"message":  "Obtained beacon block root from fallback provider",
+				"provider": "node2",
+			},
+		},
+		{
+			name:    "AllFail",
+			primary: failing,
+			fallbacks: map[string]eth2client.BeaconBlockRootProvider{
+				"node1": failing,
+			},
+			logEntry: map[string]interface{}{
+				"message": "Failed to obtain beacon block root",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewLogCapture()
+			submitter := &recordingContributionsSubmitter{}
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.TraceLevel),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithSpecProvider(mock.NewSpecProvider()),
+				standard.WithBeaconBlockRootProvider(test.primary),
+				standard.WithFallbackBeaconBlockRootProviders(test.fallbacks),
+				standard.WithBeaconBlockRootPolicy(test.policy),
+				standard.WithContributionAndProofSigner(mocksigner.New()),
+				standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+				standard.WithSyncCommitteeContributionProvider(mock.NewSyncCommitteeContributionProvider()),
+				standard.WithSyncCommitteeContributionsSubmitter(submitter),
+				standard.WithChainTime(chainTime),
+			)
+			require.NoError(t, err)
+
+			s.Aggregate(ctx, &synccommitteeaggregator.Duty{
+				Slot:             10,
+				ValidatorIndices: []phase0.ValidatorIndex{1},
+				SelectionProofs: map[phase0.ValidatorIndex]map[uint64]phase0.BLSSignature{
+					1: {0: phase0.BLSSignature{}},
+				},
+			})
+
+			require.Len(t, submitter.submitted, test.submitted)
+			require.True(t, capture.HasLog(test.logEntry), "missing log entry %v", test.logEntry)
+		})
+	}
+}
+
+// blockingContributionsSubmitter blocks submission until released.
+type blockingContributionsSubmitter struct {
+	entered  chan struct{}
+	release  chan struct{}
+	recorder recordingContributionsSubmitter
+}
+
+func (b *blockingContributionsSubmitter) SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error {
+	close(b.entered)
+	<-b.release
+	return b.recorder.SubmitSyncCommitteeContributions(ctx, contributionAndProofs)
+}
+
+func TestStop(t *testing.T) {
+	ctx := context.Background()
+
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	capture := logger.NewLogCapture()
+	submitter := &blockingContributionsSubmitter{
+		entered: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.TraceLevel),
+		standard.WithMonitor(nullmetrics.New(ctx)),
+		standard.WithSpecProvider(mock.NewSpecProvider()),
+		standard.WithBeaconBlockRootProvider(mockETH2Client),
+		standard.WithContributionAndProofSigner(mocksigner.New()),
+		standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+		standard.WithSyncCommitteeContributionProvider(mock.NewSyncCommitteeContributionProvider()),
+		standard.WithSyncCommitteeContributionsSubmitter(submitter),
+		standard.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+
+	duty := func(slot phase0.Slot) *synccommitteeaggregator.Duty {
+		return &synccommitteeaggregator.Duty{
+			Slot:             slot,
+			ValidatorIndices: []phase0.ValidatorIndex{1},
+			SelectionProofs: map[phase0.ValidatorIndex]map[uint64]phase0.BLSSignature{
+				1: {0: phase0.BLSSignature{}},
+			},
+		}
+	}
+
+	// Start an aggregation, and wait for it to reach submission.
+	s.SetBeaconBlockRoot(10, phase0.Root{0x01})
+	aggregated := make(chan struct{})
+	go func() {
+		s.Aggregate(ctx, duty(10))
+		close(aggregated)
+	}()
+	<-submitter.entered
+
+	// Stop should time out whilst the aggregation is in flight.
+	stopCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	require.ErrorContains(t, s.Stop(stopCtx), "failed to wait for in-flight aggregations")
+	cancel()
+
+	// New aggregations should be rejected.
+	s.SetBeaconBlockRoot(11, phase0.Root{0x01})
+	s.Aggregate(ctx, duty(11))
+	capture.AssertHasEntry(t, "Service is draining; not starting aggregation")
+
+	// Once the in-flight aggregation completes Stop should return.
+	close(submitter.release)
+	<-aggregated
+	require.NoError(t, s.Stop(ctx))
+	require.Len(t, submitter.recorder.submitted, 1)
+}
+
+// indexSigner signs contribution and proofs with a signature derived from the
+// aggregator and subcommittee indices, so that signatures can be matched to their
+// contributions.
+type indexSigner struct {
+	singleCalls int
+}
+
+func indexSignature(contributionAndProof *altair.ContributionAndProof) phase0.BLSSignature {
+	return phase0.BLSSignature{byte(contributionAndProof.AggregatorIndex), byte(contributionAndProof.Contribution.SubcommitteeIndex)}
+}
+
+func (s *indexSigner) SignContributionAndProof(_ context.Context,
+	_ e2wtypes.Account,
+	contributionAndProof *altair.ContributionAndProof,
+) (
+	phase0.BLSSignature,
+	error,
+) {
+	s.singleCalls++
+	return indexSignature(contributionAndProof), nil
+}
+
+// batchIndexSigner is an indexSigner that can also sign in batch.
+type batchIndexSigner struct {
+	indexSigner
+	batchCalls int
+}
+
+func (s *batchIndexSigner) SignContributionAndProofs(_ context.Context,
+	accounts []e2wtypes.Account,
+	contributionAndProofs []*altair.ContributionAndProof,
+) (
+	[]phase0.BLSSignature,
+	error,
+) {
+	s.batchCalls++
+	sigs := make([]phase0.BLSSignature, len(accounts))
+	for i := range contributionAndProofs {
+		sigs[i] = indexSignature(contributionAndProofs[i])
+	}
+	return sigs, nil
+}
+
+func TestAggregateBatchSigning(t *testing.T) {
+	ctx := context.Background()
+
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	duty := &synccommitteeaggregator.Duty{
+		Slot:             10,
+		ValidatorIndices: []phase0.ValidatorIndex{1, 2},
+		SelectionProofs: map[phase0.ValidatorIndex]map[uint64]phase0.BLSSignature{
+			1: {0: phase0.BLSSignature{}, 3: phase0.BLSSignature{}},
+			2: {1: phase0.BLSSignature{}},
+		},
+	}
+
+	batchSigner := &batchIndexSigner{}
+	singleSigner := &indexSigner{}
+
+	tests := []struct {
+		name   string
+		signer signer.ContributionAndProofSigner
+	}{
+		{
+			name:   "Batch",
+			signer: batchSigner,
+		},
+		{
+			name:   "Single",
+			signer: singleSigner,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submitter := &recordingContributionsSubmitter{}
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithSpecProvider(mock.NewSpecProvider()),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithContributionAndProofSigner(test.signer),
+				standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+				standard.WithSyncCommitteeContributionProvider(mock.NewSyncCommitteeContributionProvider()),
+				standard.WithSyncCommitteeContributionsSubmitter(submitter),
+				standard.WithChainTime(chainTime),
+			)
+			require.NoError(t, err)
+
+			s.SetBeaconBlockRoot(10, phase0.Root{0x01})
+			s.Aggregate(ctx, duty)
+
+			require.Len(t, submitter.submitted, 3)
+			for _, signed := range submitter.submitted {
+				require.Equal(t, indexSignature(signed.Message), signed.Signature)
+			}
+		})
+	}
+
+	// The batch signer signs everything in a single call.
+	require.Equal(t, 1, batchSigner.batchCalls)
+	require.Equal(t, 0, batchSigner.singleCalls)
+	// The single signer falls back to a call per contribution.
+	require.Equal(t, 3, singleSigner.singleCalls)
+}
+
+// slowSyncCommitteeContributionProvider returns contributions immediately for
+// fast subcommittees, and blocks until the context is done for others.
+type slowSyncCommitteeContributionProvider struct {
+	eth2client.SyncCommitteeContributionProvider
+	fast map[uint64]bool
+}
+
+func (p *slowSyncCommitteeContributionProvider) SyncCommitteeContribution(ctx context.Context,
+	slot phase0.Slot,
+	subcommitteeIndex uint64,
+	beaconBlockRoot phase0.Root,
+) (
+	*altair.SyncCommitteeContribution,
+	error,
+) {
+	if !p.fast[subcommitteeIndex] {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return p.SyncCommitteeContributionProvider.SyncCommitteeContribution(ctx, slot, subcommitteeIndex, beaconBlockRoot)
+}
+
+func TestAggregateContributionFetchDeadline(t *testing.T) {
+	ctx := context.Background()
+
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	// Genesis a slot ago, so slot 1 starts now.
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now().Add(-12*time.Second))),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		fast      map[uint64]bool
+		submitted int
+		logEntry  string
+	}{
+		{
+			name:      "Partial",
+			fast:      map[uint64]bool{0: true},
+			submitted: 1,
+			logEntry:  "Contribution fetch deadline passed; submitting contributions obtained so far",
+		},
+		{
+			name:     "None",
+			fast:     map[uint64]bool{},
+			logEntry: "No contributions obtained before fetch deadline; nothing to submit",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewLogCapture()
+			submitter := &recordingContributionsSubmitter{}
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.TraceLevel),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithSpecProvider(mock.NewSpecProvider()),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithContributionAndProofSigner(mocksigner.New()),
+				standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+				standard.WithSyncCommitteeContributionProvider(&slowSyncCommitteeContributionProvider{
+					SyncCommitteeContributionProvider: mock.NewSyncCommitteeContributionProvider(),
+					fast:                              test.fast,
+				}),
+				standard.WithSyncCommitteeContributionsSubmitter(submitter),
+				standard.WithChainTime(chainTime),
+				standard.WithContributionFetchDeadline(0.05),
+			)
+			require.NoError(t, err)
+
+			s.SetBeaconBlockRoot(1, phase0.Root{0x01})
+			s.Aggregate(ctx, &synccommitteeaggregator.Duty{
+				Slot:             1,
+				ValidatorIndices: []phase0.ValidatorIndex{1, 2},
+				SelectionProofs: map[phase0.ValidatorIndex]map[uint64]phase0.BLSSignature{
+					1: {0: phase0.BLSSignature{}},
+					2: {1: phase0.BLSSignature{}},
+				},
+			})
+
+			require.Len(t, submitter.submitted, test.submitted)
+			capture.AssertHasEntry(t, test.logEntry)
+		})
+	}
+}
+
+// slowContributionAndProofSigner is a contribution and proof signer that
+// takes time to sign.
+type slowContributionAndProofSigner struct {
+	delay time.Duration
+}
+
+func (s *slowContributionAndProofSigner) SignContributionAndProof(_ context.Context,
+	_ e2wtypes.Account,
+	_ *altair.ContributionAndProof,
+) (
+	phase0.BLSSignature,
+	error,
+) {
+	time.Sleep(s.delay)
+	return phase0.BLSSignature{}, nil
+}
+
+// signerDegradedMonitor records the signer degradation signals reported.
+type signerDegradedMonitor struct {
+	*nullmetrics.Service
+	signals []bool
+}
+
+func (m *signerDegradedMonitor) SyncCommitteeAggregationSignerDegraded(degraded bool) {
+	m.signals = append(m.signals, degraded)
+}
+
+func TestAggregateSignerLatency(t *testing.T) {
+	ctx := context.Background()
+
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	capture := logger.NewLogCapture()
+	monitor := &signerDegradedMonitor{Service: nullmetrics.New(ctx)}
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.TraceLevel),
+		standard.WithMonitor(monitor),
+		standard.WithSpecProvider(mock.NewSpecProvider()),
+		standard.WithBeaconBlockRootProvider(mockETH2Client),
+		standard.WithContributionAndProofSigner(&slowContributionAndProofSigner{delay: 50 * time.Millisecond}),
+		standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+		standard.WithSyncCommitteeContributionProvider(mock.NewSyncCommitteeContributionProvider()),
+		standard.WithSyncCommitteeContributionsSubmitter(&recordingContributionsSubmitter{}),
+		standard.WithChainTime(chainTime),
+		standard.WithSignerLatencyThreshold(20*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	s.SetBeaconBlockRoot(10, phase0.Root{0x01})
+	s.Aggregate(ctx, &synccommitteeaggregator.Duty{
+		Slot:             10,
+		ValidatorIndices: []phase0.ValidatorIndex{1, 2},
+		SelectionProofs: map[phase0.ValidatorIndex]map[uint64]phase0.BLSSignature{
+			1: {0: phase0.BLSSignature{}},
+			2: {1: phase0.BLSSignature{}},
+		},
+	})
+
+	// The slow signer is reported as degraded, once.
+	require.Equal(t, []bool{true}, monitor.signals)
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"message": "Signer latency above threshold; signer degraded",
+		"duty":    "sync committee contributions",
+	}))
+}
@@ -0,0 +1,74 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// coverageStats are the running statistics of coverage for a period.
+type coverageStats struct {
+	contributions int
+	total         float64
+	min           float64
+	max           float64
+}
+
+// mean returns the mean coverage of the contributions.
+func (c *coverageStats) mean() float64 {
+	return c.total / float64(c.contributions)
+}
+
+// recordCoverage records the coverage of a contribution submitted for the given slot.
+// The first contribution of a period reports the coverage of the previous period.
+func (s *Service) recordCoverage(slot phase0.Slot, coverage float64) {
+	period := uint64(s.chainTime.SlotToEpoch(slot)) / s.epochsPerSyncCommitteePeriod
+
+	s.coverageMu.Lock()
+	defer s.coverageMu.Unlock()
+
+	stats, exists := s.coverage[period]
+	if !exists {
+		if previous, exists := s.coverage[period-1]; exists {
+			log.Info().
+				Uint64("period", period-1).
+				Int("contributions", previous.contributions).
+				Float64("mean", previous.mean()).
+				Float64("min", previous.min).
+				Float64("max", previous.max).
+				Msg("Sync committee contribution coverage for period")
+			s.monitor.SyncCommitteeAggregationPeriodCoverage(previous.mean(), previous.min, previous.max)
+		}
+		stats = &coverageStats{
+			min: coverage,
+			max: coverage,
+		}
+		s.coverage[period] = stats
+		// Retain the previous period, in case of late contributions.
+		for existing := range s.coverage {
+			if existing+1 < period {
+				delete(s.coverage, existing)
+			}
+		}
+	}
+
+	stats.contributions++
+	stats.total += coverage
+	if coverage < stats.min {
+		stats.min = coverage
+	}
+	if coverage > stats.max {
+		stats.max = coverage
+	}
+}
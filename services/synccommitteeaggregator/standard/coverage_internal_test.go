@@ -0,0 +1,88 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/vouch/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// periodCoverageMonitor records the period coverage reported.
+type periodCoverageMonitor struct {
+	*nullmetrics.Service
+	reports [][3]float64
+}
+
+func (m *periodCoverageMonitor) SyncCommitteeAggregationPeriodCoverage(mean float64, minimum float64, maximum float64) {
+	m.reports = append(m.reports, [3]float64{mean, minimum, maximum})
+}
+
+func TestRecordCoverage(t *testing.T) {
+	ctx := context.Background()
+
+	capture := logger.NewModuleLogCapture(t, &log)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithLogLevel(zerolog.Disabled),
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	monitor := &periodCoverageMonitor{Service: nullmetrics.New(ctx)}
+	s := &Service{
+		monitor:                      monitor,
+		chainTime:                    chainTime,
+		epochsPerSyncCommitteePeriod: 2,
+		coverage:                     make(map[uint64]*coverageStats),
+	}
+
+	// Period 0 covers slots 0 through 63.
+	s.recordCoverage(1, 0.5)
+	s.recordCoverage(2, 1.0)
+	s.recordCoverage(63, 0.75)
+	require.Empty(t, monitor.reports)
+	require.False(t, capture.HasLog(map[string]interface{}{
+		"message": "Sync committee contribution coverage for period",
+	}))
+
+	// Moving to period 1 reports period 0.
+	s.recordCoverage(64, 0.25)
+	require.Equal(t, [][3]float64{{0.75, 0.5, 1.0}}, monitor.reports)
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"message":       "Sync committee contribution coverage for period",
+		"period":        float64(0),
+		"contributions": float64(3),
+		"mean":          0.75,
+	}))
+
+	// Late contributions for the previous period are not reported again.
+	s.recordCoverage(60, 0.5)
+	require.Len(t, monitor.reports, 1)
+	require.Len(t, s.coverage, 2)
+
+	// Skipping a period reports nothing, and drops older periods.
+	s.recordCoverage(192, 0.5)
+	require.Len(t, monitor.reports, 1)
+	require.Len(t, s.coverage, 1)
+}
@@ -0,0 +1,82 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sort"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// namedBeaconBlockRootProvider is a beacon block root provider with its address.
+type namedBeaconBlockRootProvider struct {
+	address  string
+	provider eth2client.BeaconBlockRootProvider
+}
+
+// sortBeaconBlockRootProviders returns the providers ordered by address, to give a stable failover order.
+func sortBeaconBlockRootProviders(providers map[string]eth2client.BeaconBlockRootProvider) []*namedBeaconBlockRootProvider {
+	res := make([]*namedBeaconBlockRootProvider, 0, len(providers))
+	for address, provider := range providers {
+		res = append(res, &namedBeaconBlockRootProvider{
+			address:  address,
+			provider: provider,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].address < res[j].address })
+
+	return res
+}
+
+// policyBeaconBlockRoot obtains the beacon block root selected by the beacon
+// block root policy, trying the fallback providers in turn if the primary
+// provider is unable to supply it.
+func (s *Service) policyBeaconBlockRoot(ctx context.Context, log zerolog.Logger) (*phase0.Root, error) {
+	blockID := s.beaconBlockRootPolicy.BlockID()
+	root, err := fetchBeaconBlockRoot(ctx, s.beaconBlockRootProvider, blockID)
+	if err == nil {
+		log.Trace().Str("provider", "primary").Str("block_id", blockID).Msg("Obtained beacon block root from primary provider")
+		return root, nil
+	}
+	log.Debug().Str("block_id", blockID).Err(err).Msg("Failed to obtain beacon block root from primary provider")
+
+	for _, fallback := range s.fallbackBeaconBlockRootProviders {
+		root, err = fetchBeaconBlockRoot(ctx, fallback.provider, blockID)
+		if err != nil {
+			log.Debug().Str("provider", fallback.address).Str("block_id", blockID).Err(err).Msg("Failed to obtain beacon block root from fallback provider")
+			continue
+		}
+		log.Debug().Str("provider", fallback.address).Str("block_id", blockID).Msg("Obtained beacon block root from fallback provider")
+		return root, nil
+	}
+
+	return nil, errors.Errorf("no provider supplied %s beacon block root", blockID)
+}
+
+// fetchBeaconBlockRoot obtains the beacon block root for the given block ID from a single provider.
+func fetchBeaconBlockRoot(ctx context.Context, provider eth2client.BeaconBlockRootProvider, blockID string) (*phase0.Root, error) {
+	root, err := provider.BeaconBlockRoot(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, errors.New("returned empty beacon block root")
+	}
+
+	return root, nil
+}
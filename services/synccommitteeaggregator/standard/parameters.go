@@ -14,11 +14,15 @@
 package standard
 
 import (
+	"time"
+
 	eth2client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/vouch/services/accountmanager"
+	"github.com/attestantio/vouch/services/chaintime"
 	"github.com/attestantio/vouch/services/metrics"
 	"github.com/attestantio/vouch/services/signer"
 	"github.com/attestantio/vouch/services/submitter"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
@@ -28,10 +32,16 @@ type parameters struct {
 	monitor                             metrics.SyncCommitteeAggregationMonitor
 	specProvider                        eth2client.SpecProvider
 	beaconBlockRootProvider             eth2client.BeaconBlockRootProvider
+	fallbackBeaconBlockRootProviders    map[string]eth2client.BeaconBlockRootProvider
 	contributionAndProofSigner          signer.ContributionAndProofSigner
 	validatingAccountsProvider          accountmanager.ValidatingAccountsProvider
 	syncCommitteeContributionProvider   eth2client.SyncCommitteeContributionProvider
 	syncCommitteeContributionsSubmitter submitter.SyncCommitteeContributionsSubmitter
+	chainTime                           chaintime.Service
+	submissionDeadline                  float64
+	contributionFetchDeadline           float64
+	beaconBlockRootPolicy               synccommitteemessenger.BeaconBlockRootPolicy
+	signerLatencyThreshold              time.Duration
 }
 
 // Parameter is the interface for service parameters.
@@ -73,6 +83,14 @@ func WithBeaconBlockRootProvider(provider eth2client.BeaconBlockRootProvider) Pa
 	})
 }
 
+// WithFallbackBeaconBlockRootProviders sets the beacon block root providers to try,
+// in address order, if the beacon block root provider fails to provide the head root.
+func WithFallbackBeaconBlockRootProviders(providers map[string]eth2client.BeaconBlockRootProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.fallbackBeaconBlockRootProviders = providers
+	})
+}
+
 // WithContributionAndProofSigner sets the contribution and proof submitter.
 func WithContributionAndProofSigner(signer signer.ContributionAndProofSigner) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -101,10 +119,51 @@ func WithSyncCommitteeContributionsSubmitter(submitter submitter.SyncCommitteeCo
 	})
 }
 
+// WithChainTime sets the chaintime service.
+func WithChainTime(service chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = service
+	})
+}
+
+// WithSubmissionDeadline sets the deadline for submitting contributions, as a fraction of the way through the slot.
+func WithSubmissionDeadline(deadline float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.submissionDeadline = deadline
+	})
+}
+
+// WithContributionFetchDeadline sets the deadline for fetching contributions, as a fraction of the way through the slot.
+// A value of 0 uses the submission deadline.
+func WithContributionFetchDeadline(deadline float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.contributionFetchDeadline = deadline
+	})
+}
+
+// WithBeaconBlockRootPolicy sets the policy used to select the beacon block root
+// when it has not been supplied by the sync committee messenger.
+// This should match the policy used by the messenger.
+func WithBeaconBlockRootPolicy(policy synccommitteemessenger.BeaconBlockRootPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconBlockRootPolicy = policy
+	})
+}
+
+// WithSignerLatencyThreshold sets the average signing latency above which the
+// signer is considered degraded.  A value of 0 disables signer latency tracking.
+func WithSignerLatencyThreshold(threshold time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signerLatencyThreshold = threshold
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
+		logLevel:              zerolog.GlobalLevel(),
+		submissionDeadline:    1.0,
+		beaconBlockRootPolicy: synccommitteemessenger.BeaconBlockRootPolicyHead,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -133,6 +192,26 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.syncCommitteeContributionsSubmitter == nil {
 		return nil, errors.New("no sync committee contributions submitter specified")
 	}
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chaintime service specified")
+	}
+	if parameters.submissionDeadline <= 0 || parameters.submissionDeadline > 1 {
+		return nil, errors.New("submission deadline must be greater than 0 and no more than 1")
+	}
+	if parameters.contributionFetchDeadline < 0 || parameters.contributionFetchDeadline > parameters.submissionDeadline {
+		return nil, errors.New("contribution fetch deadline must be at least 0 and no more than the submission deadline")
+	}
+	if parameters.contributionFetchDeadline == 0 {
+		parameters.contributionFetchDeadline = parameters.submissionDeadline
+	}
+	if parameters.signerLatencyThreshold < 0 {
+		return nil, errors.New("signer latency threshold cannot be negative")
+	}
+	beaconBlockRootPolicy, err := synccommitteemessenger.ParseBeaconBlockRootPolicy(string(parameters.beaconBlockRootPolicy))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid beacon block root policy")
+	}
+	parameters.beaconBlockRootPolicy = beaconBlockRootPolicy
 
 	return &parameters, nil
 }
@@ -14,6 +14,7 @@
 package standard
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sync"
@@ -23,12 +24,16 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/services/accountmanager"
+	"github.com/attestantio/vouch/services/chaintime"
 	"github.com/attestantio/vouch/services/metrics"
 	"github.com/attestantio/vouch/services/signer"
 	"github.com/attestantio/vouch/services/synccommitteeaggregator"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
+	"github.com/attestantio/vouch/util"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
 	"go.opentelemetry.io/otel"
 )
 
@@ -39,13 +44,29 @@ type Service struct {
 	syncCommitteeSize                    uint64
 	syncCommitteeSubnetCount             uint64
 	targetAggregatorsPerSyncSubcommittee uint64
+	epochsPerSyncCommitteePeriod         uint64
 	beaconBlockRootProvider              eth2client.BeaconBlockRootProvider
+	fallbackBeaconBlockRootProviders     []*namedBeaconBlockRootProvider
+	beaconBlockRootPolicy                synccommitteemessenger.BeaconBlockRootPolicy
 	contributionAndProofSigner           signer.ContributionAndProofSigner
 	validatingAccountsProvider           accountmanager.ValidatingAccountsProvider
 	syncCommitteeContributionProvider    eth2client.SyncCommitteeContributionProvider
 	syncCommitteeContributionsSubmitter  eth2client.SyncCommitteeContributionsSubmitter
+	chainTime                            chaintime.Service
+	submissionDeadline                   float64
+	contributionFetchDeadline            float64
 	beaconBlockRoots                     map[phase0.Slot]phase0.Root
 	beaconBlockRootsMu                   sync.Mutex
+	coverage                             map[uint64]*coverageStats
+	coverageMu                           sync.Mutex
+	signerLatency                        *util.SignerLatency
+
+	// draining is set when the service is stopping, after which no new
+	// aggregations are started.  It is protected by drainingMu to ensure
+	// that in-flight aggregations are not added after Stop() starts waiting.
+	draining   bool
+	drainingMu sync.Mutex
+	inFlight   sync.WaitGroup
 }
 
 // module-wide log.
@@ -105,18 +126,37 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		return nil, errors.New("TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE of unexpected type")
 	}
 
+	tmp, exists = spec["EPOCHS_PER_SYNC_COMMITTEE_PERIOD"]
+	if !exists {
+		return nil, errors.New("EPOCHS_PER_SYNC_COMMITTEE_PERIOD not found in spec")
+	}
+	epochsPerSyncCommitteePeriod, ok := tmp.(uint64)
+	if !ok {
+		return nil, errors.New("EPOCHS_PER_SYNC_COMMITTEE_PERIOD of unexpected type")
+	}
+
 	s := &Service{
 		monitor:                              parameters.monitor,
 		slotsPerEpoch:                        slotsPerEpoch,
 		syncCommitteeSize:                    syncCommitteeSize,
 		syncCommitteeSubnetCount:             syncCommitteeSubnetCount,
 		targetAggregatorsPerSyncSubcommittee: targetAggregatorsPerSyncSubcommittee,
+		epochsPerSyncCommitteePeriod:         epochsPerSyncCommitteePeriod,
 		beaconBlockRootProvider:              parameters.beaconBlockRootProvider,
+		fallbackBeaconBlockRootProviders:     sortBeaconBlockRootProviders(parameters.fallbackBeaconBlockRootProviders),
+		beaconBlockRootPolicy:                parameters.beaconBlockRootPolicy,
 		contributionAndProofSigner:           parameters.contributionAndProofSigner,
 		validatingAccountsProvider:           parameters.validatingAccountsProvider,
 		syncCommitteeContributionProvider:    parameters.syncCommitteeContributionProvider,
 		syncCommitteeContributionsSubmitter:  parameters.syncCommitteeContributionsSubmitter,
+		chainTime:                            parameters.chainTime,
+		submissionDeadline:                   parameters.submissionDeadline,
+		contributionFetchDeadline:            parameters.contributionFetchDeadline,
 		beaconBlockRoots:                     map[phase0.Slot]phase0.Root{},
+		coverage:                             make(map[uint64]*coverageStats),
+	}
+	if parameters.signerLatencyThreshold > 0 {
+		s.signerLatency = util.NewSignerLatency(parameters.signerLatencyThreshold)
 	}
 
 	return s, nil
@@ -141,6 +181,18 @@ func (s *Service) Aggregate(ctx context.Context, data interface{}) {
 		log.Error().Msg("Passed invalid data structure")
 		return
 	}
+
+	s.drainingMu.Lock()
+	if s.draining {
+		s.drainingMu.Unlock()
+		log.Debug().Uint64("slot", uint64(duty.Slot)).Msg("Service is draining; not starting aggregation")
+		s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(duty.ValidatorIndices), "draining")
+		return
+	}
+	s.inFlight.Add(1)
+	s.drainingMu.Unlock()
+	defer s.inFlight.Done()
+
 	log := log.With().Uint64("slot", uint64(duty.Slot)).Int("validators", len(duty.ValidatorIndices)).Logger()
 	log.Trace().Msg("Aggregating")
 
@@ -155,26 +207,36 @@ func (s *Service) Aggregate(ctx context.Context, data interface{}) {
 		log.Trace().Msg("Obtained beacon block root from cache")
 	} else {
 		s.beaconBlockRootsMu.Unlock()
-		log.Debug().Msg("Failed to obtain beacon block root from cache; using head")
-		beaconBlockRoot, err = s.beaconBlockRootProvider.BeaconBlockRoot(ctx, "head")
+		log.Debug().Str("policy", string(s.beaconBlockRootPolicy)).Msg("Failed to obtain beacon block root from cache; using policy")
+		beaconBlockRoot, err = s.policyBeaconBlockRoot(ctx, log)
 		if err != nil {
 			log.Warn().Err(err).Msg("Failed to obtain beacon block root")
 			s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(duty.ValidatorIndices), "failed")
 			return
 		}
-		if beaconBlockRoot == nil {
-			log.Warn().Msg("Returned empty beacon block root")
-			s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(duty.ValidatorIndices), "failed")
-			return
-		}
 	}
 	log.Trace().Dur("elapsed", time.Since(started)).Str("beacon_block_root", fmt.Sprintf("%#x", *beaconBlockRoot)).Msg("Obtained beacon block root")
 
-	signedContributionAndProofs := make([]*altair.SignedContributionAndProof, 0)
+	contributionAndProofs := make([]*altair.ContributionAndProof, 0)
+	accounts := make([]e2wtypes.Account, 0)
+	rejected := 0
+	// Fetching contributions has its own deadline, so that a slow beacon node does not
+	// stop us submitting the contributions we have already obtained.
+	fetchDeadline := s.contributionFetchDeadlineForSlot(duty.Slot)
+	fetchCtx, fetchCancel := context.WithDeadline(ctx, fetchDeadline)
+	defer fetchCancel()
+	fetchTimedOut := false
+contributions:
 	for _, validatorIndex := range duty.ValidatorIndices {
 		for subcommitteeIndex := range duty.SelectionProofs[validatorIndex] {
 			log.Trace().Uint64("validator_index", uint64(validatorIndex)).Uint64("subcommittee_index", subcommitteeIndex).Str("beacon_block_root", fmt.Sprintf("%#x", *beaconBlockRoot)).Msg("Aggregating")
-			contribution, err := s.syncCommitteeContributionProvider.SyncCommitteeContribution(ctx, duty.Slot, subcommitteeIndex, *beaconBlockRoot)
+			contribution, err := s.syncCommitteeContributionProvider.SyncCommitteeContribution(fetchCtx, duty.Slot, subcommitteeIndex, *beaconBlockRoot)
+			if err != nil && fetchCtx.Err() != nil && ctx.Err() == nil {
+				log.Warn().Time("deadline", fetchDeadline).Int("obtained", len(contributionAndProofs)).Msg("Contribution fetch deadline passed; submitting contributions obtained so far")
+				s.monitor.SyncCommitteeContributionFetchTimedOut()
+				fetchTimedOut = true
+				break contributions
+			}
 			if err != nil {
 				log.Warn().Err(err).Msg("Failed to obtain sync committee contribution")
 				s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(duty.ValidatorIndices), "failed")
@@ -185,28 +247,62 @@ func (s *Service) Aggregate(ctx context.Context, data interface{}) {
 				s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(duty.ValidatorIndices), "failed")
 				return
 			}
+			if contribution.Slot != duty.Slot {
+				log.Warn().Uint64("contribution_slot", uint64(contribution.Slot)).Uint64("subcommittee_index", subcommitteeIndex).Msg("Returned contribution for incorrect slot; rejecting")
+				s.monitor.SyncCommitteeContributionRejected("slot")
+				rejected++
+				continue
+			}
+			if !bytes.Equal(contribution.BeaconBlockRoot[:], beaconBlockRoot[:]) {
+				log.Warn().Str("contribution_beacon_block_root", fmt.Sprintf("%#x", contribution.BeaconBlockRoot)).Uint64("subcommittee_index", subcommitteeIndex).Msg("Returned contribution for incorrect beacon block root; rejecting")
+				s.monitor.SyncCommitteeContributionRejected("beacon_block_root")
+				rejected++
+				continue
+			}
 			contributionAndProof := &altair.ContributionAndProof{
 				AggregatorIndex: validatorIndex,
 				Contribution:    contribution,
 				SelectionProof:  duty.SelectionProofs[validatorIndex][subcommitteeIndex],
 			}
-			sig, err := s.contributionAndProofSigner.SignContributionAndProof(ctx, duty.Accounts[validatorIndex], contributionAndProof)
-			if err != nil {
-				log.Warn().Err(err).Msg("Failed to obtain signature of contribution and proof")
-				s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(duty.ValidatorIndices), "failed")
-				return
-			}
+			contributionAndProofs = append(contributionAndProofs, contributionAndProof)
+			accounts = append(accounts, duty.Accounts[validatorIndex])
+		}
+	}
 
-			signedContributionAndProof := &altair.SignedContributionAndProof{
-				Message:   contributionAndProof,
-				Signature: sig,
-			}
+	if rejected > 0 && len(contributionAndProofs) == 0 {
+		log.Warn().Int("rejected", rejected).Msg("All contributions rejected; nothing to submit")
+		s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(duty.ValidatorIndices), "failed")
+		return
+	}
+	if fetchTimedOut && len(contributionAndProofs) == 0 {
+		log.Warn().Msg("No contributions obtained before fetch deadline; nothing to submit")
+		s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(duty.ValidatorIndices), "failed")
+		return
+	}
 
-			signedContributionAndProofs = append(signedContributionAndProofs, signedContributionAndProof)
+	sigs, err := s.signContributionAndProofs(ctx, accounts, contributionAndProofs)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to obtain signature of contribution and proof")
+		s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(duty.ValidatorIndices), "failed")
+		return
+	}
+	signedContributionAndProofs := make([]*altair.SignedContributionAndProof, len(contributionAndProofs))
+	for i := range contributionAndProofs {
+		signedContributionAndProofs[i] = &altair.SignedContributionAndProof{
+			Message:   contributionAndProofs[i],
+			Signature: sigs[i],
 		}
 	}
 
-	if err := s.syncCommitteeContributionsSubmitter.SubmitSyncCommitteeContributions(ctx, signedContributionAndProofs); err != nil {
+	deadline := s.submissionDeadlineForSlot(duty.Slot)
+	submitCtx, cancel := context.WithDeadline(ctx, deadline)
+	err = s.syncCommitteeContributionsSubmitter.SubmitSyncCommitteeContributions(submitCtx, signedContributionAndProofs)
+	cancel()
+	if time.Now().After(deadline) {
+		log.Warn().Time("deadline", deadline).Msg("Submission of signed contribution and proofs passed deadline")
+		s.monitor.SyncCommitteeContributionsSubmittedAfterDeadline()
+	}
+	if err != nil {
 		log.Warn().Err(err).Msg("Failed to submit signed contribution and proofs")
 		s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(signedContributionAndProofs), "failed")
 		return
@@ -217,6 +313,79 @@ func (s *Service) Aggregate(ctx context.Context, data interface{}) {
 		frac := float64(signedContributionAndProofs[i].Message.Contribution.AggregationBits.Count()) /
 			float64(signedContributionAndProofs[i].Message.Contribution.AggregationBits.Len())
 		s.monitor.SyncCommitteeAggregationCoverage(frac)
+		s.recordCoverage(duty.Slot, frac)
 	}
 	s.monitor.SyncCommitteeAggregationsCompleted(started, duty.Slot, len(signedContributionAndProofs), "succeeded")
 }
+
+// signContributionAndProofs signs the contribution and proofs, with a single
+// call to the signer if it supports it.
+func (s *Service) signContributionAndProofs(ctx context.Context,
+	accounts []e2wtypes.Account,
+	contributionAndProofs []*altair.ContributionAndProof,
+) (
+	[]phase0.BLSSignature,
+	error,
+) {
+	if multiSigner, isMultiSigner := s.contributionAndProofSigner.(signer.ContributionAndProofsSigner); isMultiSigner {
+		started := time.Now()
+		sigs, err := multiSigner.SignContributionAndProofs(ctx, accounts, contributionAndProofs)
+		s.signerLatency.Observe(log, "sync committee contributions", time.Since(started), s.monitor.SyncCommitteeAggregationSignerDegraded)
+		if err != nil {
+			return nil, err
+		}
+		if len(sigs) != len(contributionAndProofs) {
+			return nil, fmt.Errorf("signer returned %d signatures for %d contribution and proofs", len(sigs), len(contributionAndProofs))
+		}
+		return sigs, nil
+	}
+
+	sigs := make([]phase0.BLSSignature, len(contributionAndProofs))
+	for i := range contributionAndProofs {
+		var err error
+		started := time.Now()
+		sigs[i], err = s.contributionAndProofSigner.SignContributionAndProof(ctx, accounts[i], contributionAndProofs[i])
+		s.signerLatency.Observe(log, "sync committee contributions", time.Since(started), s.monitor.SyncCommitteeAggregationSignerDegraded)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sigs, nil
+}
+
+// Stop stops the service from starting new aggregations, and waits for
+// in-flight aggregations to complete.
+func (s *Service) Stop(ctx context.Context) error {
+	s.drainingMu.Lock()
+	s.draining = true
+	s.drainingMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Trace().Msg("In-flight aggregations complete")
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "failed to wait for in-flight aggregations")
+	}
+}
+
+// contributionFetchDeadlineForSlot returns the time by which contributions for the slot must be fetched.
+func (s *Service) contributionFetchDeadlineForSlot(slot phase0.Slot) time.Time {
+	startOfSlot := s.chainTime.StartOfSlot(slot)
+	slotDuration := s.chainTime.StartOfSlot(slot + 1).Sub(startOfSlot)
+	return startOfSlot.Add(time.Duration(float64(slotDuration) * s.contributionFetchDeadline))
+}
+
+// submissionDeadlineForSlot returns the time by which contributions for the slot must be submitted.
+func (s *Service) submissionDeadlineForSlot(slot phase0.Slot) time.Time {
+	startOfSlot := s.chainTime.StartOfSlot(slot)
+	slotDuration := s.chainTime.StartOfSlot(slot + 1).Sub(startOfSlot)
+	return startOfSlot.Add(time.Duration(float64(slotDuration) * s.submissionDeadline))
+}
@@ -16,10 +16,12 @@ package standard_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	mocketh2client "github.com/attestantio/go-eth2-client/mock"
 	"github.com/attestantio/vouch/mock"
 	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
 	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
 	mocksigner "github.com/attestantio/vouch/services/signer/mock"
 	nullsubmitter "github.com/attestantio/vouch/services/submitter/null"
@@ -40,6 +42,12 @@ func TestService(t *testing.T) {
 	mockETH2Client, err := mocketh2client.New(ctx)
 	require.NoError(t, err)
 	mockValidatingAccountsProvider := mockaccountmanager.NewValidatingAccountsProvider()
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
 
 	tests := []struct {
 		name     string
@@ -57,6 +65,7 @@ func TestService(t *testing.T) {
 				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
 				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
 				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
 			},
 			err: "problem with parameters: no monitor specified",
 		},
@@ -70,6 +79,7 @@ func TestService(t *testing.T) {
 				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
 				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
 				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
 			},
 			err: "problem with parameters: no spec provider specified",
 		},
@@ -83,6 +93,7 @@ func TestService(t *testing.T) {
 				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
 				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
 				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
 			},
 			err: "problem with parameters: no beacon block root provider specified",
 		},
@@ -96,6 +107,7 @@ func TestService(t *testing.T) {
 				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
 				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
 				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
 			},
 			err: "problem with parameters: no contribution and proof signer specified",
 		},
@@ -109,6 +121,7 @@ func TestService(t *testing.T) {
 				standard.WithContributionAndProofSigner(mockSigner),
 				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
 				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
 			},
 			err: "problem with parameters: no validating accounts provider specified",
 		},
@@ -122,6 +135,7 @@ func TestService(t *testing.T) {
 				standard.WithContributionAndProofSigner(mockSigner),
 				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
 				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
 			},
 			err: "problem with parameters: no sync committee contribution provider specified",
 		},
@@ -138,6 +152,85 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no sync committee contributions submitter specified",
 		},
+		{
+			name: "ChainTimeMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithContributionAndProofSigner(mockSigner),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
+				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+			},
+			err: "problem with parameters: no chaintime service specified",
+		},
+		{
+			name: "SubmissionDeadlineZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithContributionAndProofSigner(mockSigner),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
+				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
+				standard.WithSubmissionDeadline(0),
+			},
+			err: "problem with parameters: submission deadline must be greater than 0 and no more than 1",
+		},
+		{
+			name: "SubmissionDeadlineTooHigh",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithContributionAndProofSigner(mockSigner),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
+				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
+				standard.WithSubmissionDeadline(1.5),
+			},
+			err: "problem with parameters: submission deadline must be greater than 0 and no more than 1",
+		},
+		{
+			name: "ContributionFetchDeadlineAfterSubmissionDeadline",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithContributionAndProofSigner(mockSigner),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
+				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
+				standard.WithSubmissionDeadline(0.5),
+				standard.WithContributionFetchDeadline(0.75),
+			},
+			err: "problem with parameters: contribution fetch deadline must be at least 0 and no more than the submission deadline",
+		},
+		{
+			name: "SignerLatencyThresholdNegative",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithContributionAndProofSigner(mockSigner),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
+				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
+				standard.WithSignerLatencyThreshold(-time.Second),
+			},
+			err: "problem with parameters: signer latency threshold cannot be negative",
+		},
 		{
 			name: "Good",
 			params: []standard.Parameter{
@@ -149,6 +242,7 @@ func TestService(t *testing.T) {
 				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
 				standard.WithSyncCommitteeContributionProvider(mockETH2Client),
 				standard.WithSyncCommitteeContributionsSubmitter(nullSubmitter),
+				standard.WithChainTime(chainTime),
 			},
 		},
 	}
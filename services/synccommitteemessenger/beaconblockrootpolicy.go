@@ -0,0 +1,53 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synccommitteemessenger
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BeaconBlockRootPolicy is the policy used to select the beacon block root
+// over which sync committee messages are signed.
+type BeaconBlockRootPolicy string
+
+const (
+	// BeaconBlockRootPolicyHead signs over the head of the chain.
+	// This gives the best rewards, as messages are included if they match
+	// the block proposed in the following slot, but leaves messages exposed
+	// to reorgs of the head.
+	BeaconBlockRootPolicyHead BeaconBlockRootPolicy = "head"
+	// BeaconBlockRootPolicyFinalized signs over the latest finalized block.
+	// This is not exposed to reorgs of the head, but messages only earn
+	// rewards if the finalized block is also the head, so under normal
+	// conditions they earn no rewards at all.
+	BeaconBlockRootPolicyFinalized BeaconBlockRootPolicy = "finalized"
+)
+
+// ParseBeaconBlockRootPolicy parses a beacon block root policy from a string.
+func ParseBeaconBlockRootPolicy(input string) (BeaconBlockRootPolicy, error) {
+	switch strings.ToLower(input) {
+	case "", string(BeaconBlockRootPolicyHead):
+		return BeaconBlockRootPolicyHead, nil
+	case string(BeaconBlockRootPolicyFinalized):
+		return BeaconBlockRootPolicyFinalized, nil
+	default:
+		return "", fmt.Errorf("unrecognised beacon block root policy %q", input)
+	}
+}
+
+// BlockID returns the block ID to request from the beacon node for the policy.
+func (p BeaconBlockRootPolicy) BlockID() string {
+	return string(p)
+}
@@ -133,3 +133,10 @@ type Service interface {
 	// It returns a list of messages made.
 	Message(ctx context.Context, data interface{}) ([]*altair.SyncCommitteeMessage, error)
 }
+
+// SubnetsProvider is the interface for providing the sync committee subnets required by duties.
+type SubnetsProvider interface {
+	// Subnets returns the sorted sync committee subnets to which the node should be
+	// subscribed in order to carry out the given duty.
+	Subnets(ctx context.Context, duty *Duty) []uint64
+}
@@ -0,0 +1,48 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synccommitteemessenger
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SigningEpochPolicy is the policy used to select the epoch whose domain is
+// used to sign sync committee messages for a slot.
+type SigningEpochPolicy string
+
+const (
+	// SigningEpochPolicySlot signs with the domain of the epoch of the slot
+	// the message is for.  This is the domain against which the message is
+	// verified when included in a block, as defined by the spec.
+	SigningEpochPolicySlot SigningEpochPolicy = "slot"
+	// SigningEpochPolicyNextSlot signs with the domain of the epoch of the
+	// slot after the slot the message is for, being the slot for which sync
+	// committee membership is calculated.  This does not follow the spec,
+	// and results in invalid signatures at the last slot before a fork, so
+	// is for custom networks only.
+	SigningEpochPolicyNextSlot SigningEpochPolicy = "next-slot"
+)
+
+// ParseSigningEpochPolicy parses a signing epoch policy from a string.
+func ParseSigningEpochPolicy(input string) (SigningEpochPolicy, error) {
+	switch strings.ToLower(input) {
+	case "", string(SigningEpochPolicySlot):
+		return SigningEpochPolicySlot, nil
+	case string(SigningEpochPolicyNextSlot):
+		return SigningEpochPolicyNextSlot, nil
+	default:
+		return "", fmt.Errorf("unrecognised signing epoch policy %q", input)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"sync"
+)
+
+// AggregatorSelection is the aggregator selection for a sync committee period.
+type AggregatorSelection struct {
+	// Period is the sync committee period.
+	Period uint64
+	// Modulo is the modulo against which selection proofs are checked.  It
+	// is 0 if no validators are selected.
+	Modulo uint64
+	// ExpectedProbability is the probability that a selection proof is
+	// selected, as predicted by the spec.
+	ExpectedProbability float64
+	// Checked is the number of selection proofs checked.
+	Checked uint64
+	// Selected is the number of selection proofs selected.
+	Selected uint64
+}
+
+// ObservedProbability is the observed probability that a selection proof is
+// selected.
+func (a *AggregatorSelection) ObservedProbability() float64 {
+	if a.Checked == 0 {
+		return 0
+	}
+
+	return float64(a.Selected) / float64(a.Checked)
+}
+
+// aggregatorSelections holds the aggregator selections, by sync committee period.
+type aggregatorSelections struct {
+	mu      sync.Mutex
+	periods map[uint64]*AggregatorSelection
+}
+
+// newAggregatorSelections creates a new aggregator selections tracker.
+func newAggregatorSelections() *aggregatorSelections {
+	return &aggregatorSelections{
+		periods: make(map[uint64]*AggregatorSelection),
+	}
+}
+
+// selectionModulo returns the effective modulo against which selection proofs
+// are checked, or 0 if no validators are selected.
+func (s *Service) selectionModulo() uint64 {
+	if s.neverAggregate {
+		return 0
+	}
+
+	return s.aggregatorModulo
+}
+
+// expectedSelectionProbability returns the probability that a selection proof
+// is selected, as predicted by the spec.
+func (s *Service) expectedSelectionProbability() float64 {
+	modulo := s.selectionModulo()
+	if modulo == 0 {
+		return 0
+	}
+
+	return 1 / float64(modulo)
+}
+
+// recordAggregatorSelections records the outcome of checking selection proofs
+// for a sync committee period.
+func (s *Service) recordAggregatorSelections(period uint64, checked int, selected int) {
+	expected := s.expectedSelectionProbability()
+	s.monitor.SyncCommitteeAggregatorSelections(expected, checked, selected)
+
+	s.aggregatorSelections.mu.Lock()
+	defer s.aggregatorSelections.mu.Unlock()
+
+	selection, exists := s.aggregatorSelections.periods[period]
+	if !exists {
+		// Report the outcome of the previous period and drop older periods, as
+		// only the current and previous periods are of interest.
+		for cachedPeriod, cachedSelection := range s.aggregatorSelections.periods {
+			if cachedPeriod+1 == period {
+				log.Debug().
+					Uint64("period", cachedPeriod).
+					Uint64("modulo", cachedSelection.Modulo).
+					Float64("expected_probability", cachedSelection.ExpectedProbability).
+					Float64("observed_probability", cachedSelection.ObservedProbability()).
+					Uint64("checked", cachedSelection.Checked).
+					Uint64("selected", cachedSelection.Selected).
+					Msg("Aggregator selection for previous sync committee period")
+			}
+			if cachedPeriod+1 < period {
+				delete(s.aggregatorSelections.periods, cachedPeriod)
+			}
+		}
+
+		selection = &AggregatorSelection{
+			Period:              period,
+			Modulo:              s.selectionModulo(),
+			ExpectedProbability: expected,
+		}
+		s.aggregatorSelections.periods[period] = selection
+		log.Debug().
+			Uint64("period", period).
+			Uint64("modulo", selection.Modulo).
+			Float64("expected_probability", selection.ExpectedProbability).
+			Msg("Aggregator selection for sync committee period")
+	}
+	selection.Checked += uint64(checked)
+	selection.Selected += uint64(selected)
+}
+
+// AggregatorSelection returns the aggregator selection for the given sync
+// committee period, or nil if no selection proofs have been checked for it.
+// Only the current and previous periods are held.
+func (s *Service) AggregatorSelection(period uint64) *AggregatorSelection {
+	s.aggregatorSelections.mu.Lock()
+	defer s.aggregatorSelections.mu.Unlock()
+
+	selection, exists := s.aggregatorSelections.periods[period]
+	if !exists {
+		return nil
+	}
+	res := *selection
+
+	return &res
+}
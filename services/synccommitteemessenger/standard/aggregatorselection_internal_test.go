@@ -0,0 +1,166 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocketh2client "github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	mocksigner "github.com/attestantio/vouch/services/signer/mock"
+	nullsubmitter "github.com/attestantio/vouch/services/submitter/null"
+	mocksynccommitteeaggregator "github.com/attestantio/vouch/services/synccommitteeaggregator/mock"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// selectionMonitor records the aggregator selections reported.
+type selectionMonitor struct {
+	*nullmetrics.Service
+	expected float64
+	checked  int
+	selected int
+}
+
+func (m *selectionMonitor) SyncCommitteeAggregatorSelections(expected float64, checked int, selected int) {
+	m.expected = expected
+	m.checked += checked
+	m.selected += selected
+}
+
+func TestAggregatorSelection(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+	nullSubmitter, err := nullsubmitter.New(ctx)
+	require.NoError(t, err)
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+	specProvider := mock.NewSpecProvider()
+
+	monitor := &selectionMonitor{Service: nullmetrics.New(ctx)}
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithProcessConcurrency(1),
+		WithMonitor(monitor),
+		WithChainTimeService(chainTime),
+		WithSyncCommitteeAggregator(mocksynccommitteeaggregator.New()),
+		WithSpecProvider(specProvider),
+		WithBeaconBlockRootProvider(mockETH2Client),
+		WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+		WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+		WithSyncCommitteeRootSigner(mocksigner.New()),
+		WithSyncCommitteeSelectionSigner(mocksigner.New()),
+		WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+	)
+	require.NoError(t, err)
+
+	// Calculate the modulo as per the spec.
+	spec, err := specProvider.Spec(ctx)
+	require.NoError(t, err)
+	expectedModulo := spec["SYNC_COMMITTEE_SIZE"].(uint64) /
+		spec["SYNC_COMMITTEE_SUBNET_COUNT"].(uint64) /
+		spec["TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE"].(uint64)
+	if expectedModulo < 1 {
+		expectedModulo = 1
+	}
+
+	require.Nil(t, s.AggregatorSelection(0))
+
+	duty := synccommitteemessenger.NewDuty(10, map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+		1: {1},
+		2: {130, 260},
+	})
+	require.NoError(t, s.Prepare(ctx, duty))
+
+	selection := s.AggregatorSelection(0)
+	require.NotNil(t, selection)
+	require.Equal(t, uint64(0), selection.Period)
+	require.Equal(t, expectedModulo, selection.Modulo)
+	require.Equal(t, 1/float64(expectedModulo), selection.ExpectedProbability)
+	require.Equal(t, uint64(3), selection.Checked)
+	require.LessOrEqual(t, selection.Selected, selection.Checked)
+	require.Equal(t, float64(selection.Selected)/3, selection.ObservedProbability())
+
+	require.Equal(t, 1/float64(expectedModulo), monitor.expected)
+	require.Equal(t, 3, monitor.checked)
+	require.Equal(t, int(selection.Selected), monitor.selected)
+
+	// Selections accumulate within the period.
+	require.NoError(t, s.Prepare(ctx, duty))
+	require.Equal(t, uint64(6), s.AggregatorSelection(0).Checked)
+}
+
+func TestAggregatorSelectionOverride(t *testing.T) {
+	tests := []struct {
+		name        string
+		override    string
+		modulo      uint64
+		probability float64
+	}{
+		{
+			name:        "Always",
+			override:    "always",
+			modulo:      1,
+			probability: 1,
+		},
+		{
+			name:     "Never",
+			override: "never",
+		},
+		{
+			name:        "Modulo",
+			override:    "4",
+			modulo:      4,
+			probability: 0.25,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			modulo, neverAggregate, err := parseAggregatorSelectionOverride(test.override)
+			require.NoError(t, err)
+			s := &Service{
+				monitor:              nullmetrics.New(context.Background()),
+				aggregatorModulo:     modulo,
+				neverAggregate:       neverAggregate,
+				aggregatorSelections: newAggregatorSelections(),
+			}
+			s.recordAggregatorSelections(5, 4, 0)
+			selection := s.AggregatorSelection(5)
+			require.NotNil(t, selection)
+			require.Equal(t, test.modulo, selection.Modulo)
+			require.Equal(t, test.probability, selection.ExpectedProbability)
+
+			// Only the current and previous periods are held.
+			s.recordAggregatorSelections(6, 4, 0)
+			require.NotNil(t, s.AggregatorSelection(5))
+			s.recordAggregatorSelections(7, 4, 0)
+			require.Nil(t, s.AggregatorSelection(5))
+			require.NotNil(t, s.AggregatorSelection(6))
+		})
+	}
+}
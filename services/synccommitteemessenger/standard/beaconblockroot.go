@@ -0,0 +1,48 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// obtainBeaconBlockRoot obtains the beacon block root over which to sign
+// sync committee messages.  An empty root usually means that the beacon node
+// is momentarily behind, so it is retried a limited number of times before
+// giving up.
+func (s *Service) obtainBeaconBlockRoot(ctx context.Context) (*phase0.Root, error) {
+	for retries := s.emptyRootRetries; ; retries-- {
+		beaconBlockRoot, err := s.beaconBlockRootProvider.BeaconBlockRoot(ctx, s.beaconBlockRootPolicy.BlockID())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain beacon block root")
+		}
+		if beaconBlockRoot != nil {
+			return beaconBlockRoot, nil
+		}
+		if retries <= 0 {
+			return nil, errors.New("empty beacon block root obtained")
+		}
+
+		log.Debug().Int("retries", retries).Msg("Empty beacon block root obtained; retrying")
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "context done whilst waiting to retry beacon block root")
+		case <-time.After(s.emptyRootRetryInterval):
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// signedMessageKey identifies a sync committee message.
+type signedMessageKey struct {
+	validatorIndex phase0.ValidatorIndex
+	slot           phase0.Slot
+	root           phase0.Root
+}
+
+// signedMessages holds the sync committee messages that have been claimed for
+// signing, to avoid producing the same message twice.
+type signedMessages struct {
+	mu       sync.Mutex
+	messages map[signedMessageKey]struct{}
+	// lowestSlot is the lowest slot for which messages are held.
+	lowestSlot phase0.Slot
+}
+
+// newSignedMessages creates a new signed messages tracker.
+func newSignedMessages() *signedMessages {
+	return &signedMessages{
+		messages: make(map[signedMessageKey]struct{}),
+	}
+}
+
+// claimMessages claims the messages for the given validators at the given slot
+// and root, returning the validators for which messages should be produced.
+//
+// At sync committee period boundaries a validator can be in both the outgoing
+// and incoming committees, and so receive overlapping duties for the same
+// slot.  Only the first claim for a message succeeds; later claims are
+// suppressed with a log entry.
+func (s *Service) claimMessages(slot phase0.Slot,
+	root phase0.Root,
+	validatorIndices []phase0.ValidatorIndex,
+) []phase0.ValidatorIndex {
+	if s.signedMessages == nil {
+		return validatorIndices
+	}
+
+	s.signedMessages.mu.Lock()
+	defer s.signedMessages.mu.Unlock()
+
+	// Messages for old slots will not be produced again, so are pruned.
+	if slot > phase0.Slot(s.slotsPerEpoch) && slot-phase0.Slot(s.slotsPerEpoch) > s.signedMessages.lowestSlot {
+		s.signedMessages.lowestSlot = slot - phase0.Slot(s.slotsPerEpoch)
+		for key := range s.signedMessages.messages {
+			if key.slot < s.signedMessages.lowestSlot {
+				delete(s.signedMessages.messages, key)
+			}
+		}
+	}
+
+	claimed := make([]phase0.ValidatorIndex, 0, len(validatorIndices))
+	for _, validatorIndex := range validatorIndices {
+		key := signedMessageKey{
+			validatorIndex: validatorIndex,
+			slot:           slot,
+			root:           root,
+		}
+		if _, exists := s.signedMessages.messages[key]; exists {
+			log.Debug().
+				Uint64("slot", uint64(slot)).
+				Uint64("validator_index", uint64(validatorIndex)).
+				Msg("Sync committee message already produced for validator; suppressing duplicate")
+			continue
+		}
+		s.signedMessages.messages[key] = struct{}{}
+		claimed = append(claimed, validatorIndex)
+	}
+
+	return claimed
+}
+
+// releaseMessage releases the claim on a message that was not produced, so
+// that a later duty can produce it.
+func (s *Service) releaseMessage(slot phase0.Slot,
+	root phase0.Root,
+	validatorIndex phase0.ValidatorIndex,
+) {
+	if s.signedMessages == nil {
+		return
+	}
+
+	s.signedMessages.mu.Lock()
+	delete(s.signedMessages.messages, signedMessageKey{
+		validatorIndex: validatorIndex,
+		slot:           slot,
+		root:           root,
+	})
+	s.signedMessages.mu.Unlock()
+}
@@ -0,0 +1,137 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"go.opentelemetry.io/otel"
+)
+
+// receivedDuties holds the validators for which duties have been received, by epoch.
+type receivedDuties struct {
+	mu     sync.Mutex
+	epochs map[phase0.Epoch]map[phase0.ValidatorIndex]struct{}
+	// firstEpoch is the first epoch for which duties are known to have been
+	// received in full.
+	firstEpoch phase0.Epoch
+}
+
+// newReceivedDuties creates a new received duties tracker.
+func newReceivedDuties(firstEpoch phase0.Epoch) *receivedDuties {
+	return &receivedDuties{
+		epochs:     make(map[phase0.Epoch]map[phase0.ValidatorIndex]struct{}),
+		firstEpoch: firstEpoch,
+	}
+}
+
+// recordDuty records the receipt of a duty for the given validators.
+func (s *Service) recordDuty(slot phase0.Slot, validatorIndices []phase0.ValidatorIndex) {
+	// Duties are for the sync committee of the following slot.
+	epoch := s.chainTimeService.SlotToEpoch(slot + 1)
+
+	s.receivedDuties.mu.Lock()
+	defer s.receivedDuties.mu.Unlock()
+
+	indices, exists := s.receivedDuties.epochs[epoch]
+	if !exists {
+		indices = make(map[phase0.ValidatorIndex]struct{})
+		s.receivedDuties.epochs[epoch] = indices
+	}
+	for _, index := range validatorIndices {
+		indices[index] = struct{}{}
+	}
+}
+
+// dutyGapsRuntime schedules the duty gap check for shortly after the start
+// of the next epoch, by which time all duties for the current epoch will have
+// been received.
+func (s *Service) dutyGapsRuntime(_ context.Context,
+	_ interface{},
+) (
+	time.Time,
+	error,
+) {
+	nextEpoch := s.chainTimeService.CurrentEpoch() + 1
+	return s.chainTimeService.StartOfSlot(s.chainTimeService.FirstSlotOfEpoch(nextEpoch) + 1), nil
+}
+
+// checkDutyGaps checks for duty gaps in the previous epoch.
+func (s *Service) checkDutyGaps(ctx context.Context, _ interface{}) {
+	currentEpoch := s.chainTimeService.CurrentEpoch()
+	if currentEpoch == 0 {
+		return
+	}
+	s.reconcileDuties(ctx, currentEpoch-1)
+}
+
+// reconcileDuties compares the managed validators that are members of the sync
+// committee for the given epoch with the validators for which duties were
+// received, logging and returning any that did not receive a duty.
+func (s *Service) reconcileDuties(ctx context.Context, epoch phase0.Epoch) []phase0.ValidatorIndex {
+	ctx, span := otel.Tracer("attestantio.vouch.services.synccommitteemessenger.standard").Start(ctx, "reconcileDuties")
+	defer span.End()
+
+	s.receivedDuties.mu.Lock()
+	received := s.receivedDuties.epochs[epoch]
+	// Only the epoch being reconciled and later are of interest.
+	for cachedEpoch := range s.receivedDuties.epochs {
+		if cachedEpoch <= epoch {
+			delete(s.receivedDuties.epochs, cachedEpoch)
+		}
+	}
+	firstEpoch := s.receivedDuties.firstEpoch
+	s.receivedDuties.mu.Unlock()
+
+	if epoch < firstEpoch {
+		// Duties for this epoch may have been received before we started.
+		return nil
+	}
+
+	members, err := s.syncCommitteeMembersForEpoch(ctx, epoch)
+	if err != nil {
+		log.Debug().Uint64("epoch", uint64(epoch)).Err(err).Msg("Failed to obtain sync committee members; cannot check for duty gaps")
+		return nil
+	}
+
+	accounts, err := s.validatingAccountsProvider.ValidatingAccountsForEpoch(ctx, epoch)
+	if err != nil {
+		log.Debug().Uint64("epoch", uint64(epoch)).Err(err).Msg("Failed to obtain validating accounts; cannot check for duty gaps")
+		return nil
+	}
+
+	gaps := make([]phase0.ValidatorIndex, 0)
+	for index := range accounts {
+		if _, isMember := members[index]; !isMember {
+			continue
+		}
+		if _, hasDuty := received[index]; !hasDuty {
+			gaps = append(gaps, index)
+		}
+	}
+	if len(gaps) == 0 {
+		log.Trace().Uint64("epoch", uint64(epoch)).Msg("No sync committee duty gaps")
+		return gaps
+	}
+
+	sort.Slice(gaps, func(i, j int) bool { return gaps[i] < gaps[j] })
+	log.Warn().Uint64("epoch", uint64(epoch)).Interface("validator_indices", gaps).Msg("Managed sync committee members did not receive sync committee duties; check duty scheduling and beacon node subscriptions")
+	s.monitor.SyncCommitteeDutyGaps(len(gaps))
+
+	return gaps
+}
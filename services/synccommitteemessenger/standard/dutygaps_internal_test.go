@@ -0,0 +1,132 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedSyncCommitteesProvider returns a fixed sync committee.
+type fixedSyncCommitteesProvider struct {
+	validators []phase0.ValidatorIndex
+}
+
+func (p *fixedSyncCommitteesProvider) SyncCommitteeAtEpoch(_ context.Context, _ string, _ phase0.Epoch) (*apiv1.SyncCommittee, error) {
+	return &apiv1.SyncCommittee{
+		Validators: p.validators,
+	}, nil
+}
+
+func (p *fixedSyncCommitteesProvider) SyncCommittee(ctx context.Context, stateID string) (*apiv1.SyncCommittee, error) {
+	return p.SyncCommitteeAtEpoch(ctx, stateID, 0)
+}
+
+func TestReconcileDuties(t *testing.T) {
+	ctx := context.Background()
+
+	// Genesis is 10 epochs ago.
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now().Add(-10*32*12*time.Second))),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	validatingAccountsProvider := mockaccountmanager.NewValidatingAccountsProvider()
+	validatingAccountsProvider.AddAccount(1, nil)
+	validatingAccountsProvider.AddAccount(2, nil)
+	// Validator 4 is managed but not in the sync committee.
+	validatingAccountsProvider.AddAccount(4, nil)
+
+	tests := []struct {
+		name     string
+		epoch    phase0.Epoch
+		duties   map[phase0.Slot][]phase0.ValidatorIndex
+		gaps     []phase0.ValidatorIndex
+		logEntry string
+	}{
+		{
+			name:  "BeforeStart",
+			epoch: 4,
+			gaps:  nil,
+		},
+		{
+			name:  "NoGaps",
+			epoch: 8,
+			duties: map[phase0.Slot][]phase0.ValidatorIndex{
+				255: {1, 2},
+				256: {1, 2},
+			},
+			gaps: []phase0.ValidatorIndex{},
+		},
+		{
+			name:  "MissingDuty",
+			epoch: 8,
+			duties: map[phase0.Slot][]phase0.ValidatorIndex{
+				255: {1},
+				256: {1},
+			},
+			gaps:     []phase0.ValidatorIndex{2},
+			logEntry: "Managed sync committee members did not receive sync committee duties; check duty scheduling and beacon node subscriptions",
+		},
+		{
+			name:  "DutyForOtherEpoch",
+			epoch: 8,
+			duties: map[phase0.Slot][]phase0.ValidatorIndex{
+				// Slot 287 is in epoch 8, but its duty is for the following slot in epoch 9.
+				287: {1, 2},
+			},
+			gaps:     []phase0.ValidatorIndex{1, 2},
+			logEntry: "Managed sync committee members did not receive sync committee duties; check duty scheduling and beacon node subscriptions",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewModuleLogCapture(t, &log)
+			s := &Service{
+				monitor:                      nullmetrics.New(ctx),
+				chainTimeService:             chainTime,
+				epochsPerSyncCommitteePeriod: 256,
+				validatingAccountsProvider:   validatingAccountsProvider,
+				syncCommitteesProvider: &fixedSyncCommitteesProvider{
+					validators: []phase0.ValidatorIndex{1, 2, 3},
+				},
+				syncCommitteeMembers: newSyncCommitteeMembers(),
+				receivedDuties:       newReceivedDuties(5),
+			}
+			for slot, indices := range test.duties {
+				s.recordDuty(slot, indices)
+			}
+			require.Equal(t, test.gaps, s.reconcileDuties(ctx, test.epoch))
+			if test.logEntry != "" {
+				capture.AssertHasEntry(t, test.logEntry)
+			}
+
+			// Received duties are released once reconciled.
+			require.NotContains(t, s.receivedDuties.epochs, test.epoch)
+		})
+	}
+}
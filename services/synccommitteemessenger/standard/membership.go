@@ -0,0 +1,110 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// syncCommitteeMembers holds the members of sync committees by period.
+type syncCommitteeMembers struct {
+	mu      sync.Mutex
+	periods map[uint64]map[phase0.ValidatorIndex]struct{}
+}
+
+// newSyncCommitteeMembers creates a new sync committee members cache.
+func newSyncCommitteeMembers() *syncCommitteeMembers {
+	return &syncCommitteeMembers{
+		periods: make(map[uint64]map[phase0.ValidatorIndex]struct{}),
+	}
+}
+
+// syncCommitteeMembersForEpoch returns the members of the sync committee for
+// the given epoch, fetching them from the beacon node if not already known.
+func (s *Service) syncCommitteeMembersForEpoch(ctx context.Context,
+	epoch phase0.Epoch,
+) (
+	map[phase0.ValidatorIndex]struct{},
+	error,
+) {
+	period := uint64(epoch) / s.epochsPerSyncCommitteePeriod
+
+	s.syncCommitteeMembers.mu.Lock()
+	defer s.syncCommitteeMembers.mu.Unlock()
+
+	if members, exists := s.syncCommitteeMembers.periods[period]; exists {
+		return members, nil
+	}
+
+	syncCommittee, err := s.syncCommitteesProvider.SyncCommitteeAtEpoch(ctx, "head", epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain sync committee")
+	}
+	if syncCommittee == nil {
+		return nil, errors.New("sync committee not returned")
+	}
+
+	members := make(map[phase0.ValidatorIndex]struct{}, len(syncCommittee.Validators))
+	for _, index := range syncCommittee.Validators {
+		members[index] = struct{}{}
+	}
+	s.syncCommitteeMembers.periods[period] = members
+
+	// Only the current and previous periods are of interest.
+	for cachedPeriod := range s.syncCommitteeMembers.periods {
+		if cachedPeriod+1 < period {
+			delete(s.syncCommitteeMembers.periods, cachedPeriod)
+		}
+	}
+
+	return members, nil
+}
+
+// syncCommitteeMemberIndices returns those of the given validator indices that
+// are members of the sync committee for the slot of the duty, logging a warning
+// for any that are not.  If membership cannot be confirmed all indices are
+// returned, as it is better to sign an unnecessary message than to miss one.
+// With the next slot signing epoch policy this is also the committee of the
+// signing epoch; with the default policy the committees differ at the last
+// slot of a period, and it is the committee the message is for that matters.
+func (s *Service) syncCommitteeMemberIndices(ctx context.Context,
+	slot phase0.Slot,
+	validatorIndices []phase0.ValidatorIndex,
+) []phase0.ValidatorIndex {
+	if s.syncCommitteesProvider == nil {
+		return validatorIndices
+	}
+
+	// Membership is calculated for the following slot, as for the duty itself.
+	members, err := s.syncCommitteeMembersForEpoch(ctx, s.messageMembershipEpoch(slot))
+	if err != nil {
+		log.Warn().Uint64("slot", uint64(slot)).Err(err).Msg("Failed to confirm sync committee membership; signing for all validators in duty")
+		return validatorIndices
+	}
+
+	memberIndices := make([]phase0.ValidatorIndex, 0, len(validatorIndices))
+	for _, index := range validatorIndices {
+		if _, isMember := members[index]; !isMember {
+			log.Warn().Uint64("slot", uint64(slot)).Uint64("validator_index", uint64(index)).Msg("Validator in duty is not a member of the sync committee; not signing message")
+			continue
+		}
+		memberIndices = append(memberIndices, index)
+	}
+
+	return memberIndices
+}
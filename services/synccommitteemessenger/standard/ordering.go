@@ -0,0 +1,40 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// orderValidatorIndices orders validator indices for signing.  Priority
+// validators come first, in the order in which they were configured, followed
+// by the remaining validators in ascending order of index.
+func (s *Service) orderValidatorIndices(indices []phase0.ValidatorIndex) {
+	sort.Slice(indices, func(i, j int) bool {
+		iPriority, iIsPriority := s.priorityIndices[indices[i]]
+		jPriority, jIsPriority := s.priorityIndices[indices[j]]
+		switch {
+		case iIsPriority && jIsPriority:
+			return iPriority < jPriority
+		case iIsPriority:
+			return true
+		case jIsPriority:
+			return false
+		default:
+			return indices[i] < indices[j]
+		}
+	})
+}
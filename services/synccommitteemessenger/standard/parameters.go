@@ -15,15 +15,19 @@ package standard
 
 import (
 	"context"
+	"time"
 
 	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/services/accountmanager"
 	"github.com/attestantio/vouch/services/chaintime"
 	"github.com/attestantio/vouch/services/metrics"
 	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	"github.com/attestantio/vouch/services/scheduler"
 	"github.com/attestantio/vouch/services/signer"
 	"github.com/attestantio/vouch/services/submitter"
 	"github.com/attestantio/vouch/services/synccommitteeaggregator"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
@@ -31,6 +35,7 @@ import (
 type parameters struct {
 	logLevel                            zerolog.Level
 	processConcurrency                  int64
+	maxProcessConcurrency               int64
 	monitor                             metrics.SyncCommitteeMessageMonitor
 	chainTimeService                    chaintime.Service
 	syncCommitteeAggregator             synccommitteeaggregator.Service
@@ -40,7 +45,20 @@ type parameters struct {
 	validatingAccountsProvider          accountmanager.ValidatingAccountsProvider
 	syncCommitteeRootSigner             signer.SyncCommitteeRootSigner
 	syncCommitteeSelectionSigner        signer.SyncCommitteeSelectionSigner
+	syncCommitteeSelectionsSigner       signer.SyncCommitteeSelectionsSigner
 	syncCommitteeSubscriptionsSubmitter submitter.SyncCommitteeSubscriptionsSubmitter
+	aggregatorSelectionOverride         string
+	beaconBlockRootPolicy               synccommitteemessenger.BeaconBlockRootPolicy
+	signingEpochPolicy                  synccommitteemessenger.SigningEpochPolicy
+	signerSelfCheck                     bool
+	emptyRootRetries                    int
+	emptyRootRetryInterval              time.Duration
+	syncCommitteesProvider              eth2client.SyncCommitteesProvider
+	priorityIndices                     []phase0.ValidatorIndex
+	scheduler                           scheduler.Service
+	allowDuplicateMessages              bool
+	signerLatencyThreshold              time.Duration
+	signerDegradedConcurrency           int64
 }
 
 // Parameter is the interface for service parameters.
@@ -68,6 +86,24 @@ func WithProcessConcurrency(concurrency int64) Parameter {
 	})
 }
 
+// WithMaxProcessConcurrency sets the maximum concurrency for the service when
+// deriving the concurrency from the number of validators.  A value of 0 uses the
+// fixed process concurrency.
+func WithMaxProcessConcurrency(concurrency int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxProcessConcurrency = concurrency
+	})
+}
+
+// WithPriorityIndices sets the validators whose sync committee messages are
+// signed first, in the order supplied.  Other validators are signed in
+// ascending order of index.
+func WithPriorityIndices(indices []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.priorityIndices = indices
+	})
+}
+
 // WithMonitor sets the monitor for this module.
 func WithMonitor(monitor metrics.SyncCommitteeMessageMonitor) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -131,6 +167,14 @@ func WithSyncCommitteeSelectionSigner(signer signer.SyncCommitteeSelectionSigner
 	})
 }
 
+// WithSyncCommitteeSelectionsSigner sets the sync committee selections signer.
+// This is optional; if not present selections are signed individually.
+func WithSyncCommitteeSelectionsSigner(signer signer.SyncCommitteeSelectionsSigner) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.syncCommitteeSelectionsSigner = signer
+	})
+}
+
 // WithSyncCommitteeSubscriptionsSubmitter sets the sync committee subscriptions submitter.
 func WithSyncCommitteeSubscriptionsSubmitter(submitter submitter.SyncCommitteeSubscriptionsSubmitter) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -138,11 +182,110 @@ func WithSyncCommitteeSubscriptionsSubmitter(submitter submitter.SyncCommitteeSu
 	})
 }
 
+// WithAggregatorSelectionOverride overrides the spec-derived aggregator selection.
+// Valid values are "always", "never", or a positive integer to use as the selection modulo.
+// This is for test networks only, and will be refused on mainnet.
+func WithAggregatorSelectionOverride(override string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.aggregatorSelectionOverride = override
+	})
+}
+
+// WithBeaconBlockRootPolicy sets the policy used to select the beacon block root
+// over which sync committee messages are signed.
+func WithBeaconBlockRootPolicy(policy synccommitteemessenger.BeaconBlockRootPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconBlockRootPolicy = policy
+	})
+}
+
+// WithSigningEpochPolicy sets the policy used to select the epoch whose domain
+// is used to sign sync committee messages.  Policies other than the default are
+// for test networks only, and will be refused on mainnet.
+func WithSigningEpochPolicy(policy synccommitteemessenger.SigningEpochPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signingEpochPolicy = policy
+	})
+}
+
+// WithSignerSelfCheck checks that the signer can sign for a validating account at startup.
+func WithSignerSelfCheck(check bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signerSelfCheck = check
+	})
+}
+
+// WithEmptyRootRetries sets the number of times to retry fetching the beacon
+// block root if the beacon node returns an empty root.
+func WithEmptyRootRetries(retries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.emptyRootRetries = retries
+	})
+}
+
+// WithEmptyRootRetryInterval sets the interval between retries when the beacon
+// node returns an empty beacon block root.
+func WithEmptyRootRetryInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.emptyRootRetryInterval = interval
+	})
+}
+
+// WithSyncCommitteesProvider sets the provider used to confirm that validators in
+// duties are members of the sync committee before signing.
+// This is optional; if not present membership is not confirmed.
+func WithSyncCommitteesProvider(provider eth2client.SyncCommitteesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.syncCommitteesProvider = provider
+	})
+}
+
+// WithScheduler sets the scheduler used to periodically check that duties
+// have been received for all managed sync committee members.
+// This is optional; if not present, or if there is no sync committees
+// provider, duties are not checked.
+func WithScheduler(scheduler scheduler.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.scheduler = scheduler
+	})
+}
+
+// WithAllowDuplicateMessages allows more than one sync committee message to be
+// produced for the same validator, slot and root.  By default duplicates, for
+// example from overlapping duties at sync committee period boundaries, are
+// suppressed.
+func WithAllowDuplicateMessages(allow bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.allowDuplicateMessages = allow
+	})
+}
+
+// WithSignerLatencyThreshold sets the average signing latency above which the
+// signer is considered degraded.  A value of 0 disables signer latency tracking.
+func WithSignerLatencyThreshold(threshold time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signerLatencyThreshold = threshold
+	})
+}
+
+// WithSignerDegradedConcurrency sets the maximum process concurrency when the
+// signer is degraded, to reduce the load on the signer.  A value of 0 leaves the
+// process concurrency unchanged.
+func WithSignerDegradedConcurrency(concurrency int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signerDegradedConcurrency = concurrency
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
-		monitor:  nullmetrics.New(context.Background()),
+		logLevel:               zerolog.GlobalLevel(),
+		monitor:                nullmetrics.New(context.Background()),
+		beaconBlockRootPolicy:  synccommitteemessenger.BeaconBlockRootPolicyHead,
+		signingEpochPolicy:     synccommitteemessenger.SigningEpochPolicySlot,
+		emptyRootRetries:       2,
+		emptyRootRetryInterval: 250 * time.Millisecond,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -153,6 +296,9 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.processConcurrency < 1 {
 		return nil, errors.New("no process concurrency specified")
 	}
+	if parameters.maxProcessConcurrency < 0 {
+		return nil, errors.New("max process concurrency cannot be negative")
+	}
 	if parameters.monitor == nil {
 		return nil, errors.New("no monitor specified")
 	}
@@ -183,6 +329,28 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.syncCommitteeRootSigner == nil {
 		return nil, errors.New("no sync committee root signer specified")
 	}
+	if parameters.emptyRootRetries < 0 {
+		return nil, errors.New("empty root retries cannot be negative")
+	}
+	if parameters.emptyRootRetries > 0 && parameters.emptyRootRetryInterval <= 0 {
+		return nil, errors.New("empty root retry interval must be positive")
+	}
+	if parameters.signerLatencyThreshold < 0 {
+		return nil, errors.New("signer latency threshold cannot be negative")
+	}
+	if parameters.signerDegradedConcurrency < 0 {
+		return nil, errors.New("signer degraded concurrency cannot be negative")
+	}
+	beaconBlockRootPolicy, err := synccommitteemessenger.ParseBeaconBlockRootPolicy(string(parameters.beaconBlockRootPolicy))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid beacon block root policy")
+	}
+	parameters.beaconBlockRootPolicy = beaconBlockRootPolicy
+	signingEpochPolicy, err := synccommitteemessenger.ParseSigningEpochPolicy(string(parameters.signingEpochPolicy))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signing epoch policy")
+	}
+	parameters.signingEpochPolicy = signingEpochPolicy
 
 	return &parameters, nil
 }
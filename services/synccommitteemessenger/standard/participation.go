@@ -0,0 +1,125 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
+)
+
+// syncParticipation holds the sync committee participation of validators, by epoch.
+type syncParticipation struct {
+	mu     sync.Mutex
+	epochs map[phase0.Epoch]map[phase0.ValidatorIndex]*validatorParticipation
+}
+
+// validatorParticipation is the participation of a validator in an epoch, in
+// sync committee positions.  A validator receives the participant reward for
+// each of its positions in the sync committee for each slot in which its
+// message is included, so the ratio of earned to expected positions is a proxy
+// for the ratio of rewards earned to rewards available.
+type validatorParticipation struct {
+	expected uint64
+	earned   uint64
+}
+
+// newSyncParticipation creates a new sync participation tracker.
+func newSyncParticipation() *syncParticipation {
+	return &syncParticipation{
+		epochs: make(map[phase0.Epoch]map[phase0.ValidatorIndex]*validatorParticipation),
+	}
+}
+
+// recordParticipation records the participation of validators in the sync
+// committee for a duty.  Expected validators are those that should have
+// produced a message, and earned those whose message was submitted.
+// Participation for earlier epochs is complete at this point, so is reported.
+func (s *Service) recordParticipation(duty *synccommitteemessenger.Duty,
+	expected []phase0.ValidatorIndex,
+	earned []phase0.ValidatorIndex,
+) {
+	// Messages are for the sync committee of the following slot.
+	epoch := s.chainTimeService.SlotToEpoch(duty.Slot() + 1)
+	contributionIndices := duty.ContributionIndices()
+
+	s.syncParticipation.mu.Lock()
+	participation, exists := s.syncParticipation.epochs[epoch]
+	if !exists {
+		participation = make(map[phase0.ValidatorIndex]*validatorParticipation)
+		s.syncParticipation.epochs[epoch] = participation
+	}
+	for _, index := range expected {
+		if _, exists := participation[index]; !exists {
+			participation[index] = &validatorParticipation{}
+		}
+		participation[index].expected += uint64(len(contributionIndices[index]))
+	}
+	for _, index := range earned {
+		if _, exists := participation[index]; !exists {
+			participation[index] = &validatorParticipation{}
+		}
+		participation[index].earned += uint64(len(contributionIndices[index]))
+	}
+	complete := make(map[phase0.Epoch]map[phase0.ValidatorIndex]*validatorParticipation)
+	for cachedEpoch, cachedParticipation := range s.syncParticipation.epochs {
+		if cachedEpoch < epoch {
+			complete[cachedEpoch] = cachedParticipation
+			delete(s.syncParticipation.epochs, cachedEpoch)
+		}
+	}
+	s.syncParticipation.mu.Unlock()
+
+	for completeEpoch, completeParticipation := range complete {
+		s.reportParticipation(completeEpoch, completeParticipation)
+	}
+}
+
+// participationRewards returns the estimated ratio of sync committee rewards
+// earned to those available for each validator.
+func participationRewards(participation map[phase0.ValidatorIndex]*validatorParticipation) map[phase0.ValidatorIndex]float64 {
+	rewards := make(map[phase0.ValidatorIndex]float64, len(participation))
+	for index, validator := range participation {
+		if validator.expected == 0 {
+			continue
+		}
+		rewards[index] = float64(validator.earned) / float64(validator.expected)
+	}
+
+	return rewards
+}
+
+// reportParticipation reports the sync committee participation for an epoch.
+func (s *Service) reportParticipation(epoch phase0.Epoch,
+	participation map[phase0.ValidatorIndex]*validatorParticipation,
+) {
+	rewards := participationRewards(participation)
+	if len(rewards) == 0 {
+		return
+	}
+
+	underperforming := make([]phase0.ValidatorIndex, 0)
+	for index, reward := range rewards {
+		if reward < 1 {
+			underperforming = append(underperforming, index)
+		}
+	}
+	if len(underperforming) > 0 {
+		sort.Slice(underperforming, func(i, j int) bool { return underperforming[i] < underperforming[j] })
+		log.Debug().Uint64("epoch", uint64(epoch)).Interface("validator_indices", underperforming).Msg("Sync committee members did not earn all available participation rewards")
+	}
+	s.monitor.SyncCommitteeParticipationRewards(rewards)
+}
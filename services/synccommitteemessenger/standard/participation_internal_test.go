@@ -0,0 +1,144 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocketh2client "github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	mocksigner "github.com/attestantio/vouch/services/signer/mock"
+	nullsubmitter "github.com/attestantio/vouch/services/submitter/null"
+	mocksynccommitteeaggregator "github.com/attestantio/vouch/services/synccommitteeaggregator/mock"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// participationMonitor records the participation rewards reported.
+type participationMonitor struct {
+	*nullmetrics.Service
+	reports []map[phase0.ValidatorIndex]float64
+}
+
+func (m *participationMonitor) SyncCommitteeParticipationRewards(rewards map[phase0.ValidatorIndex]float64) {
+	m.reports = append(m.reports, rewards)
+}
+
+func testParticipationService(ctx context.Context, t *testing.T, monitor *participationMonitor) *Service {
+	t.Helper()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+	nullSubmitter, err := nullsubmitter.New(ctx)
+	require.NoError(t, err)
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithProcessConcurrency(1),
+		WithMonitor(monitor),
+		WithChainTimeService(chainTime),
+		WithSyncCommitteeAggregator(mocksynccommitteeaggregator.New()),
+		WithSpecProvider(mock.NewSpecProvider()),
+		WithBeaconBlockRootProvider(mockETH2Client),
+		WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+		WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+		WithSyncCommitteeRootSigner(mocksigner.New()),
+		WithSyncCommitteeSelectionSigner(mocksigner.New()),
+		WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+	)
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestParticipationRewards(t *testing.T) {
+	ctx := context.Background()
+	monitor := &participationMonitor{Service: nullmetrics.New(ctx)}
+	s := testParticipationService(ctx, t, monitor)
+
+	contributionIndices := map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+		1: {1},
+		2: {130, 260},
+		3: {400},
+	}
+
+	// Four slots in epoch 0.  Validator 1 participates in all of them, validator
+	// 2 misses one, and validator 3 only participates in one.
+	for slot := phase0.Slot(1); slot <= 4; slot++ {
+		duty := synccommitteemessenger.NewDuty(slot, contributionIndices)
+		expected := []phase0.ValidatorIndex{1, 2, 3}
+		earned := []phase0.ValidatorIndex{1}
+		if slot != 2 {
+			earned = append(earned, 2)
+		}
+		if slot == 4 {
+			earned = append(earned, 3)
+		}
+		s.recordParticipation(duty, expected, earned)
+	}
+	// Nothing is reported until the epoch is complete.
+	require.Empty(t, monitor.reports)
+
+	// A duty in the following epoch completes epoch 0.
+	s.recordParticipation(synccommitteemessenger.NewDuty(40, contributionIndices), []phase0.ValidatorIndex{1}, nil)
+	require.Len(t, monitor.reports, 1)
+	require.Equal(t, map[phase0.ValidatorIndex]float64{
+		1: 1,
+		2: 0.75,
+		3: 0.25,
+	}, monitor.reports[0])
+}
+
+func TestParticipationRewardsEmpty(t *testing.T) {
+	rewards := participationRewards(map[phase0.ValidatorIndex]*validatorParticipation{
+		1: {},
+		2: {expected: 2, earned: 0},
+	})
+	require.Equal(t, map[phase0.ValidatorIndex]float64{2: 0}, rewards)
+}
+
+func TestMessageParticipationRewards(t *testing.T) {
+	ctx := context.Background()
+	monitor := &participationMonitor{Service: nullmetrics.New(ctx)}
+	s := testParticipationService(ctx, t, monitor)
+
+	contributionIndices := map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+		1: {1},
+		2: {130, 260},
+	}
+	_, err := s.Message(ctx, synccommitteemessenger.NewDuty(10, contributionIndices))
+	require.NoError(t, err)
+	require.Empty(t, monitor.reports)
+
+	_, err = s.Message(ctx, synccommitteemessenger.NewDuty(40, contributionIndices))
+	require.NoError(t, err)
+	require.Len(t, monitor.reports, 1)
+	require.Equal(t, map[phase0.ValidatorIndex]float64{
+		1: 1,
+		2: 1,
+	}, monitor.reports[0])
+}
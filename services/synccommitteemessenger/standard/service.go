@@ -14,10 +14,13 @@
 package standard
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,30 +34,59 @@ import (
 	"github.com/attestantio/vouch/services/submitter"
 	"github.com/attestantio/vouch/services/synccommitteeaggregator"
 	"github.com/attestantio/vouch/services/synccommitteemessenger"
+	"github.com/attestantio/vouch/util"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
 	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
 	"go.opentelemetry.io/otel"
+	"golang.org/x/sync/semaphore"
 )
 
 // Service is a beacon block attester.
 type Service struct {
 	monitor                           metrics.SyncCommitteeMessageMonitor
-	processConcurrency                int64
+	processConcurrency                *util.DynamicProcessConcurrency
 	slotsPerEpoch                     uint64
 	syncCommitteeSize                 uint64
 	syncCommitteeSubnetCount          uint64
 	targetAggregatorsPerSyncCommittee uint64
+	epochsPerSyncCommitteePeriod      uint64
+	aggregatorModulo                  uint64
+	neverAggregate                    bool
 	chainTimeService                  chaintime.Service
 	syncCommitteeAggregator           synccommitteeaggregator.Service
 	validatingAccountsProvider        accountmanager.ValidatingAccountsProvider
 	beaconBlockRootProvider           eth2client.BeaconBlockRootProvider
+	beaconBlockRootPolicy             synccommitteemessenger.BeaconBlockRootPolicy
+	signingEpochPolicy                synccommitteemessenger.SigningEpochPolicy
 	syncCommitteeMessagesSubmitter    submitter.SyncCommitteeMessagesSubmitter
 	syncCommitteeSelectionSigner      signer.SyncCommitteeSelectionSigner
+	syncCommitteeSelectionsSigner     signer.SyncCommitteeSelectionsSigner
 	syncCommitteeRootSigner           signer.SyncCommitteeRootSigner
+	subcommittees                     *subcommitteesCache
+	emptyRootRetries                  int
+	emptyRootRetryInterval            time.Duration
+	syncCommitteesProvider            eth2client.SyncCommitteesProvider
+	syncCommitteeMembers              *syncCommitteeMembers
+	// priorityIndices maps priority validators to their position in the signing order.
+	priorityIndices map[phase0.ValidatorIndex]int
+	// receivedDuties are the duties received, for reconciliation against
+	// sync committee membership.
+	receivedDuties *receivedDuties
+	// signedMessages are the messages produced, to suppress duplicates.
+	signedMessages *signedMessages
+	// aggregatorSelections are the aggregator selections, for diagnostics.
+	aggregatorSelections *aggregatorSelections
+	// syncParticipation is the participation of validators, for reward estimates.
+	syncParticipation *syncParticipation
+	// signerLatency is the latency of the signer, to detect degradation.
+	signerLatency *util.SignerLatency
 }
 
+// mainnetGenesisForkVersion is the genesis fork version of mainnet.
+var mainnetGenesisForkVersion = phase0.Version{0x00, 0x00, 0x00, 0x00}
+
 // module-wide log.
 var log zerolog.Logger
 
@@ -96,20 +128,99 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		return nil, errors.Wrap(err, "failed to obtain TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE from spec")
 	}
 
+	epochsPerSyncCommitteePeriod, err := specUint64(spec, "EPOCHS_PER_SYNC_COMMITTEE_PERIOD")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain EPOCHS_PER_SYNC_COMMITTEE_PERIOD from spec")
+	}
+
+	aggregatorModulo := syncCommitteeSize / syncCommitteeSubnetCount / targetAggregatorsPerSyncCommittee
+	if aggregatorModulo < 1 {
+		aggregatorModulo = 1
+	}
+	neverAggregate := false
+	if parameters.aggregatorSelectionOverride != "" {
+		if err := checkAggregatorSelectionOverrideAllowed(spec); err != nil {
+			return nil, err
+		}
+		aggregatorModulo, neverAggregate, err = parseAggregatorSelectionOverride(parameters.aggregatorSelectionOverride)
+		if err != nil {
+			return nil, err
+		}
+		log.Warn().Str("override", parameters.aggregatorSelectionOverride).Msg("Aggregator selection override in place; this should only be used on test networks")
+	}
+
+	if parameters.signingEpochPolicy != synccommitteemessenger.SigningEpochPolicySlot {
+		if err := checkTestNetworkOnly(spec, "signing epoch policy"); err != nil {
+			return nil, err
+		}
+		log.Warn().Str("policy", string(parameters.signingEpochPolicy)).Msg("Non-standard signing epoch policy in place; this should only be used on test networks")
+	}
+
+	priorityIndices := make(map[phase0.ValidatorIndex]int, len(parameters.priorityIndices))
+	for i, index := range parameters.priorityIndices {
+		if _, exists := priorityIndices[index]; !exists {
+			priorityIndices[index] = i
+		}
+	}
+
+	var signerLatency *util.SignerLatency
+	if parameters.signerLatencyThreshold > 0 {
+		signerLatency = util.NewSignerLatency(parameters.signerLatencyThreshold)
+	}
+
 	s := &Service{
 		monitor:                           parameters.monitor,
-		processConcurrency:                parameters.processConcurrency,
+		processConcurrency:                util.NewDynamicProcessConcurrency(parameters.processConcurrency, parameters.maxProcessConcurrency, signerLatency, parameters.signerDegradedConcurrency),
 		slotsPerEpoch:                     slotsPerEpoch,
 		syncCommitteeSize:                 syncCommitteeSize,
 		syncCommitteeSubnetCount:          syncCommitteeSubnetCount,
 		targetAggregatorsPerSyncCommittee: targetAggregatorsPerSyncCommittee,
+		epochsPerSyncCommitteePeriod:      epochsPerSyncCommitteePeriod,
+		aggregatorModulo:                  aggregatorModulo,
+		neverAggregate:                    neverAggregate,
 		chainTimeService:                  parameters.chainTimeService,
 		syncCommitteeAggregator:           parameters.syncCommitteeAggregator,
 		validatingAccountsProvider:        parameters.validatingAccountsProvider,
 		beaconBlockRootProvider:           parameters.beaconBlockRootProvider,
+		beaconBlockRootPolicy:             parameters.beaconBlockRootPolicy,
+		signingEpochPolicy:                parameters.signingEpochPolicy,
 		syncCommitteeMessagesSubmitter:    parameters.syncCommitteeMessagesSubmitter,
 		syncCommitteeSelectionSigner:      parameters.syncCommitteeSelectionSigner,
+		syncCommitteeSelectionsSigner:     parameters.syncCommitteeSelectionsSigner,
 		syncCommitteeRootSigner:           parameters.syncCommitteeRootSigner,
+		subcommittees:                     newSubcommitteesCache(syncCommitteeSize, syncCommitteeSubnetCount),
+		priorityIndices:                   priorityIndices,
+		emptyRootRetries:                  parameters.emptyRootRetries,
+		emptyRootRetryInterval:            parameters.emptyRootRetryInterval,
+		syncCommitteesProvider:            parameters.syncCommitteesProvider,
+		syncCommitteeMembers:              newSyncCommitteeMembers(),
+		aggregatorSelections:              newAggregatorSelections(),
+		syncParticipation:                 newSyncParticipation(),
+		signerLatency:                     signerLatency,
+	}
+	if !parameters.allowDuplicateMessages {
+		s.signedMessages = newSignedMessages()
+	}
+
+	if parameters.signerSelfCheck {
+		if err := s.signerSelfCheck(ctx); err != nil {
+			return nil, errors.Wrap(err, "signer self check failed")
+		}
+	}
+
+	if parameters.scheduler != nil && s.syncCommitteesProvider != nil {
+		// Duties for the current epoch may have been missed before we started.
+		s.receivedDuties = newReceivedDuties(s.chainTimeService.CurrentEpoch() + 1)
+		if err := parameters.scheduler.SchedulePeriodicJob(ctx,
+			"Sync committee duty gaps",
+			"Sync committee duty gaps",
+			s.dutyGapsRuntime,
+			nil,
+			s.checkDutyGaps,
+			nil,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to schedule sync committee duty gap check")
+		}
 	}
 
 	return s, nil
@@ -127,27 +238,80 @@ func (s *Service) Prepare(ctx context.Context, data interface{}) error {
 		return errors.New("passed invalid data structure")
 	}
 
-	// Decide if we are an aggregator.
+	period := uint64(s.chainTimeService.SlotToEpoch(duty.Slot())) / s.epochsPerSyncCommitteePeriod
+
+	// Gather the selections that need to be signed.
+	validatorIndices := make([]phase0.ValidatorIndex, 0, len(duty.ValidatorIndices()))
+	accounts := make([]e2wtypes.Account, 0, len(duty.ValidatorIndices()))
+	subcommitteeIndices := make([]uint64, 0, len(duty.ValidatorIndices()))
 	for _, validatorIndex := range duty.ValidatorIndices() {
-		subcommittees := make(map[uint64]bool)
-		for _, contributionIndex := range duty.ContributionIndices()[validatorIndex] {
-			subcommittee := uint64(contributionIndex) / (s.syncCommitteeSize / s.syncCommitteeSubnetCount)
-			subcommittees[subcommittee] = true
+		subcommittees := s.subcommittees.get(period, validatorIndex, duty.ContributionIndices()[validatorIndex])
+		for _, subcommittee := range subcommittees {
+			validatorIndices = append(validatorIndices, validatorIndex)
+			accounts = append(accounts, duty.Account(validatorIndex))
+			subcommitteeIndices = append(subcommitteeIndices, subcommittee)
 		}
-		for subcommittee := range subcommittees {
-			isAggregator, sig, err := s.isAggregator(ctx, duty.Account(validatorIndex), duty.Slot(), subcommittee)
-			if err != nil {
-				return errors.Wrap(err, "failed to calculate if this is an aggregator")
-			}
-			if isAggregator {
-				duty.SetAggregatorSubcommittees(validatorIndex, subcommittee, sig)
-			}
+	}
+
+	if e := log.Trace(); e.Enabled() {
+		e.Uint64("slot", uint64(duty.Slot())).Uints64("subnets", s.Subnets(ctx, duty)).Msg("Sync committee subnets for duty")
+	}
+
+	sigs, err := s.signSyncCommitteeSelections(ctx, accounts, duty.Slot(), subcommitteeIndices)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign sync committee selections")
+	}
+
+	// Decide if we are an aggregator.
+	selected := 0
+	for i := range sigs {
+		isAggregator, err := s.isAggregator(sigs[i])
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate if this is an aggregator")
+		}
+		if isAggregator {
+			selected++
+			duty.SetAggregatorSubcommittees(validatorIndices[i], subcommitteeIndices[i], sigs[i])
 		}
 	}
+	s.recordAggregatorSelections(period, len(sigs), selected)
 
 	return nil
 }
 
+// signSyncCommitteeSelections signs the sync committee selections, with a
+// single call to the signer if it supports it.
+func (s *Service) signSyncCommitteeSelections(ctx context.Context,
+	accounts []e2wtypes.Account,
+	slot phase0.Slot,
+	subcommitteeIndices []uint64,
+) (
+	[]phase0.BLSSignature,
+	error,
+) {
+	if s.syncCommitteeSelectionsSigner != nil {
+		sigs, err := s.syncCommitteeSelectionsSigner.SignSyncCommitteeSelections(ctx, accounts, slot, subcommitteeIndices)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign the slots")
+		}
+		if len(sigs) != len(accounts) {
+			return nil, fmt.Errorf("expected %d signatures, received %d", len(accounts), len(sigs))
+		}
+		return sigs, nil
+	}
+
+	sigs := make([]phase0.BLSSignature, len(accounts))
+	for i := range accounts {
+		var err error
+		sigs[i], err = s.syncCommitteeSelectionSigner.SignSyncCommitteeSelection(ctx, accounts[i], slot, subcommitteeIndices[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign the slot")
+		}
+	}
+
+	return sigs, nil
+}
+
 // Message generates and broadcasts sync committee messages for a slot.
 // It returns a list of messages made.
 func (s *Service) Message(ctx context.Context, data interface{}) ([]*altair.SyncCommitteeMessage, error) {
@@ -161,65 +325,132 @@ func (s *Service) Message(ctx context.Context, data interface{}) ([]*altair.Sync
 		return nil, errors.New("passed invalid data structure")
 	}
 
-	// Fetch the beacon block root.
-	beaconBlockRoot, err := s.beaconBlockRootProvider.BeaconBlockRoot(ctx, "head")
-	if err != nil {
-		s.monitor.SyncCommitteeMessagesCompleted(started, duty.Slot(), len(duty.ValidatorIndices()), "failed")
-		return nil, errors.Wrap(err, "failed to obtain beacon block root")
+	if s.receivedDuties != nil {
+		s.recordDuty(duty.Slot(), duty.ValidatorIndices())
 	}
-	if beaconBlockRoot == nil {
+
+	// Fetch the beacon block root.  The aggregator is given the same root,
+	// so that its contributions match the messages signed here.
+	beaconBlockRoot, err := s.obtainBeaconBlockRoot(ctx)
+	if err != nil {
 		s.monitor.SyncCommitteeMessagesCompleted(started, duty.Slot(), len(duty.ValidatorIndices()), "failed")
-		return nil, errors.Wrap(err, "empty beacon block root obtained")
+		s.recordParticipation(duty, duty.ValidatorIndices(), nil)
+		return nil, err
 	}
-	log.Trace().Dur("elapsed", time.Since(started)).Msg("Obtained beacon block root")
+	log.Trace().Dur("elapsed", time.Since(started)).Str("policy", string(s.beaconBlockRootPolicy)).Msg("Obtained beacon block root")
 	s.syncCommitteeAggregator.SetBeaconBlockRoot(duty.Slot(), *beaconBlockRoot)
 
-	// Sign in parallel.
-	msgs := make([]*altair.SyncCommitteeMessage, 0, len(duty.ContributionIndices()))
-	var msgsMu sync.Mutex
+	// Sign in parallel, bounded by the process concurrency.
 	validatorIndices := make([]phase0.ValidatorIndex, 0, len(duty.ContributionIndices()))
 	for validatorIndex := range duty.ContributionIndices() {
 		validatorIndices = append(validatorIndices, validatorIndex)
 	}
+	// Guard against stale duties, for example around sync committee period boundaries.
+	validatorIndices = s.syncCommitteeMemberIndices(ctx, duty.Slot(), validatorIndices)
+	// Guard against overlapping duties, for example around sync committee period boundaries.
+	if len(validatorIndices) > 0 {
+		validatorIndices = s.claimMessages(duty.Slot(), *beaconBlockRoot, validatorIndices)
+		if len(validatorIndices) == 0 {
+			log.Trace().Dur("elapsed", time.Since(started)).Msg("All sync committee messages already produced")
+			return []*altair.SyncCommitteeMessage{}, nil
+		}
+	}
+	// Signing starts in order, and messages are submitted in the same order, so
+	// that priority validators are not held up behind others.
+	s.orderValidatorIndices(validatorIndices)
+	signedMsgs := make([]*altair.SyncCommitteeMessage, len(validatorIndices))
+	sem := semaphore.NewWeighted(s.processConcurrency.For(log, len(validatorIndices)))
 	var wg sync.WaitGroup
 	for i := range validatorIndices {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			log.Error().Err(err).Msg("Failed to obtain semaphore")
+			for _, validatorIndex := range validatorIndices[i:] {
+				s.releaseMessage(duty.Slot(), *beaconBlockRoot, validatorIndex)
+			}
+			break
+		}
 		wg.Add(1)
 		go func(ctx context.Context,
 			wg *sync.WaitGroup,
 			i int,
 		) {
 			defer wg.Done()
-			sig, err := s.contribute(ctx, duty.Account(validatorIndices[i]), s.chainTimeService.SlotToEpoch(duty.Slot()+1), *beaconBlockRoot)
+			defer sem.Release(1)
+			sig, err := s.contribute(ctx, duty.Account(validatorIndices[i]), s.messageSigningEpoch(duty.Slot()), *beaconBlockRoot)
 			if err != nil {
 				log.Error().Err(err).Msg("Failed to sign sync committee message")
+				s.releaseMessage(duty.Slot(), *beaconBlockRoot, validatorIndices[i])
 				return
 			}
 			log.Trace().Uint64("slot", uint64(duty.Slot())).Uint64("validator_index", uint64(validatorIndices[i])).Str("signature", fmt.Sprintf("%#x", sig)).Msg("Signed sync committee message")
 
-			msg := &altair.SyncCommitteeMessage{
+			// Each goroutine writes only its own element, so no lock is required.
+			signedMsgs[i] = &altair.SyncCommitteeMessage{
 				Slot:            duty.Slot(),
 				BeaconBlockRoot: *beaconBlockRoot,
 				ValidatorIndex:  validatorIndices[i],
 				Signature:       sig,
 			}
-			msgsMu.Lock()
-			msgs = append(msgs, msg)
-			msgsMu.Unlock()
 		}(ctx, &wg, i)
 	}
 	wg.Wait()
 
+	msgs := make([]*altair.SyncCommitteeMessage, 0, len(signedMsgs))
+	for _, msg := range signedMsgs {
+		if msg != nil {
+			msgs = append(msgs, msg)
+		}
+	}
+
 	if err := s.syncCommitteeMessagesSubmitter.SubmitSyncCommitteeMessages(ctx, msgs); err != nil {
 		log.Trace().Dur("elapsed", time.Since(started)).Err(err).Msg("Failed to submit sync committee messages")
 		s.monitor.SyncCommitteeMessagesCompleted(started, duty.Slot(), len(msgs), "failed")
+		// The messages were not submitted, so release them to allow a later duty to produce them.
+		for _, msg := range msgs {
+			s.releaseMessage(duty.Slot(), *beaconBlockRoot, msg.ValidatorIndex)
+		}
+		s.recordParticipation(duty, validatorIndices, nil)
 		return nil, errors.Wrap(err, "failed to submit sync committee messages")
 	}
 	log.Trace().Dur("elapsed", time.Since(started)).Msg("Submitted sync committee messages")
 	s.monitor.SyncCommitteeMessagesCompleted(started, duty.Slot(), len(msgs), "succeeded")
+	submitted := make([]phase0.ValidatorIndex, 0, len(msgs))
+	for _, msg := range msgs {
+		submitted = append(submitted, msg.ValidatorIndex)
+	}
+	s.recordParticipation(duty, validatorIndices, submitted)
 
 	return msgs, nil
 }
 
+// messageMembershipEpoch returns the epoch for which sync committee membership
+// is calculated for a sync committee message for the given slot.
+//
+// A sync committee message for a slot is included in the block at the
+// following slot, so sync committee membership (and hence the duty itself) is
+// calculated for slot+1.  At the last slot of a sync committee period this is
+// the committee for the following period.
+func (s *Service) messageMembershipEpoch(slot phase0.Slot) phase0.Epoch {
+	return s.chainTimeService.SlotToEpoch(slot + 1)
+}
+
+// messageSigningEpoch returns the epoch whose domain is used to sign a sync
+// committee message for the given slot, according to the signing epoch policy.
+//
+// It is tempting to use the membership epoch of slot+1 when selecting the
+// signing domain, however the spec uses the epoch of the slot the message is
+// for, as this is the domain against which the message is verified when
+// included.  Within a fork the two are interchangeable, as the domain only
+// changes with the fork version, but at the last slot before a fork slot+1 is
+// in the new fork and would result in an invalid signature.  The next slot
+// policy is available for custom networks that require it.
+func (s *Service) messageSigningEpoch(slot phase0.Slot) phase0.Epoch {
+	if s.signingEpochPolicy == synccommitteemessenger.SigningEpochPolicyNextSlot {
+		return s.messageMembershipEpoch(slot)
+	}
+	return s.chainTimeService.SlotToEpoch(slot)
+}
+
 func (s *Service) contribute(ctx context.Context,
 	account e2wtypes.Account,
 	epoch phase0.Epoch,
@@ -230,37 +461,75 @@ func (s *Service) contribute(ctx context.Context,
 ) {
 	ctx, span := otel.Tracer("attestantio.vouch.services.synccommitteemessenger.standard").Start(ctx, "contribute")
 	defer span.End()
+	started := time.Now()
 	sig, err := s.syncCommitteeRootSigner.SignSyncCommitteeRoot(ctx, account, epoch, root)
+	s.signerLatency.Observe(log, "sync committee messages", time.Since(started), s.monitor.SyncCommitteeMessageSignerDegraded)
 	if err != nil {
 		return phase0.BLSSignature{}, err
 	}
 	return sig, err
 }
 
-func (s *Service) isAggregator(ctx context.Context, account e2wtypes.Account, slot phase0.Slot, subcommitteeIndex uint64) (bool, phase0.BLSSignature, error) {
-	modulo := s.syncCommitteeSize / s.syncCommitteeSubnetCount / s.targetAggregatorsPerSyncCommittee
-	if modulo < 1 {
-		modulo = 1
-	}
-
-	// Sign the slot.
-	signature, err := s.syncCommitteeSelectionSigner.SignSyncCommitteeSelection(ctx, account, slot, subcommitteeIndex)
-	if err != nil {
-		return false, phase0.BLSSignature{}, errors.Wrap(err, "failed to sign the slot")
+// isAggregator returns true if the selection signature shows that the validator is an aggregator.
+func (s *Service) isAggregator(signature phase0.BLSSignature) (bool, error) {
+	if s.neverAggregate {
+		return false, nil
 	}
 
 	// Hash the signature.
 	sigHash := sha256.New()
 	n, err := sigHash.Write(signature[:])
 	if err != nil {
-		return false, phase0.BLSSignature{}, errors.Wrap(err, "failed to hash the slot signature")
+		return false, errors.Wrap(err, "failed to hash the slot signature")
 	}
 	if n != len(signature) {
-		return false, phase0.BLSSignature{}, errors.New("failed to write all bytes of the slot signature to the hash")
+		return false, errors.New("failed to write all bytes of the slot signature to the hash")
 	}
 	hash := sigHash.Sum(nil)
 
-	return binary.LittleEndian.Uint64(hash[:8])%modulo == 0, signature, nil
+	return binary.LittleEndian.Uint64(hash[:8])%s.aggregatorModulo == 0, nil
+}
+
+// checkAggregatorSelectionOverrideAllowed ensures that the aggregator selection
+// override is not used on mainnet.  If the genesis fork version cannot be
+// obtained the override is refused.
+func checkAggregatorSelectionOverrideAllowed(spec map[string]interface{}) error {
+	return checkTestNetworkOnly(spec, "aggregator selection override")
+}
+
+// checkTestNetworkOnly ensures that the named feature is not used on mainnet.
+// If the genesis fork version cannot be obtained the feature is refused.
+func checkTestNetworkOnly(spec map[string]interface{}, feature string) error {
+	tmp, exists := spec["GENESIS_FORK_VERSION"]
+	if !exists {
+		return fmt.Errorf("cannot confirm network is not mainnet; %s refused", feature)
+	}
+	genesisForkVersion, ok := tmp.(phase0.Version)
+	if !ok {
+		return fmt.Errorf("GENESIS_FORK_VERSION of unexpected type; %s refused", feature)
+	}
+	if bytes.Equal(genesisForkVersion[:], mainnetGenesisForkVersion[:]) {
+		return fmt.Errorf("%s not allowed on mainnet", feature)
+	}
+
+	return nil
+}
+
+// parseAggregatorSelectionOverride parses the aggregator selection override,
+// returning the modulo to use and if aggregation should never take place.
+func parseAggregatorSelectionOverride(override string) (uint64, bool, error) {
+	switch strings.ToLower(override) {
+	case "always":
+		return 1, false, nil
+	case "never":
+		return 0, true, nil
+	default:
+		modulo, err := strconv.ParseUint(override, 10, 64)
+		if err != nil || modulo < 1 {
+			return 0, false, fmt.Errorf("invalid aggregator selection override %q", override)
+		}
+		return modulo, false, nil
+	}
 }
 
 func specUint64(spec map[string]interface{}, item string) (uint64, error) {
@@ -0,0 +1,238 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAggregatorSelectionOverrideAllowed(t *testing.T) {
+	tests := []struct {
+		name string
+		spec map[string]interface{}
+		err  string
+	}{
+		{
+			name: "Missing",
+			spec: map[string]interface{}{},
+			err:  "cannot confirm network is not mainnet; aggregator selection override refused",
+		},
+		{
+			name: "WrongType",
+			spec: map[string]interface{}{
+				"GENESIS_FORK_VERSION": "0x00000000",
+			},
+			err: "GENESIS_FORK_VERSION of unexpected type; aggregator selection override refused",
+		},
+		{
+			name: "Mainnet",
+			spec: map[string]interface{}{
+				"GENESIS_FORK_VERSION": phase0.Version{0x00, 0x00, 0x00, 0x00},
+			},
+			err: "aggregator selection override not allowed on mainnet",
+		},
+		{
+			name: "Testnet",
+			spec: map[string]interface{}{
+				"GENESIS_FORK_VERSION": phase0.Version{0x00, 0x00, 0x10, 0x20},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkAggregatorSelectionOverrideAllowed(test.spec)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsAggregatorOverride(t *testing.T) {
+	tests := []struct {
+		name       string
+		override   string
+		err        string
+		aggregator bool
+	}{
+		{
+			name:     "Invalid",
+			override: "sometimes",
+			err:      `invalid aggregator selection override "sometimes"`,
+		},
+		{
+			name:     "Zero",
+			override: "0",
+			err:      `invalid aggregator selection override "0"`,
+		},
+		{
+			name:       "Always",
+			override:   "always",
+			aggregator: true,
+		},
+		{
+			name:     "Never",
+			override: "never",
+		},
+		{
+			name:       "ModuloOne",
+			override:   "1",
+			aggregator: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			modulo, neverAggregate, err := parseAggregatorSelectionOverride(test.override)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			s := &Service{
+				aggregatorModulo: modulo,
+				neverAggregate:   neverAggregate,
+			}
+			for i := 0; i < 16; i++ {
+				isAggregator, err := s.isAggregator(phase0.BLSSignature{byte(i)})
+				require.NoError(t, err)
+				require.Equal(t, test.aggregator, isAggregator)
+			}
+		})
+	}
+}
+
+// periodSyncCommitteesProvider returns a sync committee for each period.
+type periodSyncCommitteesProvider struct {
+	epochsPerPeriod uint64
+	periods         map[uint64][]phase0.ValidatorIndex
+}
+
+func (p *periodSyncCommitteesProvider) SyncCommitteeAtEpoch(_ context.Context, _ string, epoch phase0.Epoch) (*apiv1.SyncCommittee, error) {
+	return &apiv1.SyncCommittee{
+		Validators: p.periods[uint64(epoch)/p.epochsPerPeriod],
+	}, nil
+}
+
+func (p *periodSyncCommitteesProvider) SyncCommittee(ctx context.Context, stateID string) (*apiv1.SyncCommittee, error) {
+	return p.SyncCommitteeAtEpoch(ctx, stateID, 0)
+}
+
+func TestMessageSigningEpochBoundaries(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	// Sync committee periods of 4 epochs, so the last slot of period 0 is 127.
+	epochsPerPeriod := uint64(4)
+	provider := &periodSyncCommitteesProvider{
+		epochsPerPeriod: epochsPerPeriod,
+		periods: map[uint64][]phase0.ValidatorIndex{
+			0: {1, 2},
+			1: {2, 3},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		policy          synccommitteemessenger.SigningEpochPolicy
+		slot            phase0.Slot
+		signingEpoch    phase0.Epoch
+		membershipEpoch phase0.Epoch
+		members         []phase0.ValidatorIndex
+	}{
+		{
+			name:            "MidEpoch",
+			policy:          synccommitteemessenger.SigningEpochPolicySlot,
+			slot:            10,
+			signingEpoch:    0,
+			membershipEpoch: 0,
+			members:         []phase0.ValidatorIndex{1, 2},
+		},
+		{
+			name:            "LastSlotOfEpoch",
+			policy:          synccommitteemessenger.SigningEpochPolicySlot,
+			slot:            31,
+			signingEpoch:    0,
+			membershipEpoch: 1,
+			members:         []phase0.ValidatorIndex{1, 2},
+		},
+		{
+			name:            "LastSlotOfEpochNextSlot",
+			policy:          synccommitteemessenger.SigningEpochPolicyNextSlot,
+			slot:            31,
+			signingEpoch:    1,
+			membershipEpoch: 1,
+			members:         []phase0.ValidatorIndex{1, 2},
+		},
+		{
+			// The message is for the committee of the following period,
+			// but is signed with the domain of the current epoch.
+			name:            "LastSlotOfPeriod",
+			policy:          synccommitteemessenger.SigningEpochPolicySlot,
+			slot:            127,
+			signingEpoch:    3,
+			membershipEpoch: 4,
+			members:         []phase0.ValidatorIndex{2, 3},
+		},
+		{
+			name:            "LastSlotOfPeriodNextSlot",
+			policy:          synccommitteemessenger.SigningEpochPolicyNextSlot,
+			slot:            127,
+			signingEpoch:    4,
+			membershipEpoch: 4,
+			members:         []phase0.ValidatorIndex{2, 3},
+		},
+		{
+			name:            "FirstSlotOfPeriod",
+			policy:          synccommitteemessenger.SigningEpochPolicySlot,
+			slot:            128,
+			signingEpoch:    4,
+			membershipEpoch: 4,
+			members:         []phase0.ValidatorIndex{2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				chainTimeService:             chainTime,
+				signingEpochPolicy:           test.policy,
+				epochsPerSyncCommitteePeriod: epochsPerPeriod,
+				syncCommitteesProvider:       provider,
+				syncCommitteeMembers:         newSyncCommitteeMembers(),
+			}
+			require.Equal(t, test.signingEpoch, s.messageSigningEpoch(test.slot))
+			require.Equal(t, test.membershipEpoch, s.messageMembershipEpoch(test.slot))
+			require.Equal(t, test.members, s.syncCommitteeMemberIndices(ctx, test.slot, []phase0.ValidatorIndex{1, 2, 3}))
+		})
+	}
+}
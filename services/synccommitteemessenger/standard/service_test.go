@@ -15,10 +15,15 @@ package standard_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	mocketh2client "github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/mock"
 	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
 	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
@@ -26,10 +31,12 @@ import (
 	mocksigner "github.com/attestantio/vouch/services/signer/mock"
 	nullsubmitter "github.com/attestantio/vouch/services/submitter/null"
 	mocksynccommitteeaggregator "github.com/attestantio/vouch/services/synccommitteeaggregator/mock"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
 	"github.com/attestantio/vouch/services/synccommitteemessenger/standard"
 	"github.com/attestantio/vouch/testing/logger"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
 )
 
 func TestService(t *testing.T) {
@@ -253,6 +260,139 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no sync committee subscriptions submitter specified",
 		},
+		{
+			name: "AggregatorSelectionOverrideMainnet",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mockSyncCommitteeAggregator),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeRootSigner(mockSigner),
+				standard.WithSyncCommitteeSelectionSigner(mockSigner),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+				standard.WithAggregatorSelectionOverride("always"),
+			},
+			err: "aggregator selection override not allowed on mainnet",
+		},
+		{
+			name: "SigningEpochPolicyInvalid",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mockSyncCommitteeAggregator),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeRootSigner(mockSigner),
+				standard.WithSyncCommitteeSelectionSigner(mockSigner),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+				standard.WithSigningEpochPolicy("next-epoch"),
+			},
+			err: "problem with parameters: invalid signing epoch policy: unrecognised signing epoch policy \"next-epoch\"",
+		},
+		{
+			name: "SigningEpochPolicyMainnet",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mockSyncCommitteeAggregator),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeRootSigner(mockSigner),
+				standard.WithSyncCommitteeSelectionSigner(mockSigner),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+				standard.WithSigningEpochPolicy("next-slot"),
+			},
+			err: "signing epoch policy not allowed on mainnet",
+		},
+		{
+			name: "BeaconBlockRootPolicyInvalid",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mockSyncCommitteeAggregator),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeRootSigner(mockSigner),
+				standard.WithSyncCommitteeSelectionSigner(mockSigner),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+				standard.WithBeaconBlockRootPolicy("latest"),
+			},
+			err: "problem with parameters: invalid beacon block root policy: unrecognised beacon block root policy \"latest\"",
+		},
+		{
+			name: "EmptyRootRetriesNegative",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mockSyncCommitteeAggregator),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeRootSigner(mockSigner),
+				standard.WithSyncCommitteeSelectionSigner(mockSigner),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+				standard.WithEmptyRootRetries(-1),
+			},
+			err: "problem with parameters: empty root retries cannot be negative",
+		},
+		{
+			name: "EmptyRootRetryIntervalZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mockSyncCommitteeAggregator),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeRootSigner(mockSigner),
+				standard.WithSyncCommitteeSelectionSigner(mockSigner),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+				standard.WithEmptyRootRetryInterval(0),
+			},
+			err: "problem with parameters: empty root retry interval must be positive",
+		},
+		{
+			name: "SignerLatencyThresholdNegative",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mockSyncCommitteeAggregator),
+				standard.WithSpecProvider(specProvider),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockValidatingAccountsProvider),
+				standard.WithSyncCommitteeRootSigner(mockSigner),
+				standard.WithSyncCommitteeSelectionSigner(mockSigner),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+				standard.WithSignerLatencyThreshold(-time.Second),
+			},
+			err: "problem with parameters: signer latency threshold cannot be negative",
+		},
 		{
 			name: "Good",
 			params: []standard.Parameter{
@@ -287,3 +427,643 @@ func TestService(t *testing.T) {
 		})
 	}
 }
+
+// forkAwareSyncCommitteeRootSigner records the fork version of the domain that would be used for signing.
+type forkAwareSyncCommitteeRootSigner struct {
+	forkEpoch    phase0.Epoch
+	forkVersions []phase0.Version
+}
+
+func (s *forkAwareSyncCommitteeRootSigner) SignSyncCommitteeRoot(_ context.Context,
+	_ e2wtypes.Account,
+	epoch phase0.Epoch,
+	_ phase0.Root,
+) (
+	phase0.BLSSignature,
+	error,
+) {
+	if epoch < s.forkEpoch {
+		s.forkVersions = append(s.forkVersions, phase0.Version{0x01})
+	} else {
+		s.forkVersions = append(s.forkVersions, phase0.Version{0x02})
+	}
+	return phase0.BLSSignature{}, nil
+}
+
+func TestMessageForkBoundary(t *testing.T) {
+	ctx := context.Background()
+
+	// Fork takes place at the start of epoch 2, i.e. slot 64.
+	forkEpoch := phase0.Epoch(2)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+	nullSubmitter, err := nullsubmitter.New(ctx)
+	require.NoError(t, err)
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		slot        phase0.Slot
+		forkVersion phase0.Version
+	}{
+		{
+			name:        "PreFork",
+			slot:        62,
+			forkVersion: phase0.Version{0x01},
+		},
+		{
+			name:        "LastSlotBeforeFork",
+			slot:        63,
+			forkVersion: phase0.Version{0x01},
+		},
+		{
+			name:        "FirstSlotOfFork",
+			slot:        64,
+			forkVersion: phase0.Version{0x02},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rootSigner := &forkAwareSyncCommitteeRootSigner{forkEpoch: forkEpoch}
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mocksynccommitteeaggregator.New()),
+				standard.WithSpecProvider(mock.NewSpecProvider()),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+				standard.WithSyncCommitteeRootSigner(rootSigner),
+				standard.WithSyncCommitteeSelectionSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+			)
+			require.NoError(t, err)
+
+			duty := synccommitteemessenger.NewDuty(test.slot, map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+				1: {1},
+			})
+			msgs, err := s.Message(ctx, duty)
+			require.NoError(t, err)
+			require.Len(t, msgs, 1)
+			require.Equal(t, []phase0.Version{test.forkVersion}, rootSigner.forkVersions)
+		})
+	}
+}
+
+// blockIDBeaconBlockRootProvider returns a different beacon block root for each block ID.
+type blockIDBeaconBlockRootProvider struct {
+	roots map[string]phase0.Root
+}
+
+func (p *blockIDBeaconBlockRootProvider) BeaconBlockRoot(_ context.Context, blockID string) (*phase0.Root, error) {
+	root, exists := p.roots[blockID]
+	if !exists {
+		return nil, fmt.Errorf("unknown block ID %s", blockID)
+	}
+
+	return &root, nil
+}
+
+// recordingSyncCommitteeAggregator records the beacon block roots it is given.
+type recordingSyncCommitteeAggregator struct {
+	*mocksynccommitteeaggregator.Service
+	roots map[phase0.Slot]phase0.Root
+}
+
+func (a *recordingSyncCommitteeAggregator) SetBeaconBlockRoot(slot phase0.Slot, root phase0.Root) {
+	a.roots[slot] = root
+}
+
+func TestMessageBeaconBlockRootPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+	nullSubmitter, err := nullsubmitter.New(ctx)
+	require.NoError(t, err)
+
+	provider := &blockIDBeaconBlockRootProvider{
+		roots: map[string]phase0.Root{
+			"head":      {0x01},
+			"finalized": {0x02},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		policy synccommitteemessenger.BeaconBlockRootPolicy
+		root   phase0.Root
+	}{
+		{
+			name: "Default",
+			root: phase0.Root{0x01},
+		},
+		{
+			name:   "Head",
+			policy: synccommitteemessenger.BeaconBlockRootPolicyHead,
+			root:   phase0.Root{0x01},
+		},
+		{
+			name:   "Finalized",
+			policy: synccommitteemessenger.BeaconBlockRootPolicyFinalized,
+			root:   phase0.Root{0x02},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			aggregator := &recordingSyncCommitteeAggregator{
+				Service: mocksynccommitteeaggregator.New(),
+				roots:   make(map[phase0.Slot]phase0.Root),
+			}
+			params := []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(aggregator),
+				standard.WithSpecProvider(mock.NewSpecProvider()),
+				standard.WithBeaconBlockRootProvider(provider),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+				standard.WithSyncCommitteeRootSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSelectionSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+			}
+			if test.policy != "" {
+				params = append(params, standard.WithBeaconBlockRootPolicy(test.policy))
+			}
+			s, err := standard.New(ctx, params...)
+			require.NoError(t, err)
+
+			duty := synccommitteemessenger.NewDuty(10, map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+				1: {1},
+			})
+			msgs, err := s.Message(ctx, duty)
+			require.NoError(t, err)
+			require.Len(t, msgs, 1)
+			require.Equal(t, test.root, msgs[0].BeaconBlockRoot)
+			// The aggregator must use the same root as the signed messages.
+			require.Equal(t, test.root, aggregator.roots[10])
+		})
+	}
+}
+
+// emptyBeaconBlockRootProvider returns an empty beacon block root a number of
+// times before returning a root.
+type emptyBeaconBlockRootProvider struct {
+	empties int
+	calls   int
+}
+
+func (p *emptyBeaconBlockRootProvider) BeaconBlockRoot(_ context.Context, _ string) (*phase0.Root, error) {
+	p.calls++
+	if p.calls <= p.empties {
+		return nil, nil
+	}
+
+	return &phase0.Root{0x01}, nil
+}
+
+func TestMessageEmptyBeaconBlockRoot(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+	nullSubmitter, err := nullsubmitter.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		empties int
+		retries int
+		calls   int
+		err     string
+	}{
+		{
+			name:  "NotEmpty",
+			calls: 1,
+		},
+		{
+			name:    "EmptyOnce",
+			empties: 1,
+			retries: 2,
+			calls:   2,
+		},
+		{
+			name:    "EmptyNoRetries",
+			empties: 1,
+			calls:   1,
+			err:     "empty beacon block root obtained",
+		},
+		{
+			name:    "EmptyRetriesExhausted",
+			empties: 3,
+			retries: 2,
+			calls:   3,
+			err:     "empty beacon block root obtained",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := &emptyBeaconBlockRootProvider{empties: test.empties}
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mocksynccommitteeaggregator.New()),
+				standard.WithSpecProvider(mock.NewSpecProvider()),
+				standard.WithBeaconBlockRootProvider(provider),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+				standard.WithSyncCommitteeRootSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSelectionSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+				standard.WithEmptyRootRetries(test.retries),
+				standard.WithEmptyRootRetryInterval(time.Millisecond),
+			)
+			require.NoError(t, err)
+
+			duty := synccommitteemessenger.NewDuty(10, map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+				1: {1},
+			})
+			msgs, err := s.Message(ctx, duty)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, msgs, 1)
+				require.Equal(t, phase0.Root{0x01}, msgs[0].BeaconBlockRoot)
+			}
+			require.Equal(t, test.calls, provider.calls)
+		})
+	}
+}
+
+// staticSyncCommitteesProvider returns a fixed sync committee.
+type staticSyncCommitteesProvider struct {
+	validators []phase0.ValidatorIndex
+	err        error
+	calls      int
+}
+
+func (p *staticSyncCommitteesProvider) SyncCommittee(ctx context.Context, stateID string) (*apiv1.SyncCommittee, error) {
+	return p.SyncCommitteeAtEpoch(ctx, stateID, 0)
+}
+
+func (p *staticSyncCommitteesProvider) SyncCommitteeAtEpoch(_ context.Context, _ string, _ phase0.Epoch) (*apiv1.SyncCommittee, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return &apiv1.SyncCommittee{
+		Validators: p.validators,
+	}, nil
+}
+
+func TestMessageSyncCommitteeMembership(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+	nullSubmitter, err := nullsubmitter.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		provider *staticSyncCommitteesProvider
+		signed   []phase0.ValidatorIndex
+		logEntry string
+	}{
+		{
+			name:   "NoProvider",
+			signed: []phase0.ValidatorIndex{1, 2},
+		},
+		{
+			name: "AllMembers",
+			provider: &staticSyncCommitteesProvider{
+				validators: []phase0.ValidatorIndex{1, 2, 3},
+			},
+			signed: []phase0.ValidatorIndex{1, 2},
+		},
+		{
+			name: "StaleValidator",
+			provider: &staticSyncCommitteesProvider{
+				validators: []phase0.ValidatorIndex{1, 3},
+			},
+			signed:   []phase0.ValidatorIndex{1},
+			logEntry: "Validator in duty is not a member of the sync committee; not signing message",
+		},
+		{
+			name: "ProviderErrors",
+			provider: &staticSyncCommitteesProvider{
+				err: errors.New("unavailable"),
+			},
+			signed:   []phase0.ValidatorIndex{1, 2},
+			logEntry: "Failed to confirm sync committee membership; signing for all validators in duty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewLogCapture()
+			params := []standard.Parameter{
+				standard.WithLogLevel(zerolog.WarnLevel),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mocksynccommitteeaggregator.New()),
+				standard.WithSpecProvider(mock.NewSpecProvider()),
+				standard.WithBeaconBlockRootProvider(&emptyBeaconBlockRootProvider{}),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+				standard.WithSyncCommitteeRootSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSelectionSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+			}
+			if test.provider != nil {
+				params = append(params, standard.WithSyncCommitteesProvider(test.provider))
+			}
+			s, err := standard.New(ctx, params...)
+			require.NoError(t, err)
+
+			duty := synccommitteemessenger.NewDuty(10, map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+				1: {1},
+				2: {2},
+			})
+			msgs, err := s.Message(ctx, duty)
+			require.NoError(t, err)
+			signed := make([]phase0.ValidatorIndex, 0, len(msgs))
+			for _, msg := range msgs {
+				signed = append(signed, msg.ValidatorIndex)
+			}
+			require.ElementsMatch(t, test.signed, signed)
+			if test.logEntry != "" {
+				capture.AssertHasEntry(t, test.logEntry)
+			}
+
+			// Membership is cached for the period, unless it could not be obtained.
+			if test.provider != nil && test.provider.err == nil {
+				_, err = s.Message(ctx, duty)
+				require.NoError(t, err)
+				require.Equal(t, 1, test.provider.calls)
+			}
+		})
+	}
+}
+
+func TestMessageOrdering(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+	nullSubmitter, err := nullsubmitter.New(ctx)
+	require.NoError(t, err)
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		processConcurrency int64
+		priorityIndices    []phase0.ValidatorIndex
+		expected           []phase0.ValidatorIndex
+	}{
+		{
+			name:               "Index",
+			processConcurrency: 1,
+			expected:           []phase0.ValidatorIndex{1, 3, 5, 8, 13},
+		},
+		{
+			name:               "Priority",
+			processConcurrency: 1,
+			priorityIndices:    []phase0.ValidatorIndex{8, 3},
+			expected:           []phase0.ValidatorIndex{8, 3, 1, 5, 13},
+		},
+		{
+			name:               "PriorityUnknown",
+			processConcurrency: 1,
+			priorityIndices:    []phase0.ValidatorIndex{21, 13},
+			expected:           []phase0.ValidatorIndex{13, 1, 3, 5, 8},
+		},
+		{
+			name:               "PriorityDuplicate",
+			processConcurrency: 1,
+			priorityIndices:    []phase0.ValidatorIndex{5, 1, 5},
+			expected:           []phase0.ValidatorIndex{5, 1, 3, 8, 13},
+		},
+		{
+			name:               "PriorityConcurrent",
+			processConcurrency: 4,
+			priorityIndices:    []phase0.ValidatorIndex{8, 3},
+			expected:           []phase0.ValidatorIndex{8, 3, 1, 5, 13},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(test.processConcurrency),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mocksynccommitteeaggregator.New()),
+				standard.WithSpecProvider(mock.NewSpecProvider()),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+				standard.WithSyncCommitteeRootSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSelectionSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+				standard.WithPriorityIndices(test.priorityIndices),
+			)
+			require.NoError(t, err)
+
+			duty := synccommitteemessenger.NewDuty(10, map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+				13: {1},
+				5:  {2},
+				1:  {3},
+				8:  {4},
+				3:  {5},
+			})
+			msgs, err := s.Message(ctx, duty)
+			require.NoError(t, err)
+			signed := make([]phase0.ValidatorIndex, 0, len(msgs))
+			for _, msg := range msgs {
+				signed = append(signed, msg.ValidatorIndex)
+			}
+			require.Equal(t, test.expected, signed)
+		})
+	}
+}
+
+func TestMessageDuplicateDuties(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+	nullSubmitter, err := nullsubmitter.New(ctx)
+	require.NoError(t, err)
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	// Slot 8191 is the last slot of the first sync committee period, so its
+	// duties are for the incoming committee, which overlaps with the outgoing
+	// committee.
+	slot := phase0.Slot(8191)
+
+	tests := []struct {
+		name                   string
+		allowDuplicateMessages bool
+		expected               []phase0.ValidatorIndex
+	}{
+		{
+			name:     "Suppressed",
+			expected: []phase0.ValidatorIndex{3},
+		},
+		{
+			name:                   "Allowed",
+			allowDuplicateMessages: true,
+			expected:               []phase0.ValidatorIndex{2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProcessConcurrency(1),
+				standard.WithMonitor(nullmetrics.New(ctx)),
+				standard.WithChainTimeService(chainTime),
+				standard.WithSyncCommitteeAggregator(mocksynccommitteeaggregator.New()),
+				standard.WithSpecProvider(mock.NewSpecProvider()),
+				standard.WithBeaconBlockRootProvider(mockETH2Client),
+				standard.WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+				standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+				standard.WithSyncCommitteeRootSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSelectionSigner(mocksigner.New()),
+				standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+				standard.WithAllowDuplicateMessages(test.allowDuplicateMessages),
+			)
+			require.NoError(t, err)
+
+			// Validator 2 is in both the outgoing and incoming committees.
+			outgoingDuty := synccommitteemessenger.NewDuty(slot, map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+				1: {1},
+				2: {2},
+			})
+			incomingDuty := synccommitteemessenger.NewDuty(slot, map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+				2: {5},
+				3: {6},
+			})
+
+			msgs, err := s.Message(ctx, outgoingDuty)
+			require.NoError(t, err)
+			require.Len(t, msgs, 2)
+
+			msgs, err = s.Message(ctx, incomingDuty)
+			require.NoError(t, err)
+			signed := make([]phase0.ValidatorIndex, 0, len(msgs))
+			for _, msg := range msgs {
+				signed = append(signed, msg.ValidatorIndex)
+			}
+			require.Equal(t, test.expected, signed)
+
+			// A later slot is unaffected.
+			msgs, err = s.Message(ctx, synccommitteemessenger.NewDuty(slot+1, map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+				2: {5},
+			}))
+			require.NoError(t, err)
+			require.Len(t, msgs, 1)
+		})
+	}
+}
+
+// failingSyncCommitteeMessagesSubmitter fails to submit sync committee messages until told otherwise.
+type failingSyncCommitteeMessagesSubmitter struct {
+	fail bool
+}
+
+func (s *failingSyncCommitteeMessagesSubmitter) SubmitSyncCommitteeMessages(_ context.Context, _ []*altair.SyncCommitteeMessage) error {
+	if s.fail {
+		return errors.New("mock error")
+	}
+	return nil
+}
+
+func TestMessageDuplicateDutiesSubmissionFailure(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+	nullSubmitter, err := nullsubmitter.New(ctx)
+	require.NoError(t, err)
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+	messagesSubmitter := &failingSyncCommitteeMessagesSubmitter{fail: true}
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithProcessConcurrency(1),
+		standard.WithMonitor(nullmetrics.New(ctx)),
+		standard.WithChainTimeService(chainTime),
+		standard.WithSyncCommitteeAggregator(mocksynccommitteeaggregator.New()),
+		standard.WithSpecProvider(mock.NewSpecProvider()),
+		standard.WithBeaconBlockRootProvider(mockETH2Client),
+		standard.WithSyncCommitteeMessagesSubmitter(messagesSubmitter),
+		standard.WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+		standard.WithSyncCommitteeRootSigner(mocksigner.New()),
+		standard.WithSyncCommitteeSelectionSigner(mocksigner.New()),
+		standard.WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+	)
+	require.NoError(t, err)
+
+	duty := synccommitteemessenger.NewDuty(phase0.Slot(8191), map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+		1: {1},
+		2: {2},
+	})
+
+	_, err = s.Message(ctx, duty)
+	require.EqualError(t, err, "failed to submit sync committee messages: mock error")
+
+	// The messages were not submitted, so a later duty can produce them.
+	messagesSubmitter.fail = false
+	msgs, err := s.Message(ctx, duty)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+}
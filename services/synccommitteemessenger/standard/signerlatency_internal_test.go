@@ -0,0 +1,114 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocketh2client "github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
+	mocksigner "github.com/attestantio/vouch/services/signer/mock"
+	nullsubmitter "github.com/attestantio/vouch/services/submitter/null"
+	mocksynccommitteeaggregator "github.com/attestantio/vouch/services/synccommitteeaggregator/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// slowSigner is a sync committee root signer that takes time to sign.
+type slowSigner struct {
+	delay time.Duration
+}
+
+func (s *slowSigner) SignSyncCommitteeRoot(_ context.Context,
+	_ e2wtypes.Account,
+	_ phase0.Epoch,
+	_ phase0.Root,
+) (
+	phase0.BLSSignature,
+	error,
+) {
+	time.Sleep(s.delay)
+	return phase0.BLSSignature{}, nil
+}
+
+// signerDegradedMonitor records the signer degradation signals reported.
+type signerDegradedMonitor struct {
+	*nullmetrics.Service
+	signals []bool
+}
+
+func (m *signerDegradedMonitor) SyncCommitteeMessageSignerDegraded(degraded bool) {
+	m.signals = append(m.signals, degraded)
+}
+
+func TestSignerLatency(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithLogLevel(zerolog.Disabled),
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+	nullSubmitter, err := nullsubmitter.New(ctx)
+	require.NoError(t, err)
+	mockETH2Client, err := mocketh2client.New(ctx)
+	require.NoError(t, err)
+
+	signer := &slowSigner{delay: 50 * time.Millisecond}
+	monitor := &signerDegradedMonitor{Service: nullmetrics.New(ctx)}
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithProcessConcurrency(4),
+		WithMonitor(monitor),
+		WithChainTimeService(chainTime),
+		WithSyncCommitteeAggregator(mocksynccommitteeaggregator.New()),
+		WithSpecProvider(mock.NewSpecProvider()),
+		WithBeaconBlockRootProvider(mockETH2Client),
+		WithSyncCommitteeMessagesSubmitter(nullSubmitter),
+		WithValidatingAccountsProvider(mockaccountmanager.NewValidatingAccountsProvider()),
+		WithSyncCommitteeRootSigner(signer),
+		WithSyncCommitteeSelectionSigner(mocksigner.New()),
+		WithSyncCommitteeSubscriptionsSubmitter(nullSubmitter),
+		WithSignerLatencyThreshold(20*time.Millisecond),
+		WithSignerDegradedConcurrency(1),
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), s.processConcurrency.For(log, 10))
+
+	// A slow signer is reported as degraded, once, and concurrency is reduced.
+	for i := 0; i < 3; i++ {
+		_, err := s.contribute(ctx, nil, 0, phase0.Root{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, []bool{true}, monitor.signals)
+	require.Equal(t, int64(1), s.processConcurrency.For(log, 10))
+
+	// The signer recovers when it speeds up.
+	signer.delay = 0
+	for i := 0; i < 20; i++ {
+		_, err := s.contribute(ctx, nil, 0, phase0.Root{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, []bool{true, false}, monitor.signals)
+	require.Equal(t, int64(4), s.processConcurrency.For(log, 10))
+}
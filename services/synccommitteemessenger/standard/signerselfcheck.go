@@ -0,0 +1,61 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/signer"
+	"github.com/pkg/errors"
+)
+
+// signerSelfCheck checks that the signer can sign for a validating account,
+// failing if it cannot.
+func (s *Service) signerSelfCheck(ctx context.Context) error {
+	checker, isChecker := s.syncCommitteeRootSigner.(signer.SelfChecker)
+	if !isChecker {
+		log.Warn().Msg("Signer does not support self check; skipping")
+		return nil
+	}
+
+	epoch := s.chainTimeService.CurrentEpoch()
+	accounts, err := s.validatingAccountsProvider.ValidatingAccountsForEpoch(ctx, epoch)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain validating accounts")
+	}
+	if len(accounts) == 0 {
+		log.Info().Msg("No validating accounts; skipping signer self check")
+		return nil
+	}
+
+	// Use the account with the lowest index, so that the same account is checked each time.
+	indices := make([]phase0.ValidatorIndex, 0, len(accounts))
+	for index := range accounts {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	account := accounts[indices[0]]
+
+	if err := checker.SelfCheck(ctx, account, epoch); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to sign for account %s", account.Name()))
+	}
+	log.Info().Str("account", account.Name()).Msg("Signer self check succeeded")
+
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/accountmanager"
+	mockaccountmanager "github.com/attestantio/vouch/services/accountmanager/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	"github.com/attestantio/vouch/testutil"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	e2types "github.com/wealdtech/go-eth2-types/v2"
+	e2wallet "github.com/wealdtech/go-eth2-wallet"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	nd "github.com/wealdtech/go-eth2-wallet-nd/v2"
+	scratch "github.com/wealdtech/go-eth2-wallet-store-scratch"
+	e2wtypes "github.com/wealdtech/go-eth2-wallet-types/v2"
+)
+
+// selfCheckingSigner is a sync committee root signer that supports self checks.
+type selfCheckingSigner struct {
+	err     error
+	checked []string
+}
+
+func (*selfCheckingSigner) SignSyncCommitteeRoot(_ context.Context,
+	_ e2wtypes.Account,
+	_ phase0.Epoch,
+	_ phase0.Root,
+) (
+	phase0.BLSSignature,
+	error,
+) {
+	return phase0.BLSSignature{}, nil
+}
+
+func (s *selfCheckingSigner) SelfCheck(_ context.Context,
+	account e2wtypes.Account,
+	_ phase0.Epoch,
+) error {
+	s.checked = append(s.checked, account.Name())
+	return s.err
+}
+
+func TestSignerSelfCheck(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, e2types.InitBLS())
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithLogLevel(zerolog.Disabled),
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	store := scratch.New()
+	require.NoError(t, e2wallet.UseStore(store))
+	wallet, err := nd.CreateWallet(ctx, "Test wallet", store, keystorev4.New())
+	require.NoError(t, err)
+	require.NoError(t, wallet.(e2wtypes.WalletLocker).Unlock(ctx, nil))
+	account0, err := wallet.(e2wtypes.WalletAccountImporter).ImportAccount(ctx,
+		"Interop 0",
+		testutil.HexToBytes("0x25295f0d1d592a90b333e26e85149708208e9f8e8bc18f6c77bd62f8ad7a6866"),
+		[]byte("pass"),
+	)
+	require.NoError(t, err)
+	account1, err := wallet.(e2wtypes.WalletAccountImporter).ImportAccount(ctx,
+		"Interop 1",
+		testutil.HexToBytes("0x51d0b65185db6989ab0b560d6deed19c7ead0e24b9b6372cbecb1f26bdfad000"),
+		[]byte("pass"),
+	)
+	require.NoError(t, err)
+
+	noAccountsProvider := mockaccountmanager.NewValidatingAccountsProvider()
+	accountsProvider := mockaccountmanager.NewValidatingAccountsProvider()
+	accountsProvider.AddAccount(2, account1)
+	accountsProvider.AddAccount(1, account0)
+
+	tests := []struct {
+		name             string
+		accountsProvider accountmanager.ValidatingAccountsProvider
+		checkErr         error
+		checked          []string
+		err              string
+	}{
+		{
+			name:             "NoAccounts",
+			accountsProvider: noAccountsProvider,
+		},
+		{
+			name:             "Good",
+			accountsProvider: accountsProvider,
+			checked:          []string{"Interop 0"},
+		},
+		{
+			name:             "Failed",
+			accountsProvider: accountsProvider,
+			checkErr:         errors.New("unreachable"),
+			checked:          []string{"Interop 0"},
+			err:              "failed to sign for account Interop 0: unreachable",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			signer := &selfCheckingSigner{err: test.checkErr}
+			s := &Service{
+				chainTimeService:           chainTime,
+				validatingAccountsProvider: test.accountsProvider,
+				syncCommitteeRootSigner:    signer,
+			}
+			err := s.signerSelfCheck(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.checked, signer.checked)
+		})
+	}
+}
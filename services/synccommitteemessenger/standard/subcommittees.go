@@ -0,0 +1,101 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
+)
+
+// subcommitteesCache caches the sync subcommittees of validators.
+// Sync committee membership is fixed for a sync committee period, so the
+// cache is cleared whenever the period changes.
+type subcommitteesCache struct {
+	mu               sync.Mutex
+	subcommitteeSize uint64
+	period           uint64
+	subcommittees    map[phase0.ValidatorIndex][]uint64
+}
+
+// newSubcommitteesCache creates a new subcommittees cache.
+func newSubcommitteesCache(syncCommitteeSize uint64, syncCommitteeSubnetCount uint64) *subcommitteesCache {
+	return &subcommitteesCache{
+		subcommitteeSize: syncCommitteeSize / syncCommitteeSubnetCount,
+		subcommittees:    make(map[phase0.ValidatorIndex][]uint64),
+	}
+}
+
+// get returns the sorted, unique subcommittees for a validator in the given period,
+// calculating them from the validator's sync committee indices if not already cached.
+func (c *subcommitteesCache) get(period uint64,
+	validatorIndex phase0.ValidatorIndex,
+	contributionIndices []phase0.CommitteeIndex,
+) []uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if period != c.period {
+		c.period = period
+		c.subcommittees = make(map[phase0.ValidatorIndex][]uint64)
+	}
+
+	if subcommittees, exists := c.subcommittees[validatorIndex]; exists {
+		return subcommittees
+	}
+
+	subcommittees := make([]uint64, 0, len(contributionIndices))
+	seen := make(map[uint64]bool, len(contributionIndices))
+	for _, contributionIndex := range contributionIndices {
+		subcommittee := c.subcommittee(contributionIndex)
+		if !seen[subcommittee] {
+			seen[subcommittee] = true
+			subcommittees = append(subcommittees, subcommittee)
+		}
+	}
+	sort.Slice(subcommittees, func(i, j int) bool { return subcommittees[i] < subcommittees[j] })
+	c.subcommittees[validatorIndex] = subcommittees
+
+	return subcommittees
+}
+
+// subcommittee returns the subcommittee for a sync committee index.
+func (c *subcommitteesCache) subcommittee(index phase0.CommitteeIndex) uint64 {
+	return uint64(index) / c.subcommitteeSize
+}
+
+// Subnets returns the sorted sync committee subnets to which the node should be
+// subscribed in order to carry out the given duty.  Sync committee subnets map
+// directly to subcommittees, so these are the subcommittees of all validators
+// in the duty.
+func (s *Service) Subnets(_ context.Context, duty *synccommitteemessenger.Duty) []uint64 {
+	period := uint64(s.chainTimeService.SlotToEpoch(duty.Slot())) / s.epochsPerSyncCommitteePeriod
+
+	seen := make(map[uint64]bool)
+	subnets := make([]uint64, 0)
+	for _, validatorIndex := range duty.ValidatorIndices() {
+		for _, subcommittee := range s.subcommittees.get(period, validatorIndex, duty.ContributionIndices()[validatorIndex]) {
+			if !seen[subcommittee] {
+				seen[subcommittee] = true
+				subnets = append(subnets, subcommittee)
+			}
+		}
+	}
+	sort.Slice(subnets, func(i, j int) bool { return subnets[i] < subnets[j] })
+
+	return subnets
+}
@@ -0,0 +1,147 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	"github.com/attestantio/vouch/services/synccommitteemessenger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubcommitteesCache(t *testing.T) {
+	// Mainnet values: 4 subcommittees of 128.
+	c := newSubcommitteesCache(512, 4)
+
+	tests := []struct {
+		name                string
+		period              uint64
+		validatorIndex      phase0.ValidatorIndex
+		contributionIndices []phase0.CommitteeIndex
+		expected            []uint64
+	}{
+		{
+			name:                "Single",
+			period:              1,
+			validatorIndex:      1,
+			contributionIndices: []phase0.CommitteeIndex{0},
+			expected:            []uint64{0},
+		},
+		{
+			name:                "Boundaries",
+			period:              1,
+			validatorIndex:      2,
+			contributionIndices: []phase0.CommitteeIndex{127, 128, 255, 256, 511},
+			expected:            []uint64{0, 1, 2, 3},
+		},
+		{
+			name:                "Duplicates",
+			period:              1,
+			validatorIndex:      3,
+			contributionIndices: []phase0.CommitteeIndex{300, 129, 260, 130},
+			expected:            []uint64{1, 2},
+		},
+		{
+			name:                "CachedWithinPeriod",
+			period:              1,
+			validatorIndex:      1,
+			contributionIndices: []phase0.CommitteeIndex{500},
+			expected:            []uint64{0},
+		},
+		{
+			name:                "RecalculatedNextPeriod",
+			period:              2,
+			validatorIndex:      1,
+			contributionIndices: []phase0.CommitteeIndex{500},
+			expected:            []uint64{3},
+		},
+		{
+			name:                "PreviousPeriodCleared",
+			period:              2,
+			validatorIndex:      2,
+			contributionIndices: []phase0.CommitteeIndex{5},
+			expected:            []uint64{0},
+		},
+		{
+			name:                "Empty",
+			period:              2,
+			validatorIndex:      4,
+			contributionIndices: []phase0.CommitteeIndex{},
+			expected:            []uint64{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, c.get(test.period, test.validatorIndex, test.contributionIndices))
+		})
+	}
+}
+
+func TestSubnets(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	s := &Service{
+		chainTimeService:             chainTime,
+		epochsPerSyncCommitteePeriod: 256,
+		subcommittees:                newSubcommitteesCache(512, 4),
+	}
+
+	tests := []struct {
+		name                string
+		contributionIndices map[phase0.ValidatorIndex][]phase0.CommitteeIndex
+		expected            []uint64
+	}{
+		{
+			name:                "Empty",
+			contributionIndices: map[phase0.ValidatorIndex][]phase0.CommitteeIndex{},
+			expected:            []uint64{},
+		},
+		{
+			name: "Single",
+			contributionIndices: map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+				1: {300},
+			},
+			expected: []uint64{2},
+		},
+		{
+			name: "Multiple",
+			contributionIndices: map[phase0.ValidatorIndex][]phase0.CommitteeIndex{
+				2: {511, 5},
+				3: {200, 10},
+				4: {130},
+			},
+			expected: []uint64{0, 1, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			duty := synccommitteemessenger.NewDuty(1, test.contributionIndices)
+			require.Equal(t, test.expected, s.Subnets(ctx, duty))
+		})
+	}
+}
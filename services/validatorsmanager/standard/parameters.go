@@ -15,6 +15,7 @@ package standard
 
 import (
 	"context"
+	"fmt"
 
 	eth2client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -29,7 +30,10 @@ type parameters struct {
 	monitor            metrics.ValidatorsManagerMonitor
 	clientMonitor      metrics.ClientMonitor
 	validatorsProvider eth2client.ValidatorsProvider
+	refreshProviders   map[string]eth2client.ValidatorsProvider
 	farFutureEpoch     phase0.Epoch
+	refreshBatchSize   int
+	refreshConcurrency int64
 }
 
 // Parameter is the interface for service parameters.
@@ -71,6 +75,15 @@ func WithValidatorsProvider(provider eth2client.ValidatorsProvider) Parameter {
 	})
 }
 
+// WithRefreshValidatorsProviders sets the validators providers used when
+// refreshing validators, keyed by address.  If supplied, batches are spread
+// across the providers in place of the validators provider.
+func WithRefreshValidatorsProviders(providers map[string]eth2client.ValidatorsProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.refreshProviders = providers
+	})
+}
+
 // WithFarFutureEpoch sets the far future epoch.
 func WithFarFutureEpoch(farFutureEpoch phase0.Epoch) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -78,12 +91,28 @@ func WithFarFutureEpoch(farFutureEpoch phase0.Epoch) Parameter {
 	})
 }
 
+// WithRefreshBatchSize sets the maximum number of validators to request from the beacon node at a time.
+func WithRefreshBatchSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.refreshBatchSize = size
+	})
+}
+
+// WithRefreshConcurrency sets the maximum number of concurrent requests to the beacon node when refreshing validators.
+func WithRefreshConcurrency(concurrency int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.refreshConcurrency = concurrency
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel:      zerolog.GlobalLevel(),
-		monitor:       nullmetrics.New(context.Background()),
-		clientMonitor: nullmetrics.New(context.Background()),
+		logLevel:           zerolog.GlobalLevel(),
+		monitor:            nullmetrics.New(context.Background()),
+		clientMonitor:      nullmetrics.New(context.Background()),
+		refreshBatchSize:   1000,
+		refreshConcurrency: 1,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -100,9 +129,20 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.validatorsProvider == nil {
 		return nil, errors.New("no validators provider specified")
 	}
+	for address, provider := range parameters.refreshProviders {
+		if provider == nil {
+			return nil, fmt.Errorf("no validators provider for refresh address %s", address)
+		}
+	}
 	if parameters.farFutureEpoch == 0 {
 		return nil, errors.New("no far future epoch specified")
 	}
+	if parameters.refreshBatchSize < 1 {
+		return nil, errors.New("refresh batch size must be at least 1")
+	}
+	if parameters.refreshConcurrency < 1 {
+		return nil, errors.New("refresh concurrency must be at least 1")
+	}
 
 	return &parameters, nil
 }
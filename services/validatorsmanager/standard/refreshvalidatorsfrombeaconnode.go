@@ -15,6 +15,8 @@ package standard
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	eth2client "github.com/attestantio/go-eth2-client"
@@ -22,25 +24,22 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
+	"golang.org/x/sync/semaphore"
 )
 
+// refreshBatchAttempts is the number of times a batch of validators is requested before giving up.
+const refreshBatchAttempts = 2
+
 // RefreshValidatorsFromBeaconNode refreshes the local store from the beacon node.
 // This is an expensive operation, and should not be called in the validating path.
 func (s *Service) RefreshValidatorsFromBeaconNode(ctx context.Context, pubKeys []phase0.BLSPubKey) error {
 	ctx, span := otel.Tracer("attestantio.vouch.services.validatorsmanager.standard").Start(ctx, "RefreshValidatorsFromBeaconNode")
 	defer span.End()
 
-	var validators map[phase0.ValidatorIndex]*api.Validator
-	var err error
 	started := time.Now()
-	validators, err = s.validatorsProvider.ValidatorsByPubKey(ctx, "head", pubKeys)
-	if service, isService := s.validatorsProvider.(eth2client.Service); isService {
-		s.clientMonitor.ClientOperation(service.Address(), "validators", err == nil, time.Since(started))
-	} else {
-		s.clientMonitor.ClientOperation("<unknown>", "validators", err == nil, time.Since(started))
-	}
+	validators, err := s.fetchValidators(ctx, pubKeys)
 	if err != nil {
-		return errors.Wrap(err, "failed to obtain validators")
+		return err
 	}
 	log.Trace().Dur("elapsed", time.Since(started)).Int("received", len(validators)).Msg("Received validators from beacon node")
 
@@ -50,10 +49,30 @@ func (s *Service) RefreshValidatorsFromBeaconNode(ctx context.Context, pubKeys [
 		return nil
 	}
 
+	s.validatorsMutex.RLock()
+	previousValidatorsByIndex := s.validatorsByIndex
+	previousValidatorPubKeyToIndex := s.validatorPubKeyToIndex
+	s.validatorsMutex.RUnlock()
+
 	validatorsByIndex := make(map[phase0.ValidatorIndex]*phase0.Validator)
 	validatorsByPubKey := make(map[phase0.BLSPubKey]*phase0.Validator)
 	validatorPubKeyToIndex := make(map[phase0.BLSPubKey]phase0.ValidatorIndex)
 	for _, validator := range validators {
+		// The index of a validator never changes, so if it has then the beacon
+		// node is faulty or on a different network.  Refuse to act on the new
+		// data, keeping the previous entry for the validator.
+		if previousIndex, exists := previousValidatorPubKeyToIndex[validator.Validator.PublicKey]; exists && previousIndex != validator.Index {
+			log.Error().
+				Str("pubkey", fmt.Sprintf("%#x", validator.Validator.PublicKey)).
+				Uint64("previous_index", uint64(previousIndex)).
+				Uint64("index", uint64(validator.Index)).
+				Msg("Beacon node reported a changed index for validator; ignoring")
+			s.monitor.ValidatorIndexChanged()
+			validatorsByIndex[previousIndex] = previousValidatorsByIndex[previousIndex]
+			validatorsByPubKey[validator.Validator.PublicKey] = previousValidatorsByIndex[previousIndex]
+			validatorPubKeyToIndex[validator.Validator.PublicKey] = previousIndex
+			continue
+		}
 		validatorsByIndex[validator.Index] = validator.Validator
 		validatorsByPubKey[validator.Validator.PublicKey] = validator.Validator
 		validatorPubKeyToIndex[validator.Validator.PublicKey] = validator.Index
@@ -72,3 +91,92 @@ func (s *Service) RefreshValidatorsFromBeaconNode(ctx context.Context, pubKeys [
 
 	return nil
 }
+
+// fetchValidators fetches validators from the beacon node in batches,
+// merging the results.  If multiple providers are available then batches are
+// spread across them.  A batch that fails is retried, with the next provider
+// if there is more than one, and if it continues to fail the entire operation
+// fails.
+func (s *Service) fetchValidators(ctx context.Context, pubKeys []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*api.Validator, error) {
+	batches := make([][]phase0.BLSPubKey, 0, len(pubKeys)/s.refreshBatchSize+1)
+	for i := 0; i < len(pubKeys); i += s.refreshBatchSize {
+		end := i + s.refreshBatchSize
+		if end > len(pubKeys) {
+			end = len(pubKeys)
+		}
+		batches = append(batches, pubKeys[i:end])
+	}
+	if len(batches) == 0 {
+		// Pass through an empty request, as per an unbatched request.
+		batches = append(batches, pubKeys)
+	}
+
+	validators := make(map[phase0.ValidatorIndex]*api.Validator, len(pubKeys))
+	var validatorsMu sync.Mutex
+	var fetchErr error
+	var fetchErrMu sync.Mutex
+	sem := semaphore.NewWeighted(s.refreshConcurrency)
+	var wg sync.WaitGroup
+	for i := range batches {
+		wg.Add(1)
+		go func(ctx context.Context, sem *semaphore.Weighted, wg *sync.WaitGroup, i int) {
+			defer wg.Done()
+			if err := sem.Acquire(ctx, 1); err != nil {
+				fetchErrMu.Lock()
+				fetchErr = errors.Wrap(err, "failed to obtain semaphore")
+				fetchErrMu.Unlock()
+				return
+			}
+			defer sem.Release(1)
+
+			batchValidators, err := s.fetchValidatorsBatch(ctx, i, batches[i])
+			if err != nil {
+				fetchErrMu.Lock()
+				fetchErr = errors.Wrapf(err, "failed to obtain validators for batch %d of %d", i+1, len(batches))
+				fetchErrMu.Unlock()
+				return
+			}
+			validatorsMu.Lock()
+			for index, validator := range batchValidators {
+				validators[index] = validator
+			}
+			validatorsMu.Unlock()
+		}(ctx, sem, &wg, i)
+	}
+	wg.Wait()
+
+	if fetchErr != nil {
+		return nil, fetchErr
+	}
+
+	return validators, nil
+}
+
+// fetchValidatorsBatch fetches a single batch of validators from the beacon node, retrying on failure.
+// Each attempt uses the next provider in turn, starting with the provider for the batch.
+func (s *Service) fetchValidatorsBatch(ctx context.Context,
+	batch int,
+	pubKeys []phase0.BLSPubKey,
+) (
+	map[phase0.ValidatorIndex]*api.Validator,
+	error,
+) {
+	var err error
+	for attempt := 1; attempt <= refreshBatchAttempts; attempt++ {
+		provider := s.refreshProviders[(batch+attempt-1)%len(s.refreshProviders)]
+		address := "<unknown>"
+		if service, isService := provider.(eth2client.Service); isService {
+			address = service.Address()
+		}
+		var validators map[phase0.ValidatorIndex]*api.Validator
+		started := time.Now()
+		validators, err = provider.ValidatorsByPubKey(ctx, "head", pubKeys)
+		s.clientMonitor.ClientOperation(address, "validators", err == nil, time.Since(started))
+		if err == nil {
+			return validators, nil
+		}
+		log.Debug().Err(err).Str("provider", address).Int("attempt", attempt).Int("validators", len(pubKeys)).Msg("Failed to obtain batch of validators")
+	}
+
+	return nil, errors.Wrap(err, "failed to obtain validators")
+}
@@ -15,8 +15,12 @@ package standard_test
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 
+	eth2client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/vouch/mock"
 	nullmetrics "github.com/attestantio/vouch/services/metrics/null"
@@ -70,3 +74,238 @@ func TestRefreshValidatorsFromBeaconNode(t *testing.T) {
 	}))
 	require.Len(t, s.ValidatorsByPubKey(ctx, fetchKeys), 0)
 }
+
+// batchingValidatorsProvider records the batches requested, and fails requests
+// containing a given public key a set number of times.
+type batchingValidatorsProvider struct {
+	eth2client.ValidatorsProvider
+	mu          sync.Mutex
+	batchSizes  []int
+	failPubKey  phase0.BLSPubKey
+	failuresRem int
+}
+
+func (p *batchingValidatorsProvider) ValidatorsByPubKey(ctx context.Context, stateID string, pubKeys []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	p.mu.Lock()
+	p.batchSizes = append(p.batchSizes, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if pubKey == p.failPubKey && p.failuresRem > 0 {
+			p.failuresRem--
+			p.mu.Unlock()
+			return nil, errors.New("mock failure")
+		}
+	}
+	p.mu.Unlock()
+
+	return p.ValidatorsProvider.ValidatorsByPubKey(ctx, stateID, pubKeys)
+}
+
+func TestRefreshValidatorsFromBeaconNodeBatched(t *testing.T) {
+	ctx := context.Background()
+
+	pubKeys := []phase0.BLSPubKey{
+		testutil.HexToPubKey("0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"),
+		testutil.HexToPubKey("0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"),
+		testutil.HexToPubKey("0xa3a32b0f8b4ddb83f1a0a853d81dd725dfe577d4f4c3db8ece52ce2b026eca84815c1a7e8e92a4de3d755733bf7e4a9b"),
+		testutil.HexToPubKey("0x88c141df77cd9d8d7a71a75c826c41a9c9f03c6ee1b180f3e7852f6a280099ded351b58d66e653af8e42816a4d8f532e"),
+		testutil.HexToPubKey("0x81283b7a20e1ca460ebd9bbd77005d557370cabb1f9a44f530c4c4c66230f675f8df8b4c2818851aa7d77a80ca5a4a5e"),
+	}
+
+	tests := []struct {
+		name        string
+		concurrency int64
+		failures    int
+		err         string
+		calls       int
+		validators  int
+	}{
+		{
+			name:        "Sequential",
+			concurrency: 1,
+			calls:       3,
+			validators:  5,
+		},
+		{
+			name:        "Concurrent",
+			concurrency: 3,
+			calls:       3,
+			validators:  5,
+		},
+		{
+			name:        "RetriedBatch",
+			concurrency: 2,
+			failures:    1,
+			calls:       4,
+			validators:  5,
+		},
+		{
+			name:        "FailedBatch",
+			concurrency: 2,
+			failures:    2,
+			err:         "failed to obtain validators for batch 3 of 3: failed to obtain validators: mock failure",
+			calls:       4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := &batchingValidatorsProvider{
+				ValidatorsProvider: mock.NewValidatorsProvider(),
+				failPubKey:         pubKeys[4],
+				failuresRem:        test.failures,
+			}
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithFarFutureEpoch(phase0.Epoch(0xffffffffffffffff)),
+				standard.WithValidatorsProvider(provider),
+				standard.WithRefreshBatchSize(2),
+				standard.WithRefreshConcurrency(test.concurrency),
+			)
+			require.NoError(t, err)
+
+			err = s.RefreshValidatorsFromBeaconNode(ctx, pubKeys)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, provider.batchSizes, test.calls)
+			for _, batchSize := range provider.batchSizes {
+				require.LessOrEqual(t, batchSize, 2)
+			}
+			require.Len(t, s.ValidatorsByPubKey(ctx, pubKeys), test.validators)
+		})
+	}
+}
+
+func TestRefreshValidatorsFromBeaconNodeProviders(t *testing.T) {
+	ctx := context.Background()
+
+	pubKeys := []phase0.BLSPubKey{
+		testutil.HexToPubKey("0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"),
+		testutil.HexToPubKey("0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"),
+		testutil.HexToPubKey("0xa3a32b0f8b4ddb83f1a0a853d81dd725dfe577d4f4c3db8ece52ce2b026eca84815c1a7e8e92a4de3d755733bf7e4a9b"),
+		testutil.HexToPubKey("0x88c141df77cd9d8d7a71a75c826c41a9c9f03c6ee1b180f3e7852f6a280099ded351b58d66e653af8e42816a4d8f532e"),
+		testutil.HexToPubKey("0x81283b7a20e1ca460ebd9bbd77005d557370cabb1f9a44f530c4c4c66230f675f8df8b4c2818851aa7d77a80ca5a4a5e"),
+	}
+
+	// The third batch is sent to the first provider, which fails it once, so
+	// it is retried with the second provider.
+	first := &batchingValidatorsProvider{
+		ValidatorsProvider: mock.NewValidatorsProvider(),
+		failPubKey:         pubKeys[4],
+		failuresRem:        1,
+	}
+	second := &batchingValidatorsProvider{
+		ValidatorsProvider: mock.NewValidatorsProvider(),
+	}
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithFarFutureEpoch(phase0.Epoch(0xffffffffffffffff)),
+		standard.WithValidatorsProvider(mock.NewValidatorsProvider()),
+		standard.WithRefreshValidatorsProviders(map[string]eth2client.ValidatorsProvider{
+			"first":  first,
+			"second": second,
+		}),
+		standard.WithRefreshBatchSize(2),
+		standard.WithRefreshConcurrency(3),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.RefreshValidatorsFromBeaconNode(ctx, pubKeys))
+	require.Len(t, s.ValidatorsByPubKey(ctx, pubKeys), 5)
+	require.Len(t, first.batchSizes, 2)
+	require.Len(t, second.batchSizes, 2)
+	require.Equal(t, 0, first.failuresRem)
+}
+
+func TestRefreshValidatorsProvidersNil(t *testing.T) {
+	_, err := standard.New(context.Background(),
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithFarFutureEpoch(phase0.Epoch(0xffffffffffffffff)),
+		standard.WithValidatorsProvider(mock.NewValidatorsProvider()),
+		standard.WithRefreshValidatorsProviders(map[string]eth2client.ValidatorsProvider{
+			"first": nil,
+		}),
+	)
+	require.EqualError(t, err, "problem with parameters: no validators provider for refresh address first")
+}
+
+// indexChangingValidatorsProvider reports a changed index for a given public key when set.
+type indexChangingValidatorsProvider struct {
+	eth2client.ValidatorsProvider
+	changePubKey phase0.BLSPubKey
+	change       bool
+}
+
+func (p *indexChangingValidatorsProvider) ValidatorsByPubKey(ctx context.Context, stateID string, pubKeys []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	validators, err := p.ValidatorsProvider.ValidatorsByPubKey(ctx, stateID, pubKeys)
+	if err != nil || !p.change {
+		return validators, err
+	}
+
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator, len(validators))
+	for index, validator := range validators {
+		if validator.Validator.PublicKey == p.changePubKey {
+			changed := *validator
+			changed.Index = index + 1000
+			res[changed.Index] = &changed
+			continue
+		}
+		res[index] = validator
+	}
+
+	return res, nil
+}
+
+// indexChangesMonitor counts the index changes reported.
+type indexChangesMonitor struct {
+	changes int
+}
+
+func (m *indexChangesMonitor) ValidatorIndexChanged() {
+	m.changes++
+}
+
+func TestRefreshValidatorsFromBeaconNodeIndexChanged(t *testing.T) {
+	ctx := context.Background()
+
+	pubKeys := []phase0.BLSPubKey{
+		testutil.HexToPubKey("0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"),
+		testutil.HexToPubKey("0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"),
+	}
+
+	provider := &indexChangingValidatorsProvider{
+		ValidatorsProvider: mock.NewValidatorsProvider(),
+		changePubKey:       pubKeys[1],
+	}
+	monitor := &indexChangesMonitor{}
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithMonitor(monitor),
+		standard.WithFarFutureEpoch(phase0.Epoch(0xffffffffffffffff)),
+		standard.WithValidatorsProvider(provider),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.RefreshValidatorsFromBeaconNode(ctx, pubKeys))
+	indices := make(map[phase0.BLSPubKey]phase0.ValidatorIndex)
+	for index, validator := range s.ValidatorsByPubKey(ctx, pubKeys) {
+		indices[validator.PublicKey] = index
+	}
+	require.Len(t, indices, 2)
+	require.Equal(t, 0, monitor.changes)
+
+	// The beacon node now reports a changed index for the second validator.
+	provider.change = true
+	require.NoError(t, s.RefreshValidatorsFromBeaconNode(ctx, pubKeys))
+	require.Equal(t, 1, monitor.changes)
+
+	// The previous index is retained, and the changed index is not known.
+	validators := s.ValidatorsByPubKey(ctx, pubKeys)
+	require.Len(t, validators, 2)
+	for index, validator := range validators {
+		require.Equal(t, indices[validator.PublicKey], index)
+	}
+	require.Empty(t, s.ValidatorsByIndex(ctx, []phase0.ValidatorIndex{indices[pubKeys[1]] + 1000}))
+}
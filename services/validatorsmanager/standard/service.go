@@ -15,6 +15,7 @@ package standard
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	eth2client "github.com/attestantio/go-eth2-client"
@@ -29,8 +30,10 @@ import (
 type Service struct {
 	monitor            metrics.ValidatorsManagerMonitor
 	clientMonitor      metrics.ClientMonitor
-	validatorsProvider eth2client.ValidatorsProvider
+	refreshProviders   []eth2client.ValidatorsProvider
 	farFutureEpoch     phase0.Epoch
+	refreshBatchSize   int
+	refreshConcurrency int64
 
 	validatorsMutex        sync.RWMutex
 	validatorsByIndex      map[phase0.ValidatorIndex]*phase0.Validator
@@ -58,7 +61,9 @@ func New(_ context.Context, params ...Parameter) (*Service, error) {
 		monitor:                parameters.monitor,
 		clientMonitor:          parameters.clientMonitor,
 		farFutureEpoch:         parameters.farFutureEpoch,
-		validatorsProvider:     parameters.validatorsProvider,
+		refreshProviders:       refreshProviders(parameters),
+		refreshBatchSize:       parameters.refreshBatchSize,
+		refreshConcurrency:     parameters.refreshConcurrency,
 		validatorsByIndex:      make(map[phase0.ValidatorIndex]*phase0.Validator),
 		validatorsByPubKey:     make(map[phase0.BLSPubKey]*phase0.Validator),
 		validatorPubKeyToIndex: make(map[phase0.BLSPubKey]phase0.ValidatorIndex),
@@ -66,3 +71,23 @@ func New(_ context.Context, params ...Parameter) (*Service, error) {
 
 	return s, nil
 }
+
+// refreshProviders returns the providers to use when refreshing validators,
+// in a stable order so that batches are spread consistently between refreshes.
+func refreshProviders(parameters *parameters) []eth2client.ValidatorsProvider {
+	if len(parameters.refreshProviders) == 0 {
+		return []eth2client.ValidatorsProvider{parameters.validatorsProvider}
+	}
+
+	addresses := make([]string, 0, len(parameters.refreshProviders))
+	for address := range parameters.refreshProviders {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	providers := make([]eth2client.ValidatorsProvider, 0, len(addresses))
+	for _, address := range addresses {
+		providers = append(providers, parameters.refreshProviders[address])
+	}
+
+	return providers
+}
@@ -36,6 +36,30 @@ func TestService(t *testing.T) {
 		err      string
 		logEntry string
 	}{
+		{
+			name: "RefreshBatchSizeZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(nullmetrics.New(context.Background())),
+				standard.WithClientMonitor(nullmetrics.New(context.Background())),
+				standard.WithFarFutureEpoch(farFutureEpoch),
+				standard.WithValidatorsProvider(validatorsProvider),
+				standard.WithRefreshBatchSize(0),
+			},
+			err: "problem with parameters: refresh batch size must be at least 1",
+		},
+		{
+			name: "RefreshConcurrencyZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(nullmetrics.New(context.Background())),
+				standard.WithClientMonitor(nullmetrics.New(context.Background())),
+				standard.WithFarFutureEpoch(farFutureEpoch),
+				standard.WithValidatorsProvider(validatorsProvider),
+				standard.WithRefreshConcurrency(0),
+			},
+			err: "problem with parameters: refresh concurrency must be at least 1",
+		},
 		{
 			name: "Good",
 			params: []standard.Parameter{
@@ -84,9 +84,8 @@ func (s *Service) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 	errored := 0
 	timedOut := 0
 	softTimedOut := 0
-	bestScore := float64(0)
-	var bestProposal *spec.VersionedBeaconBlock
-	bestProvider := ""
+	proposals := make(map[string]*spec.VersionedBeaconBlock, requests)
+	scores := make(map[string]float64, requests)
 
 	// Loop 1: prior to soft timeout.
 	for responded+errored+timedOut+softTimedOut != requests {
@@ -100,11 +99,8 @@ func (s *Service) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 				Int("errored", errored).
 				Int("timed_out", timedOut).
 				Msg("Response received")
-			if bestProposal == nil || resp.score > bestScore {
-				bestProposal = resp.proposal
-				bestScore = resp.score
-				bestProvider = resp.provider
-			}
+			proposals[resp.provider] = resp.proposal
+			scores[resp.provider] = resp.score
 		case err := <-errCh:
 			errored++
 			log.Debug().
@@ -149,11 +145,8 @@ func (s *Service) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 				Int("errored", errored).
 				Int("timed_out", timedOut).
 				Msg("Response received")
-			if bestProposal == nil || resp.score > bestScore {
-				bestProposal = resp.proposal
-				bestScore = resp.score
-				bestProvider = resp.provider
-			}
+			proposals[resp.provider] = resp.proposal
+			scores[resp.provider] = resp.score
 		case err := <-errCh:
 			errored++
 			log.Debug().
@@ -183,10 +176,16 @@ func (s *Service) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 		Int("timed_out", timedOut).
 		Msg("Results")
 
-	if bestProposal == nil {
+	if len(proposals) == 0 {
 		return nil, errors.New("no proposals received")
 	}
+	bestProvider, bestProposal := bestBeaconBlockProposal(proposals, scores)
+	bestScore := scores[bestProvider]
 	log.Trace().Str("provider", bestProvider).Stringer("proposal", bestProposal).Float64("score", bestScore).Msg("Selected best proposal")
+	if bestScore < s.minScore {
+		log.Warn().Str("provider", bestProvider).Float64("score", bestScore).Float64("min_score", s.minScore).Msg("Best beacon block proposal scored below minimum; beacon nodes may be out of sync")
+		monitorLowScore()
+	}
 	if bestProvider != "" {
 		s.clientMonitor.StrategyOperation("best", bestProvider, "beacon block proposal", time.Since(started))
 	}
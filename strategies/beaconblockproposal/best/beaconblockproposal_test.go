@@ -115,6 +115,26 @@ func TestBeaconBlockProposal(t *testing.T) {
 			committeeIndex: 3,
 			err:            "no proposals received",
 		},
+		{
+			name: "LowScore",
+			params: []best.Parameter{
+				best.WithLogLevel(zerolog.TraceLevel),
+				best.WithTimeout(2 * time.Second),
+				best.WithEventsProvider(mock.NewEventsProvider()),
+				best.WithChainTimeService(chainTime),
+				best.WithSpecProvider(specProvider),
+				best.WithProcessConcurrency(2),
+				best.WithSignedBeaconBlockProvider(signedBeaconBlockProvider),
+				best.WithBeaconBlockProposalProviders(map[string]eth2client.BeaconBlockProposalProvider{
+					"good": mock.NewBeaconBlockProposalProvider(),
+				}),
+				best.WithBlockRootToSlotCache(blockToSlotCache),
+				best.WithMinScore(1000000),
+			},
+			slot:           12345,
+			committeeIndex: 3,
+			logEntries:     []string{"Best beacon block proposal scored below minimum; beacon nodes may be out of sync"},
+		},
 		{
 			name: "GoodMixed",
 			params: []best.Parameter{
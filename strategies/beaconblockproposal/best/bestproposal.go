@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package best
+
+import (
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// bestBeaconBlockProposal selects the best of the scored proposals, returning
+// the name of its provider and the proposal itself.
+// Where proposals have the same score that from the provider whose name sorts
+// first is selected, so the result does not depend on the order of arrival.
+func bestBeaconBlockProposal(proposals map[string]*spec.VersionedBeaconBlock,
+	scores map[string]float64,
+) (
+	string,
+	*spec.VersionedBeaconBlock,
+) {
+	names := make([]string, 0, len(proposals))
+	for name := range proposals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bestProvider := ""
+	for _, name := range names {
+		if bestProvider == "" || scores[name] > scores[bestProvider] {
+			bestProvider = name
+		}
+	}
+	log.Trace().Interface("scores", scores).Str("provider", bestProvider).Msg("Scored proposals")
+
+	return bestProvider, proposals[bestProvider]
+}
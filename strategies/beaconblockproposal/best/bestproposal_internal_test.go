@@ -0,0 +1,113 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package best
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// testProposal returns a phase 0 proposal with the given number of attestation votes.
+func testProposal(votes uint64) *spec.VersionedBeaconBlock {
+	return &spec.VersionedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconBlock{
+			Slot:       12346,
+			ParentRoot: testutil.HexToRoot("0x0202020202020202020202020202020202020202020202020202020202020202"),
+			Body: &phase0.BeaconBlockBody{
+				Attestations: []*phase0.Attestation{
+					{
+						AggregationBits: bitList(votes, 128),
+						Data: &phase0.AttestationData{
+							Slot: 12345,
+							Target: &phase0.Checkpoint{
+								Root:  testutil.HexToRoot("0x0101010101010101010101010101010101010101010101010101010101010101"),
+								Epoch: 385,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestBestBeaconBlockProposal(t *testing.T) {
+	tests := []struct {
+		name      string
+		proposals map[string]*spec.VersionedBeaconBlock
+		scores    map[string]float64
+		provider  string
+	}{
+		{
+			name:      "Empty",
+			proposals: map[string]*spec.VersionedBeaconBlock{},
+			scores:    map[string]float64{},
+		},
+		{
+			name: "Single",
+			proposals: map[string]*spec.VersionedBeaconBlock{
+				"one": testProposal(1),
+			},
+			scores: map[string]float64{
+				"one": 1,
+			},
+			provider: "one",
+		},
+		{
+			name: "Multiple",
+			proposals: map[string]*spec.VersionedBeaconBlock{
+				"one":   testProposal(1),
+				"two":   testProposal(3),
+				"three": testProposal(2),
+			},
+			scores: map[string]float64{
+				"one":   1,
+				"two":   3,
+				"three": 2,
+			},
+			provider: "two",
+		},
+		{
+			name: "Tied",
+			proposals: map[string]*spec.VersionedBeaconBlock{
+				"two":   testProposal(2),
+				"one":   testProposal(2),
+				"three": testProposal(1),
+			},
+			scores: map[string]float64{
+				"one":   2,
+				"two":   2,
+				"three": 1,
+			},
+			provider: "one",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider, proposal := bestBeaconBlockProposal(test.proposals, test.scores)
+			require.Equal(t, test.provider, provider)
+			if test.provider == "" {
+				require.Nil(t, proposal)
+			} else {
+				require.Equal(t, test.proposals[test.provider], proposal)
+			}
+		})
+	}
+}
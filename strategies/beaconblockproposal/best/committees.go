@@ -0,0 +1,92 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package best
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// committeeSizes are the sizes of beacon committees, keyed by slot and committee index.
+type committeeSizes map[phase0.Slot]map[phase0.CommitteeIndex]uint64
+
+// committeeSizesForEpoch obtains the committee sizes for the given epoch,
+// fetching them if they are not already cached.
+func (s *Service) committeeSizesForEpoch(ctx context.Context, epoch phase0.Epoch) (committeeSizes, error) {
+	s.committeeSizesMu.RLock()
+	sizes, exists := s.committeeSizes[epoch]
+	s.committeeSizesMu.RUnlock()
+	if exists {
+		return sizes, nil
+	}
+
+	committees, err := s.beaconCommitteesProvider.BeaconCommitteesAtEpoch(ctx, "head", epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain beacon committees")
+	}
+	sizes = make(committeeSizes)
+	for _, committee := range committees {
+		if _, exists := sizes[committee.Slot]; !exists {
+			sizes[committee.Slot] = make(map[phase0.CommitteeIndex]uint64)
+		}
+		sizes[committee.Slot][committee.Index] = uint64(len(committee.Validators))
+	}
+
+	s.committeeSizesMu.Lock()
+	s.committeeSizes[epoch] = sizes
+	// Attestations can only be included for a limited time, so older epochs are not required.
+	for cachedEpoch := range s.committeeSizes {
+		if cachedEpoch+2 < epoch {
+			delete(s.committeeSizes, cachedEpoch)
+		}
+	}
+	s.committeeSizesMu.Unlock()
+
+	return sizes, nil
+}
+
+// attestationMatchesCommittee checks that an attestation refers to a valid
+// committee, and that its aggregation bits match the size of that committee.
+// If committee validation is not enabled, or committee information cannot be
+// obtained, the attestation is assumed to be valid.
+func (s *Service) attestationMatchesCommittee(ctx context.Context,
+	name string,
+	attestation *phase0.Attestation,
+) bool {
+	if s.beaconCommitteesProvider == nil {
+		return true
+	}
+
+	data := attestation.Data
+	sizes, err := s.committeeSizesForEpoch(ctx, s.chainTime.SlotToEpoch(data.Slot))
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to obtain committees for attestation; assuming valid")
+		return true
+	}
+
+	size, exists := sizes[data.Slot][data.Index]
+	if !exists {
+		log.Debug().Str("provider", name).Uint64("slot", uint64(data.Slot)).Uint64("committee_index", uint64(data.Index)).Msg("Attestation for unknown committee; ignoring")
+		return false
+	}
+	// The aggregation bits of a valid attestation have exactly one bit per committee member.
+	if attestation.AggregationBits.Len() != size {
+		log.Debug().Str("provider", name).Uint64("slot", uint64(data.Slot)).Uint64("committee_index", uint64(data.Index)).Uint64("aggregation_bits", attestation.AggregationBits.Len()).Uint64("committee_size", size).Msg("Attestation does not match committee size; ignoring")
+		return false
+	}
+
+	return true
+}
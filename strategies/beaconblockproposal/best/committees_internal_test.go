@@ -0,0 +1,173 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package best
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/cache"
+	mockcache "github.com/attestantio/vouch/services/cache/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	"github.com/attestantio/vouch/services/metrics/null"
+	"github.com/attestantio/vouch/testutil"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testBeaconCommitteesProvider provides a single committee of 128 validators
+// at index 0 for each slot in the requested epoch.
+type testBeaconCommitteesProvider struct {
+	calls int
+}
+
+func (p *testBeaconCommitteesProvider) BeaconCommittees(ctx context.Context, _ string) ([]*apiv1.BeaconCommittee, error) {
+	return p.BeaconCommitteesAtEpoch(ctx, "head", 0)
+}
+
+func (p *testBeaconCommitteesProvider) BeaconCommitteesAtEpoch(_ context.Context, _ string, epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, error) {
+	p.calls++
+	committees := make([]*apiv1.BeaconCommittee, 0, 32)
+	for i := uint64(0); i < 32; i++ {
+		committees = append(committees, &apiv1.BeaconCommittee{
+			Slot:       phase0.Slot(uint64(epoch)*32 + i),
+			Index:      0,
+			Validators: make([]phase0.ValidatorIndex, 128),
+		})
+	}
+	return committees, nil
+}
+
+func TestScoreCommitteeValidation(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithLogLevel(zerolog.Disabled),
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	cacheSvc := mockcache.New(map[phase0.Root]phase0.Slot{
+		testutil.HexToRoot("0x0202020202020202020202020202020202020202020202020202020202020202"): phase0.Slot(12345),
+	})
+
+	attestation := func(index phase0.CommitteeIndex, bits bitfield.Bitlist) *phase0.Attestation {
+		return &phase0.Attestation{
+			AggregationBits: bits,
+			Data: &phase0.AttestationData{
+				Slot:  12345,
+				Index: index,
+				Target: &phase0.Checkpoint{
+					Root:  testutil.HexToRoot("0x0101010101010101010101010101010101010101010101010101010101010101"),
+					Epoch: 385,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		attestations []*phase0.Attestation
+		validate     bool
+		score        float64
+	}{
+		{
+			name:         "Valid",
+			attestations: []*phase0.Attestation{attestation(0, bitList(1, 128))},
+			validate:     true,
+			score:        1,
+		},
+		{
+			name:         "OversizedNotValidated",
+			attestations: []*phase0.Attestation{attestation(0, bitList(200, 256))},
+			score:        200,
+		},
+		{
+			name:         "Oversized",
+			attestations: []*phase0.Attestation{attestation(0, bitList(200, 256))},
+			validate:     true,
+			score:        0,
+		},
+		{
+			name:         "Undersized",
+			attestations: []*phase0.Attestation{attestation(0, bitList(1, 64))},
+			validate:     true,
+			score:        0,
+		},
+		{
+			name:         "UnknownCommittee",
+			attestations: []*phase0.Attestation{attestation(5, bitList(1, 128))},
+			validate:     true,
+			score:        0,
+		},
+		{
+			name: "Mixed",
+			attestations: []*phase0.Attestation{
+				attestation(0, bitList(200, 256)),
+				attestation(0, bitList(2, 128)),
+			},
+			validate: true,
+			score:    2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			committeesProvider := &testBeaconCommitteesProvider{}
+			params := []Parameter{
+				WithLogLevel(zerolog.Disabled),
+				WithTimeout(2 * time.Second),
+				WithClientMonitor(null.New(context.Background())),
+				WithEventsProvider(mock.NewEventsProvider()),
+				WithChainTimeService(chainTime),
+				WithSpecProvider(mock.NewSpecProvider()),
+				WithProcessConcurrency(6),
+				WithBeaconBlockProposalProviders(map[string]eth2client.BeaconBlockProposalProvider{
+					"one": mock.NewBeaconBlockProposalProvider(),
+				}),
+				WithSignedBeaconBlockProvider(mock.NewSignedBeaconBlockProvider()),
+				WithBlockRootToSlotCache(cacheSvc.(cache.BlockRootToSlotProvider)),
+			}
+			if test.validate {
+				params = append(params, WithBeaconCommitteesProvider(committeesProvider))
+			}
+			s, err := New(ctx, params...)
+			require.NoError(t, err)
+
+			block := &spec.VersionedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+				Phase0: &phase0.BeaconBlock{
+					Slot:       12346,
+					ParentRoot: testutil.HexToRoot("0x0202020202020202020202020202020202020202020202020202020202020202"),
+					Body: &phase0.BeaconBlockBody{
+						Attestations: test.attestations,
+					},
+				},
+			}
+			require.Equal(t, test.score, s.scoreBeaconBlockProposal(ctx, test.name, block))
+
+			// Committees should be fetched at most once for the epoch.
+			require.LessOrEqual(t, committeesProvider.calls, 1)
+		})
+	}
+}
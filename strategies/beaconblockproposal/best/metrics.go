@@ -0,0 +1,60 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package best
+
+import (
+	"context"
+
+	"github.com/attestantio/vouch/services/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var lowScoreCounter prometheus.Counter
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if lowScoreCounter != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	lowScoreCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "vouch",
+		Subsystem: "beaconblockproposal_strategy",
+		Name:      "low_score_total",
+		Help:      "The number of best beacon block proposals that scored below the minimum score.",
+	})
+	if err := prometheus.Register(lowScoreCounter); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// monitorLowScore increments the low score counter.
+func monitorLowScore() {
+	if lowScoreCounter == nil {
+		return
+	}
+	lowScoreCounter.Inc()
+}
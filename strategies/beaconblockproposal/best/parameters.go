@@ -39,6 +39,11 @@ type parameters struct {
 	signedBeaconBlockProvider    eth2client.SignedBeaconBlockProvider
 	timeout                      time.Duration
 	blockRootToSlotCache         cache.BlockRootToSlotProvider
+	beaconCommitteesProvider     eth2client.BeaconCommitteesProvider
+	validatorsProvider           eth2client.ValidatorsProvider
+	monitor                      metrics.Service
+	minScore                     float64
+	scorer                       Scorer
 }
 
 // Parameter is the interface for service parameters.
@@ -122,11 +127,52 @@ func WithBlockRootToSlotCache(cache cache.BlockRootToSlotProvider) Parameter {
 	})
 }
 
+// WithBeaconCommitteesProvider sets the beacon committees provider.
+// If supplied, attestations in proposals are validated against their
+// committees prior to scoring.
+func WithBeaconCommitteesProvider(provider eth2client.BeaconCommitteesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconCommitteesProvider = provider
+	})
+}
+
+// WithValidatorsProvider sets the validators provider.
+// If supplied, slashings in proposals are weighted by the effective balance
+// of the slashed validators rather than counted at the maximum effective balance.
+func WithValidatorsProvider(provider eth2client.ValidatorsProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorsProvider = provider
+	})
+}
+
+// WithMonitor sets the monitor for the module.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithMinScore sets the minimum score for the best proposal, below which a
+// warning is logged.
+func WithMinScore(minScore float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.minScore = minScore
+	})
+}
+
+// WithScorer sets the method used to score proposals.
+func WithScorer(scorer Scorer) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.scorer = scorer
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
 		logLevel:      zerolog.GlobalLevel(),
 		clientMonitor: nullmetrics.New(context.Background()),
+		scorer:        ScorerHeuristic,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -161,6 +207,14 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.blockRootToSlotCache == nil {
 		return nil, errors.New("no block root to slot cache specified")
 	}
+	if parameters.minScore < 0 {
+		return nil, errors.New("min score cannot be negative")
+	}
+	switch parameters.scorer {
+	case ScorerHeuristic, ScorerReward:
+	default:
+		return nil, errors.Errorf("unknown scorer %q", parameters.scorer)
+	}
 
 	return &parameters, nil
 }
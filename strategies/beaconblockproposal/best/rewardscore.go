@@ -0,0 +1,320 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package best
+
+import (
+	"context"
+	"math"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// rewardBlock contains the parts of a block that provide proposer rewards.
+type rewardBlock struct {
+	slot              phase0.Slot
+	parentRoot        phase0.Root
+	attestations      []*phase0.Attestation
+	attesterSlashings []*phase0.AttesterSlashing
+	proposerSlashings []*phase0.ProposerSlashing
+	syncAggregate     *altair.SyncAggregate
+}
+
+// rewardScoreBeaconBlockProposal generates a score for a beacon block that is
+// an estimate of the proposer reward for the block, in gwei.
+func (s *Service) rewardScoreBeaconBlockProposal(ctx context.Context,
+	name string,
+	blockProposal *spec.VersionedBeaconBlock,
+) float64 {
+	if blockProposal == nil {
+		return 0
+	}
+	if blockProposal.IsEmpty() {
+		return 0
+	}
+
+	var block *rewardBlock
+	switch blockProposal.Version {
+	case spec.DataVersionPhase0:
+		block = &rewardBlock{
+			slot:              blockProposal.Phase0.Slot,
+			parentRoot:        blockProposal.Phase0.ParentRoot,
+			attestations:      blockProposal.Phase0.Body.Attestations,
+			attesterSlashings: blockProposal.Phase0.Body.AttesterSlashings,
+			proposerSlashings: blockProposal.Phase0.Body.ProposerSlashings,
+		}
+	case spec.DataVersionAltair:
+		block = &rewardBlock{
+			slot:              blockProposal.Altair.Slot,
+			parentRoot:        blockProposal.Altair.ParentRoot,
+			attestations:      blockProposal.Altair.Body.Attestations,
+			attesterSlashings: blockProposal.Altair.Body.AttesterSlashings,
+			proposerSlashings: blockProposal.Altair.Body.ProposerSlashings,
+			syncAggregate:     blockProposal.Altair.Body.SyncAggregate,
+		}
+	case spec.DataVersionBellatrix:
+		block = &rewardBlock{
+			slot:              blockProposal.Bellatrix.Slot,
+			parentRoot:        blockProposal.Bellatrix.ParentRoot,
+			attestations:      blockProposal.Bellatrix.Body.Attestations,
+			attesterSlashings: blockProposal.Bellatrix.Body.AttesterSlashings,
+			proposerSlashings: blockProposal.Bellatrix.Body.ProposerSlashings,
+			syncAggregate:     blockProposal.Bellatrix.Body.SyncAggregate,
+		}
+	case spec.DataVersionCapella:
+		block = &rewardBlock{
+			slot:              blockProposal.Capella.Slot,
+			parentRoot:        blockProposal.Capella.ParentRoot,
+			attestations:      blockProposal.Capella.Body.Attestations,
+			attesterSlashings: blockProposal.Capella.Body.AttesterSlashings,
+			proposerSlashings: blockProposal.Capella.Body.ProposerSlashings,
+			syncAggregate:     blockProposal.Capella.Body.SyncAggregate,
+		}
+	default:
+		log.Error().Int("version", int(blockProposal.Version)).Msg("Unhandled block version")
+		return 0
+	}
+
+	// The base reward per increment depends on the total active balance.
+	// All active validators are assumed to have the maximum effective balance.
+	activeValidators := s.activeValidators(ctx, block)
+	totalActiveBalance := activeValidators * s.maxEffectiveBalance
+	baseRewardPerIncrement := uint64(0)
+	if totalActiveBalance > 0 {
+		baseRewardPerIncrement = s.effectiveBalanceIncrement * s.baseRewardFactor / integerSquareRoot(totalActiveBalance)
+	}
+
+	var attestationReward float64
+	if blockProposal.Version == spec.DataVersionPhase0 {
+		attestationReward = s.phase0AttestationReward(ctx, name, block, totalActiveBalance)
+	} else {
+		attestationReward = s.altairAttestationReward(ctx, name, block, baseRewardPerIncrement)
+	}
+
+	balances := s.slashedBalances(ctx, block.attesterSlashings, block.proposerSlashings)
+	slashingReward := s.slashingReward(block.attesterSlashings, block.proposerSlashings, balances)
+
+	syncCommitteeReward := float64(0)
+	if block.syncAggregate != nil {
+		totalBaseRewards := baseRewardPerIncrement * (totalActiveBalance / s.effectiveBalanceIncrement)
+		maxParticipantRewards := totalBaseRewards * s.syncRewardWeight / s.weightDenominator / s.slotsPerEpoch
+		participantReward := float64(maxParticipantRewards / s.syncCommitteeSize)
+		syncCommitteeReward = float64(block.syncAggregate.SyncCommitteeBits.Count()) *
+			participantReward * float64(s.proposerWeight) / float64(s.weightDenominator-s.proposerWeight)
+	}
+
+	log.Trace().
+		Uint64("slot", uint64(block.slot)).
+		Str("provider", name).
+		Uint64("active_validators", activeValidators).
+		Float64("attestations", attestationReward).
+		Float64("slashings", slashingReward).
+		Float64("sync_committee", syncCommitteeReward).
+		Float64("total", attestationReward+slashingReward+syncCommitteeReward).
+		Msg("Estimated block reward")
+
+	return attestationReward + slashingReward + syncCommitteeReward
+}
+
+// altairAttestationReward estimates the proposer reward for the attestations
+// in an Altair or later block, in gwei.
+func (s *Service) altairAttestationReward(ctx context.Context,
+	name string,
+	block *rewardBlock,
+	baseRewardPerIncrement uint64,
+) float64 {
+	baseReward := float64(s.maxEffectiveBalance / s.effectiveBalanceIncrement * baseRewardPerIncrement)
+
+	// The proposer receives a proportion of the rewards for the attestation
+	// participation flags that are newly set by the block.
+	numerator := float64(0)
+	attested := make(map[phase0.Slot]map[phase0.CommitteeIndex]bitfield.Bitlist)
+	for _, attestation := range block.attestations {
+		if !s.attestationMatchesCommittee(ctx, name, attestation) {
+			continue
+		}
+		priorVotes, err := s.priorVotesForAttestation(ctx, attestation, block.parentRoot)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to obtain prior votes for attestation; assuming no votes")
+		}
+		votes := newVotes(attested, attestation, priorVotes)
+
+		inclusionDistance := block.slot - attestation.Data.Slot
+		weight := uint64(0)
+		if s.altairTargetCorrect(ctx, attestation) {
+			weight += s.timelyTargetWeight
+		}
+		if inclusionDistance <= 5 {
+			weight += s.timelySourceWeight
+		}
+		if inclusionDistance == 1 && block.parentRoot == attestation.Data.BeaconBlockRoot {
+			weight += s.timelyHeadWeight
+		}
+		numerator += float64(votes) * baseReward * float64(weight)
+	}
+
+	denominator := float64((s.weightDenominator-s.proposerWeight)*s.weightDenominator) / float64(s.proposerWeight)
+
+	return numerator / denominator
+}
+
+// phase0AttestationReward estimates the proposer reward for the attestations
+// in a phase 0 block, in gwei.
+func (s *Service) phase0AttestationReward(ctx context.Context,
+	name string,
+	block *rewardBlock,
+	totalActiveBalance uint64,
+) float64 {
+	if totalActiveBalance == 0 {
+		return 0
+	}
+	baseReward := s.maxEffectiveBalance * s.baseRewardFactor / integerSquareRoot(totalActiveBalance) / s.baseRewardsPerEpoch
+	proposerReward := float64(baseReward / s.proposerRewardQuotient)
+
+	votes := 0
+	attested := make(map[phase0.Slot]map[phase0.CommitteeIndex]bitfield.Bitlist)
+	for _, attestation := range block.attestations {
+		if !s.attestationMatchesCommittee(ctx, name, attestation) {
+			continue
+		}
+		votes += newVotes(attested, attestation, bitfield.NewBitlist(attestation.AggregationBits.Len()))
+	}
+
+	return float64(votes) * proposerReward
+}
+
+// slashingReward estimates the proposer reward for the slashings in a block,
+// in gwei.  The proposer is also the whistleblower, so receives the full
+// whistleblower reward for each slashed validator.  If balances are supplied
+// then the reward is based on the effective balance of each slashed validator,
+// otherwise on the maximum effective balance.
+func (s *Service) slashingReward(attesterSlashings []*phase0.AttesterSlashing,
+	proposerSlashings []*phase0.ProposerSlashing,
+	balances map[phase0.ValidatorIndex]phase0.Gwei,
+) float64 {
+	proposerIndices, attesterIndices := slashedIndices(attesterSlashings, proposerSlashings)
+	weight := s.balanceWeight(proposerIndices, balances) + s.balanceWeight(attesterIndices, balances)
+
+	return weight * float64(s.maxEffectiveBalance/s.whistleblowerRewardQuotient)
+}
+
+// activeValidators obtains the number of active validators.  This is exact if
+// committee information is available, otherwise it is estimated from the
+// committees of the attestations in the block.
+func (s *Service) activeValidators(ctx context.Context, block *rewardBlock) uint64 {
+	if s.beaconCommitteesProvider != nil {
+		sizes, err := s.committeeSizesForEpoch(ctx, s.chainTime.SlotToEpoch(block.slot))
+		if err == nil {
+			total := uint64(0)
+			for _, slotSizes := range sizes {
+				for _, size := range slotSizes {
+					total += size
+				}
+			}
+			return total
+		}
+		log.Debug().Err(err).Msg("Failed to obtain committees; estimating active validators from block")
+	}
+
+	// Committees are of equal size (to within one), so the number of active
+	// validators is approximately the committee size multiplied by the number
+	// of committees in the epoch.
+	committees := make(map[phase0.Slot]map[phase0.CommitteeIndex]uint64)
+	maxIndex := phase0.CommitteeIndex(0)
+	for _, attestation := range block.attestations {
+		if _, exists := committees[attestation.Data.Slot]; !exists {
+			committees[attestation.Data.Slot] = make(map[phase0.CommitteeIndex]uint64)
+		}
+		committees[attestation.Data.Slot][attestation.Data.Index] = attestation.AggregationBits.Len()
+		if attestation.Data.Index > maxIndex {
+			maxIndex = attestation.Data.Index
+		}
+	}
+	totalSize := uint64(0)
+	numCommittees := uint64(0)
+	for _, slotCommittees := range committees {
+		for _, size := range slotCommittees {
+			totalSize += size
+			numCommittees++
+		}
+	}
+	if numCommittees == 0 {
+		return 0
+	}
+
+	return totalSize / numCommittees * (uint64(maxIndex) + 1) * s.slotsPerEpoch
+}
+
+// newVotes returns the number of votes in the attestation that are neither
+// in prior votes nor already attested in the block, and marks them as attested.
+func newVotes(attested map[phase0.Slot]map[phase0.CommitteeIndex]bitfield.Bitlist,
+	attestation *phase0.Attestation,
+	priorVotes bitfield.Bitlist,
+) int {
+	data := attestation.Data
+	if _, exists := attested[data.Slot]; !exists {
+		attested[data.Slot] = make(map[phase0.CommitteeIndex]bitfield.Bitlist)
+	}
+	if _, exists := attested[data.Slot][data.Index]; !exists {
+		attested[data.Slot][data.Index] = bitfield.NewBitlist(attestation.AggregationBits.Len())
+	}
+
+	votes := 0
+	for i := uint64(0); i < attestation.AggregationBits.Len(); i++ {
+		if !attestation.AggregationBits.BitAt(i) {
+			continue
+		}
+		if attested[data.Slot][data.Index].BitAt(i) || priorVotes.BitAt(i) {
+			continue
+		}
+		votes++
+		attested[data.Slot][data.Index].SetBitAt(i, true)
+	}
+
+	return votes
+}
+
+// integerSquareRoot returns the largest integer whose square is at most n.
+func integerSquareRoot(n uint64) uint64 {
+	res := uint64(math.Sqrt(float64(n)))
+	if res > math.MaxUint32 {
+		res = math.MaxUint32
+	}
+	// Correct for floating point inaccuracy.
+	for res*res > n {
+		res--
+	}
+	for res < math.MaxUint32 && (res+1)*(res+1) <= n {
+		res++
+	}
+
+	return res
+}
+
+// specUint64 obtains a uint64 value from the spec, with a default if it is not present.
+func specUint64(spec map[string]interface{}, name string, defaultValue uint64) (uint64, error) {
+	tmp, exists := spec[name]
+	if !exists {
+		return defaultValue, nil
+	}
+	val, ok := tmp.(uint64)
+	if !ok {
+		return 0, errors.Errorf("%s of unexpected type", name)
+	}
+
+	return val, nil
+}
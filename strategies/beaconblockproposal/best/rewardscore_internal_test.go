@@ -0,0 +1,240 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package best
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/vouch/mock"
+	"github.com/attestantio/vouch/services/cache"
+	mockcache "github.com/attestantio/vouch/services/cache/mock"
+	standardchaintime "github.com/attestantio/vouch/services/chaintime/standard"
+	"github.com/attestantio/vouch/services/metrics/null"
+	"github.com/attestantio/vouch/testutil"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testScoringService creates a service with the given scorer.
+func testScoringService(ctx context.Context, t *testing.T, scorer Scorer) *Service {
+	t.Helper()
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithLogLevel(zerolog.Disabled),
+		standardchaintime.WithGenesisTimeProvider(mock.NewGenesisTimeProvider(time.Now())),
+		standardchaintime.WithSlotDurationProvider(mock.NewSlotDurationProvider(12*time.Second)),
+		standardchaintime.WithSlotsPerEpochProvider(mock.NewSlotsPerEpochProvider(32)),
+	)
+	require.NoError(t, err)
+
+	cacheSvc := mockcache.New(map[phase0.Root]phase0.Slot{
+		testutil.HexToRoot("0x0202020202020202020202020202020202020202020202020202020202020202"): phase0.Slot(12345),
+	})
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithTimeout(2*time.Second),
+		WithClientMonitor(null.New(ctx)),
+		WithEventsProvider(mock.NewEventsProvider()),
+		WithChainTimeService(chainTime),
+		WithSpecProvider(mock.NewSpecProvider()),
+		WithProcessConcurrency(6),
+		WithBeaconBlockProposalProviders(map[string]eth2client.BeaconBlockProposalProvider{
+			"one": mock.NewBeaconBlockProposalProvider(),
+		}),
+		WithSignedBeaconBlockProvider(mock.NewSignedBeaconBlockProvider()),
+		WithBlockRootToSlotCache(cacheSvc.(cache.BlockRootToSlotProvider)),
+		WithScorer(scorer),
+	)
+	require.NoError(t, err)
+
+	return s
+}
+
+// testAltairBlock creates an Altair block with the given contents.
+func testAltairBlock(attestations []*phase0.Attestation,
+	proposerSlashings []*phase0.ProposerSlashing,
+	syncCommitteeBits bitfield.Bitvector512,
+) *spec.VersionedBeaconBlock {
+	return &spec.VersionedBeaconBlock{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.BeaconBlock{
+			Slot:       12346,
+			ParentRoot: testutil.HexToRoot("0x0202020202020202020202020202020202020202020202020202020202020202"),
+			Body: &altair.BeaconBlockBody{
+				Attestations:      attestations,
+				ProposerSlashings: proposerSlashings,
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: syncCommitteeBits,
+				},
+			},
+		},
+	}
+}
+
+// testFullAttestations creates full attestations for the previous slot, for
+// committees 0 to 31 and 63, implying 64 committees per slot.
+func testFullAttestations() []*phase0.Attestation {
+	attestations := make([]*phase0.Attestation, 0, 33)
+	for _, index := range append([]phase0.CommitteeIndex{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+		16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	}, 63) {
+		attestations = append(attestations, &phase0.Attestation{
+			AggregationBits: bitList(128, 128),
+			Data: &phase0.AttestationData{
+				Slot:            12345,
+				Index:           index,
+				BeaconBlockRoot: testutil.HexToRoot("0x0202020202020202020202020202020202020202020202020202020202020202"),
+				Source: &phase0.Checkpoint{
+					Epoch: 384,
+					Root:  testutil.HexToRoot("0x0101010101010101010101010101010101010101010101010101010101010101"),
+				},
+				Target: &phase0.Checkpoint{
+					Epoch: 385,
+					Root:  testutil.HexToRoot("0x0202020202020202020202020202020202020202020202020202020202020202"),
+				},
+			},
+		})
+	}
+
+	return attestations
+}
+
+func testProposerSlashings() []*phase0.ProposerSlashing {
+	return []*phase0.ProposerSlashing{
+		{
+			SignedHeader1: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{Slot: 10, ProposerIndex: 1},
+			},
+			SignedHeader2: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{Slot: 10, ProposerIndex: 1},
+			},
+		},
+	}
+}
+
+func TestRewardScore(t *testing.T) {
+	ctx := context.Background()
+
+	fullSyncCommitteeBits := bitfield.NewBitvector512()
+	for i := uint64(0); i < 512; i++ {
+		fullSyncCommitteeBits.SetBitAt(i, true)
+	}
+
+	tests := []struct {
+		name  string
+		block *spec.VersionedBeaconBlock
+		score float64
+	}{
+		{
+			name:  "Nil",
+			score: 0,
+		},
+		{
+			name:  "Empty",
+			block: &spec.VersionedBeaconBlock{},
+			score: 0,
+		},
+		{
+			name:  "ProposerSlashing",
+			block: testAltairBlock(nil, testProposerSlashings(), bitfield.NewBitvector512()),
+			// MAX_EFFECTIVE_BALANCE / WHISTLEBLOWER_REWARD_QUOTIENT.
+			score: 62500000,
+		},
+		{
+			name:  "Attestations",
+			block: testAltairBlock(testFullAttestations(), nil, bitfield.NewBitvector512()),
+			// 4224 votes with all flags, base reward 22336 gwei with 262144 active validators.
+			score: 4224 * 22336 * 54 / 448.0,
+		},
+		{
+			name:  "AttestationsAndSyncAggregate",
+			block: testAltairBlock(testFullAttestations(), nil, fullSyncCommitteeBits),
+			// As above, plus 512 sync committee participants with a participant reward of 11168 gwei.
+			score: 4224*22336*54/448.0 + 512*11168*8/56.0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testScoringService(ctx, t, ScorerReward)
+			require.InDelta(t, test.score, s.scoreBeaconBlockProposal(ctx, test.name, test.block), 0.001)
+		})
+	}
+}
+
+// scoreTestProposals scores each of the proposals with the given service.
+func scoreTestProposals(ctx context.Context,
+	s *Service,
+	proposals map[string]*spec.VersionedBeaconBlock,
+) map[string]float64 {
+	scores := make(map[string]float64, len(proposals))
+	for name, proposal := range proposals {
+		scores[name] = s.scoreBeaconBlockProposal(ctx, name, proposal)
+	}
+
+	return scores
+}
+
+func TestScorersCompared(t *testing.T) {
+	ctx := context.Background()
+
+	proposals := map[string]*spec.VersionedBeaconBlock{
+		"attestations": testAltairBlock(testFullAttestations(), nil, bitfield.NewBitvector512()),
+		"slashing":     testAltairBlock(nil, testProposerSlashings(), bitfield.NewBitvector512()),
+	}
+
+	// The heuristic weights a slashing as about 2,700 attestations, so prefers
+	// the block with 4,224 new votes.
+	heuristic := testScoringService(ctx, t, ScorerHeuristic)
+	scores := scoreTestProposals(ctx, heuristic, proposals)
+	provider, _ := bestBeaconBlockProposal(proposals, scores)
+	require.Equal(t, "attestations", provider)
+	require.Equal(t, float64(3564), scores["attestations"])
+	require.Equal(t, float64(2700), scores["slashing"])
+
+	// The whistleblower reward for the slashing is worth more than the
+	// attestations with this number of active validators.
+	reward := testScoringService(ctx, t, ScorerReward)
+	scores = scoreTestProposals(ctx, reward, proposals)
+	provider, _ = bestBeaconBlockProposal(proposals, scores)
+	require.Equal(t, "slashing", provider)
+	require.Greater(t, scores["slashing"], scores["attestations"])
+}
+
+func TestIntegerSquareRoot(t *testing.T) {
+	tests := []struct {
+		n   uint64
+		res uint64
+	}{
+		{n: 0, res: 0},
+		{n: 1, res: 1},
+		{n: 15, res: 3},
+		{n: 16, res: 4},
+		{n: 8388608000000000, res: 91589344},
+		{n: 0xffffffffffffffff, res: 0xffffffff},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.res, integerSquareRoot(test.n))
+	}
+}
@@ -27,12 +27,28 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 )
 
+// Scorer is the method used to score proposals.
+type Scorer string
+
+const (
+	// ScorerHeuristic scores proposals with weights relative to the reward
+	// expected by proposing the block.
+	ScorerHeuristic Scorer = "heuristic"
+	// ScorerReward scores proposals with an estimate of the proposer reward
+	// for the block, in gwei.
+	ScorerReward Scorer = "reward"
+)
+
 // scoreBeaconBlockPropsal generates a score for a beacon block.
 // The score is relative to the reward expected by proposing the block.
 func (s *Service) scoreBeaconBlockProposal(ctx context.Context,
 	name string,
 	blockProposal *spec.VersionedBeaconBlock,
 ) float64 {
+	if s.scorer == ScorerReward {
+		return s.rewardScoreBeaconBlockProposal(ctx, name, blockProposal)
+	}
+
 	if blockProposal == nil {
 		return 0
 	}
@@ -69,7 +85,7 @@ func (s *Service) scoreBeaconBlockProposal(ctx context.Context,
 }
 
 // scorePhase0BeaconBlockPropsal generates a score for a phase 0 beacon block.
-func (*Service) scorePhase0BeaconBlockProposal(_ context.Context,
+func (s *Service) scorePhase0BeaconBlockProposal(ctx context.Context,
 	name string,
 	parentSlot phase0.Slot,
 	blockProposal *phase0.BeaconBlock,
@@ -81,6 +97,9 @@ func (*Service) scorePhase0BeaconBlockProposal(_ context.Context,
 	// Map is attestation slot -> committee index -> validator committee index -> aggregate.
 	attested := make(map[phase0.Slot]map[phase0.CommitteeIndex]bitfield.Bitlist)
 	for _, attestation := range blockProposal.Body.Attestations {
+		if !s.attestationMatchesCommittee(ctx, name, attestation) {
+			continue
+		}
 		data := attestation.Data
 		if _, exists := attested[data.Slot]; !exists {
 			attested[data.Slot] = make(map[phase0.CommitteeIndex]bitfield.Bitlist)
@@ -104,7 +123,8 @@ func (*Service) scorePhase0BeaconBlockProposal(_ context.Context,
 		}
 	}
 
-	attesterSlashingScore, proposerSlashingScore := scoreSlashings(blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings)
+	balances := s.slashedBalances(ctx, blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings)
+	attesterSlashingScore, proposerSlashingScore := s.scoreSlashings(blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings, balances)
 
 	// Scale scores by the distance between the proposal and parent slots.
 	var scale uint64
@@ -143,6 +163,9 @@ func (s *Service) scoreAltairBeaconBlockProposal(ctx context.Context,
 	// Map is attestation slot -> committee index -> validator committee index -> aggregate.
 	attested := make(map[phase0.Slot]map[phase0.CommitteeIndex]bitfield.Bitlist)
 	for _, attestation := range blockProposal.Body.Attestations {
+		if !s.attestationMatchesCommittee(ctx, name, attestation) {
+			continue
+		}
 		data := attestation.Data
 		if _, exists := attested[data.Slot]; !exists {
 			attested[data.Slot] = make(map[phase0.CommitteeIndex]bitfield.Bitlist)
@@ -201,7 +224,8 @@ func (s *Service) scoreAltairBeaconBlockProposal(ctx context.Context,
 		}
 	}
 
-	attesterSlashingScore, proposerSlashingScore := scoreSlashings(blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings)
+	balances := s.slashedBalances(ctx, blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings)
+	attesterSlashingScore, proposerSlashingScore := s.scoreSlashings(blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings, balances)
 
 	// Add sync committee score.
 	syncCommitteeScore := float64(blockProposal.Body.SyncAggregate.SyncCommitteeBits.Count()) * float64(s.syncRewardWeight) / float64(s.weightDenominator)
@@ -234,6 +258,9 @@ func (s *Service) scoreBellatrixBeaconBlockProposal(ctx context.Context,
 	// Map is attestation slot -> committee index -> validator committee index -> aggregate.
 	attested := make(map[phase0.Slot]map[phase0.CommitteeIndex]bitfield.Bitlist)
 	for _, attestation := range blockProposal.Body.Attestations {
+		if !s.attestationMatchesCommittee(ctx, name, attestation) {
+			continue
+		}
 		data := attestation.Data
 		if _, exists := attested[data.Slot]; !exists {
 			attested[data.Slot] = make(map[phase0.CommitteeIndex]bitfield.Bitlist)
@@ -292,7 +319,8 @@ func (s *Service) scoreBellatrixBeaconBlockProposal(ctx context.Context,
 		}
 	}
 
-	attesterSlashingScore, proposerSlashingScore := scoreSlashings(blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings)
+	balances := s.slashedBalances(ctx, blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings)
+	attesterSlashingScore, proposerSlashingScore := s.scoreSlashings(blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings, balances)
 
 	// Add sync committee score.
 	syncCommitteeScore := float64(blockProposal.Body.SyncAggregate.SyncCommitteeBits.Count()) * float64(s.syncRewardWeight) / float64(s.weightDenominator)
@@ -325,6 +353,9 @@ func (s *Service) scoreCapellaBeaconBlockProposal(ctx context.Context,
 	// Map is attestation slot -> committee index -> validator committee index -> aggregate.
 	attested := make(map[phase0.Slot]map[phase0.CommitteeIndex]bitfield.Bitlist)
 	for _, attestation := range blockProposal.Body.Attestations {
+		if !s.attestationMatchesCommittee(ctx, name, attestation) {
+			continue
+		}
 		data := attestation.Data
 		if _, exists := attested[data.Slot]; !exists {
 			attested[data.Slot] = make(map[phase0.CommitteeIndex]bitfield.Bitlist)
@@ -383,7 +414,8 @@ func (s *Service) scoreCapellaBeaconBlockProposal(ctx context.Context,
 		}
 	}
 
-	attesterSlashingScore, proposerSlashingScore := scoreSlashings(blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings)
+	balances := s.slashedBalances(ctx, blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings)
+	attesterSlashingScore, proposerSlashingScore := s.scoreSlashings(blockProposal.Body.AttesterSlashings, blockProposal.Body.ProposerSlashings, balances)
 
 	// Add sync committee score.
 	syncCommitteeScore := float64(blockProposal.Body.SyncAggregate.SyncCommitteeBits.Count()) * float64(s.syncRewardWeight) / float64(s.weightDenominator)
@@ -403,8 +435,12 @@ func (s *Service) scoreCapellaBeaconBlockProposal(ctx context.Context,
 	return attestationScore + proposerSlashingScore + attesterSlashingScore + syncCommitteeScore
 }
 
-func scoreSlashings(attesterSlashings []*phase0.AttesterSlashing,
+// scoreSlashings scores the slashings in a block.  If balances are supplied
+// then each slashed validator is weighted by its effective balance, otherwise
+// each is weighted as if it has the maximum effective balance.
+func (s *Service) scoreSlashings(attesterSlashings []*phase0.AttesterSlashing,
 	proposerSlashings []*phase0.ProposerSlashing,
+	balances map[phase0.ValidatorIndex]phase0.Gwei,
 ) (float64, float64) {
 	// Slashing reward will be at most MAX_EFFECTIVE_BALANCE/WHISTLEBLOWER_REWARD_QUOTIENT,
 	// which is 0.0625 Ether.
@@ -412,15 +448,13 @@ func scoreSlashings(attesterSlashings []*phase0.AttesterSlashing,
 	// So we state that a single slashing event has the same weight as about 2,700 attestations.
 	slashingWeight := float64(2700)
 
+	proposerIndices, attesterIndices := slashedIndices(attesterSlashings, proposerSlashings)
+
 	// Add proposer slashing scores.
-	proposerSlashingScore := float64(len(proposerSlashings)) * slashingWeight
+	proposerSlashingScore := slashingWeight * s.balanceWeight(proposerIndices, balances)
 
 	// Add attester slashing scores.
-	indicesSlashed := 0
-	for _, slashing := range attesterSlashings {
-		indicesSlashed += len(intersection(slashing.Attestation1.AttestingIndices, slashing.Attestation2.AttestingIndices))
-	}
-	attesterSlashingScore := slashingWeight * float64(indicesSlashed)
+	attesterSlashingScore := slashingWeight * s.balanceWeight(attesterIndices, balances)
 
 	return attesterSlashingScore, proposerSlashingScore
 }
@@ -38,6 +38,10 @@ type Service struct {
 	signedBeaconBlockProvider    eth2client.SignedBeaconBlockProvider
 	timeout                      time.Duration
 	blockRootToSlotCache         cache.BlockRootToSlotProvider
+	beaconCommitteesProvider     eth2client.BeaconCommitteesProvider
+	validatorsProvider           eth2client.ValidatorsProvider
+	minScore                     float64
+	scorer                       Scorer
 
 	// Spec values for scoring proposals.
 	slotsPerEpoch      uint64
@@ -48,8 +52,20 @@ type Service struct {
 	proposerWeight     uint64
 	weightDenominator  uint64
 
+	// Spec values for estimating proposer rewards.
+	baseRewardFactor            uint64
+	baseRewardsPerEpoch         uint64
+	effectiveBalanceIncrement   uint64
+	maxEffectiveBalance         uint64
+	proposerRewardQuotient      uint64
+	whistleblowerRewardQuotient uint64
+	syncCommitteeSize           uint64
+
 	priorBlocksVotes   map[phase0.Root]*priorBlockVotes
 	priorBlocksVotesMu sync.RWMutex
+
+	committeeSizes   map[phase0.Epoch]committeeSizes
+	committeeSizesMu sync.RWMutex
 }
 
 type priorBlockVotes struct {
@@ -76,6 +92,10 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		log = log.Level(parameters.logLevel)
 	}
 
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
 	spec, err := parameters.specProvider.Spec(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain spec")
@@ -150,6 +170,39 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		return nil, errors.New("WEIGHT_DENOMINATOR of unexpected type")
 	}
 
+	// Defaults are based on the mainnet spec.
+	baseRewardFactor, err := specUint64(spec, "BASE_REWARD_FACTOR", 64)
+	if err != nil {
+		return nil, err
+	}
+	baseRewardsPerEpoch, err := specUint64(spec, "BASE_REWARDS_PER_EPOCH", 4)
+	if err != nil {
+		return nil, err
+	}
+	effectiveBalanceIncrement, err := specUint64(spec, "EFFECTIVE_BALANCE_INCREMENT", 1000000000)
+	if err != nil {
+		return nil, err
+	}
+	maxEffectiveBalance, err := specUint64(spec, "MAX_EFFECTIVE_BALANCE", 32000000000)
+	if err != nil {
+		return nil, err
+	}
+	proposerRewardQuotient, err := specUint64(spec, "PROPOSER_REWARD_QUOTIENT", 8)
+	if err != nil {
+		return nil, err
+	}
+	whistleblowerRewardQuotient, err := specUint64(spec, "WHISTLEBLOWER_REWARD_QUOTIENT", 512)
+	if err != nil {
+		return nil, err
+	}
+	syncCommitteeSize, err := specUint64(spec, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return nil, err
+	}
+	if effectiveBalanceIncrement == 0 || proposerRewardQuotient == 0 || whistleblowerRewardQuotient == 0 || syncCommitteeSize == 0 || baseRewardsPerEpoch == 0 {
+		return nil, errors.New("invalid reward values in spec")
+	}
+
 	s := &Service{
 		processConcurrency:           parameters.processConcurrency,
 		chainTime:                    parameters.chainTime,
@@ -157,6 +210,9 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		signedBeaconBlockProvider:    parameters.signedBeaconBlockProvider,
 		timeout:                      parameters.timeout,
 		blockRootToSlotCache:         parameters.blockRootToSlotCache,
+		beaconCommitteesProvider:     parameters.beaconCommitteesProvider,
+		validatorsProvider:           parameters.validatorsProvider,
+		minScore:                     parameters.minScore,
 		clientMonitor:                parameters.clientMonitor,
 		slotsPerEpoch:                slotsPerEpoch,
 		timelySourceWeight:           timelySourceWeight,
@@ -165,7 +221,16 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		syncRewardWeight:             syncRewardWeight,
 		proposerWeight:               proposerWeight,
 		weightDenominator:            weightDenominator,
+		baseRewardFactor:             baseRewardFactor,
+		baseRewardsPerEpoch:          baseRewardsPerEpoch,
+		effectiveBalanceIncrement:    effectiveBalanceIncrement,
+		maxEffectiveBalance:          maxEffectiveBalance,
+		proposerRewardQuotient:       proposerRewardQuotient,
+		whistleblowerRewardQuotient:  whistleblowerRewardQuotient,
+		syncCommitteeSize:            syncCommitteeSize,
+		scorer:                       parameters.scorer,
 		priorBlocksVotes:             make(map[phase0.Root]*priorBlockVotes),
+		committeeSizes:               make(map[phase0.Epoch]committeeSizes),
 	}
 	log.Trace().Int64("process_concurrency", s.processConcurrency).Msg("Set process concurrency")
 
@@ -242,6 +242,27 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no signed beacon block provider specified",
 		},
+		{
+			name: "MinScoreNegative",
+			params: []best.Parameter{
+				best.WithLogLevel(zerolog.Disabled),
+				best.WithTimeout(2 * time.Second),
+				best.WithClientMonitor(null.New(context.Background())),
+				best.WithEventsProvider(mock.NewEventsProvider()),
+				best.WithChainTimeService(chainTime),
+				best.WithSpecProvider(specProvider),
+				best.WithProcessConcurrency(1),
+				best.WithBeaconBlockProposalProviders(map[string]eth2client.BeaconBlockProposalProvider{
+					"one":   mock.NewBeaconBlockProposalProvider(),
+					"two":   mock.NewBeaconBlockProposalProvider(),
+					"three": mock.NewBeaconBlockProposalProvider(),
+				}),
+				best.WithSignedBeaconBlockProvider(mock.NewSignedBeaconBlockProvider()),
+				best.WithBlockRootToSlotCache(blockToSlotCache),
+				best.WithMinScore(-1),
+			},
+			err: "problem with parameters: min score cannot be negative",
+		},
 		{
 			name: "ErroringSpecProvider",
 			params: []best.Parameter{
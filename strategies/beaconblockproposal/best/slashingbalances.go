@@ -0,0 +1,100 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package best
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// slashedIndices returns the indices of the validators slashed by the
+// proposer and attester slashings in a block.
+func slashedIndices(attesterSlashings []*phase0.AttesterSlashing,
+	proposerSlashings []*phase0.ProposerSlashing,
+) (
+	[]phase0.ValidatorIndex,
+	[]phase0.ValidatorIndex,
+) {
+	proposerIndices := make([]phase0.ValidatorIndex, 0, len(proposerSlashings))
+	for _, slashing := range proposerSlashings {
+		proposerIndices = append(proposerIndices, slashing.SignedHeader1.Message.ProposerIndex)
+	}
+
+	attesterIndices := make([]phase0.ValidatorIndex, 0)
+	for _, slashing := range attesterSlashings {
+		for _, index := range intersection(slashing.Attestation1.AttestingIndices, slashing.Attestation2.AttestingIndices) {
+			attesterIndices = append(attesterIndices, phase0.ValidatorIndex(index))
+		}
+	}
+
+	return proposerIndices, attesterIndices
+}
+
+// slashedBalances obtains the effective balances of the validators slashed in
+// a block.  It returns nil if slashings are not weighted by balance, or if the
+// balances cannot be obtained, in which case slashings are weighted as if the
+// slashed validators have the maximum effective balance.
+func (s *Service) slashedBalances(ctx context.Context,
+	attesterSlashings []*phase0.AttesterSlashing,
+	proposerSlashings []*phase0.ProposerSlashing,
+) map[phase0.ValidatorIndex]phase0.Gwei {
+	if s.validatorsProvider == nil {
+		return nil
+	}
+	proposerIndices, attesterIndices := slashedIndices(attesterSlashings, proposerSlashings)
+	indices := append(proposerIndices, attesterIndices...)
+	if len(indices) == 0 {
+		return nil
+	}
+
+	validators, err := s.validatorsProvider.Validators(ctx, "head", indices)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to obtain slashed validators; weighting slashings by maximum effective balance")
+		return nil
+	}
+
+	balances := make(map[phase0.ValidatorIndex]phase0.Gwei, len(validators))
+	for index, validator := range validators {
+		if validator == nil || validator.Validator == nil {
+			continue
+		}
+		balances[index] = validator.Validator.EffectiveBalance
+	}
+
+	return balances
+}
+
+// balanceWeight returns the combined weight of the given validators, where a
+// validator with the maximum effective balance has a weight of 1.  Validators
+// without a known balance are given a weight of 1.
+func (s *Service) balanceWeight(indices []phase0.ValidatorIndex,
+	balances map[phase0.ValidatorIndex]phase0.Gwei,
+) float64 {
+	if balances == nil {
+		return float64(len(indices))
+	}
+
+	weight := float64(0)
+	for _, index := range indices {
+		balance, exists := balances[index]
+		if !exists {
+			weight++
+			continue
+		}
+		weight += float64(balance) / float64(s.maxEffectiveBalance)
+	}
+
+	return weight
+}
@@ -0,0 +1,144 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package best
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// balancesValidatorsProvider provides validators with the given effective balances.
+type balancesValidatorsProvider struct {
+	balances map[phase0.ValidatorIndex]phase0.Gwei
+	err      error
+}
+
+func (p *balancesValidatorsProvider) Validators(_ context.Context, _ string, indices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+	for _, index := range indices {
+		balance, exists := p.balances[index]
+		if !exists {
+			continue
+		}
+		res[index] = &apiv1.Validator{
+			Index:     index,
+			Validator: &phase0.Validator{EffectiveBalance: balance},
+		}
+	}
+
+	return res, nil
+}
+
+func (*balancesValidatorsProvider) ValidatorsByPubKey(_ context.Context, _ string, _ []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestSlashingBalanceWeighting(t *testing.T) {
+	ctx := context.Background()
+
+	// Validator 1, the slashed proposer, has half of the maximum effective balance.
+	halfBalance := &balancesValidatorsProvider{
+		balances: map[phase0.ValidatorIndex]phase0.Gwei{1: 16000000000},
+	}
+	block := testAltairBlock(nil, testProposerSlashings(), bitfield.NewBitvector512())
+
+	tests := []struct {
+		name     string
+		scorer   Scorer
+		provider *balancesValidatorsProvider
+		score    float64
+	}{
+		{
+			name:   "HeuristicFlat",
+			scorer: ScorerHeuristic,
+			score:  2700,
+		},
+		{
+			name:     "HeuristicBalanceWeighted",
+			scorer:   ScorerHeuristic,
+			provider: halfBalance,
+			score:    1350,
+		},
+		{
+			name:   "RewardFlat",
+			scorer: ScorerReward,
+			// MAX_EFFECTIVE_BALANCE / WHISTLEBLOWER_REWARD_QUOTIENT.
+			score: 62500000,
+		},
+		{
+			name:     "RewardBalanceWeighted",
+			scorer:   ScorerReward,
+			provider: halfBalance,
+			score:    31250000,
+		},
+		{
+			name:     "RewardBalanceUnknown",
+			scorer:   ScorerReward,
+			provider: &balancesValidatorsProvider{},
+			score:    62500000,
+		},
+		{
+			name:     "RewardBalanceError",
+			scorer:   ScorerReward,
+			provider: &balancesValidatorsProvider{err: errors.New("mock error")},
+			score:    62500000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testScoringService(ctx, t, test.scorer)
+			if test.provider != nil {
+				s.validatorsProvider = test.provider
+			}
+			require.InDelta(t, test.score, s.scoreBeaconBlockProposal(ctx, test.name, block), 0.001)
+		})
+	}
+}
+
+func TestScoreSlashingsBalanceWeighted(t *testing.T) {
+	ctx := context.Background()
+	s := testScoringService(ctx, t, ScorerHeuristic)
+
+	attesterSlashings := []*phase0.AttesterSlashing{
+		{
+			Attestation1: &phase0.IndexedAttestation{AttestingIndices: []uint64{1, 2, 3}},
+			Attestation2: &phase0.IndexedAttestation{AttestingIndices: []uint64{2, 3, 4}},
+		},
+	}
+	balances := map[phase0.ValidatorIndex]phase0.Gwei{
+		1: 16000000000,
+		2: 8000000000,
+		3: 32000000000,
+	}
+
+	// Validators 2 and 3 are slashed.
+	attesterScore, proposerScore := s.scoreSlashings(attesterSlashings, testProposerSlashings(), nil)
+	require.InDelta(t, 2*2700, attesterScore, 0.001)
+	require.InDelta(t, 2700, proposerScore, 0.001)
+
+	attesterScore, proposerScore = s.scoreSlashings(attesterSlashings, testProposerSlashings(), balances)
+	require.InDelta(t, 0.25*2700+2700, attesterScore, 0.001)
+	require.InDelta(t, 0.5*2700, proposerScore, 0.001)
+}
@@ -52,6 +52,23 @@ func NewLogCapture() *LogCapture {
 	return c
 }
 
+// NewModuleLogCapture captures logs for querying, including those written
+// to a module's logger.  Both the global and the module loggers are
+// restored when the test completes.
+func NewModuleLogCapture(t *testing.T, log *zerolog.Logger) *LogCapture {
+	t.Helper()
+	globalLog := zerologger.Logger
+	moduleLog := *log
+	t.Cleanup(func() {
+		zerologger.Logger = globalLog
+		*log = moduleLog
+	})
+
+	c := NewLogCapture()
+	*log = zerologger.With().Logger()
+	return c
+}
+
 // AssertHasEntry checks if there is a log entry with the given string.
 func (c *LogCapture) AssertHasEntry(t *testing.T, msg string) {
 	t.Helper()
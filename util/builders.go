@@ -16,6 +16,7 @@ package util
 import (
 	"context"
 	"sync"
+	"time"
 
 	builder "github.com/attestantio/go-builder-client"
 	httpclient "github.com/attestantio/go-builder-client/http"
@@ -24,12 +25,20 @@ import (
 )
 
 var (
-	builders   map[string]builder.Service
-	buildersMu sync.Mutex
+	builders         map[string]builder.Service
+	buildersLastUsed map[string]time.Time
+	buildersMu       sync.Mutex
 )
 
 // FetchBuilderClient fetches a builder client, instantiating it if required.
-func FetchBuilderClient(ctx context.Context, address string, monitor metrics.Service) (builder.Service, error) {
+// Clients are reused across calls for the same address, so that their connections
+// can be reused.  Clients that have not been fetched for longer than the idle
+// timeout, if set, are evicted.
+func FetchBuilderClient(ctx context.Context,
+	address string,
+	monitor metrics.Service,
+	idleTimeout time.Duration,
+) (builder.Service, error) {
 	if address == "" {
 		return nil, errors.New("no address supplied")
 	}
@@ -38,8 +47,12 @@ func FetchBuilderClient(ctx context.Context, address string, monitor metrics.Ser
 	defer buildersMu.Unlock()
 	if builders == nil {
 		builders = make(map[string]builder.Service)
+		buildersLastUsed = make(map[string]time.Time)
 	}
 
+	now := time.Now()
+	evictIdleBuilderClients(now, idleTimeout)
+
 	var client builder.Service
 	var exists bool
 	if client, exists = builders[address]; !exists {
@@ -54,5 +67,38 @@ func FetchBuilderClient(ctx context.Context, address string, monitor metrics.Ser
 		}
 		builders[address] = client
 	}
+	buildersLastUsed[address] = now
+
 	return client, nil
 }
+
+// EvictBuilderClient evicts the builder client for an address, so that a new client
+// is instantiated the next time it is fetched.  The address can either be that used
+// to fetch the client or that returned by the client.
+func EvictBuilderClient(address string) {
+	buildersMu.Lock()
+	defer buildersMu.Unlock()
+
+	for key, client := range builders {
+		if key == address || client.Address() == address {
+			delete(builders, key)
+			delete(buildersLastUsed, key)
+		}
+	}
+}
+
+// evictIdleBuilderClients evicts builder clients that have been idle for longer
+// than the idle timeout.
+// This assumes that buildersMu is held.
+func evictIdleBuilderClients(now time.Time, idleTimeout time.Duration) {
+	if idleTimeout <= 0 {
+		return
+	}
+
+	for address, lastUsed := range buildersLastUsed {
+		if now.Sub(lastUsed) > idleTimeout {
+			delete(builders, address)
+			delete(buildersLastUsed, address)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/vouch/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchBuilderClient(t *testing.T) {
+	ctx := context.Background()
+
+	viper.Set("builderclient.timeout", time.Second)
+
+	_, err := util.FetchBuilderClient(ctx, "", nil, 0)
+	require.EqualError(t, err, "no address supplied")
+
+	// Same address reuses the client.
+	client1, err := util.FetchBuilderClient(ctx, "http://relay1.example.com/", nil, 0)
+	require.NoError(t, err)
+	client2, err := util.FetchBuilderClient(ctx, "http://relay1.example.com/", nil, 0)
+	require.NoError(t, err)
+	require.Same(t, client1, client2)
+
+	// Different address has its own client.
+	client3, err := util.FetchBuilderClient(ctx, "http://relay2.example.com/", nil, 0)
+	require.NoError(t, err)
+	require.NotSame(t, client1, client3)
+
+	// Evicted client is replaced.
+	util.EvictBuilderClient("http://relay1.example.com/")
+	client4, err := util.FetchBuilderClient(ctx, "http://relay1.example.com/", nil, 0)
+	require.NoError(t, err)
+	require.NotSame(t, client1, client4)
+	client5, err := util.FetchBuilderClient(ctx, "http://relay2.example.com/", nil, 0)
+	require.NoError(t, err)
+	require.Same(t, client3, client5)
+}
+
+func TestFetchBuilderClientIdleTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	viper.Set("builderclient.timeout", time.Second)
+
+	client1, err := util.FetchBuilderClient(ctx, "http://relay3.example.com/", nil, 50*time.Millisecond)
+	require.NoError(t, err)
+	client2, err := util.FetchBuilderClient(ctx, "http://relay3.example.com/", nil, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.Same(t, client1, client2)
+
+	// Client idle for longer than the timeout is replaced.
+	time.Sleep(100 * time.Millisecond)
+	client3, err := util.FetchBuilderClient(ctx, "http://relay3.example.com/", nil, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.NotSame(t, client1, client3)
+}
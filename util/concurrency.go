@@ -16,7 +16,9 @@ package util
 import (
 	"fmt"
 	"strings"
+	"sync/atomic"
 
+	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
 )
 
@@ -37,3 +39,69 @@ func ProcessConcurrency(path string) int64 {
 	}
 	return ProcessConcurrency(path[0:lastPeriod])
 }
+
+// ValidatorProcessConcurrency returns the process concurrency for working on
+// the given number of validators, with one process for each validator bounded
+// by the maximum concurrency.
+func ValidatorProcessConcurrency(validators int, maxConcurrency int64) int64 {
+	concurrency := int64(validators)
+	if concurrency > maxConcurrency {
+		concurrency = maxConcurrency
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return concurrency
+}
+
+// DynamicProcessConcurrency provides the process concurrency for working on a
+// number of validators.  If a maximum process concurrency is configured the
+// concurrency is derived from the number of validators, otherwise the fixed
+// process concurrency is used.  If the signer is degraded the concurrency is
+// further limited by the signer degraded concurrency, if configured.
+type DynamicProcessConcurrency struct {
+	processConcurrency        int64
+	maxProcessConcurrency     int64
+	current                   atomic.Int64
+	signerLatency             *SignerLatency
+	signerDegradedConcurrency int64
+}
+
+// NewDynamicProcessConcurrency creates a new dynamic process concurrency.  A
+// maximum process concurrency of 0 uses the fixed process concurrency, and a
+// signer degraded concurrency of 0 leaves the concurrency unchanged when the
+// signer is degraded.  The signer latency is optional.
+func NewDynamicProcessConcurrency(processConcurrency int64,
+	maxProcessConcurrency int64,
+	signerLatency *SignerLatency,
+	signerDegradedConcurrency int64,
+) *DynamicProcessConcurrency {
+	return &DynamicProcessConcurrency{
+		processConcurrency:        processConcurrency,
+		maxProcessConcurrency:     maxProcessConcurrency,
+		signerLatency:             signerLatency,
+		signerDegradedConcurrency: signerDegradedConcurrency,
+	}
+}
+
+// For returns the process concurrency for working on the given number of validators.
+func (c *DynamicProcessConcurrency) For(log zerolog.Logger, validators int) int64 {
+	concurrency := c.processConcurrency
+	if c.maxProcessConcurrency != 0 {
+		concurrency = ValidatorProcessConcurrency(validators, c.maxProcessConcurrency)
+		if previous := c.current.Swap(concurrency); previous != concurrency {
+			log.Debug().Int("validators", validators).Int64("process_concurrency", concurrency).Msg("Adjusted process concurrency")
+		}
+	}
+
+	if c.signerDegradedConcurrency > 0 &&
+		concurrency > c.signerDegradedConcurrency &&
+		c.signerLatency != nil &&
+		c.signerLatency.Degraded() {
+		log.Trace().Int64("process_concurrency", c.signerDegradedConcurrency).Msg("Signer degraded; reduced process concurrency")
+		concurrency = c.signerDegradedConcurrency
+	}
+
+	return concurrency
+}
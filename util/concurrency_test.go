@@ -18,8 +18,10 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/attestantio/vouch/util"
+	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
@@ -88,3 +90,87 @@ func TestProcessConcurrency(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorProcessConcurrency(t *testing.T) {
+	tests := []struct {
+		name           string
+		validators     int
+		maxConcurrency int64
+		expected       int64
+	}{
+		{
+			name:           "NoValidators",
+			validators:     0,
+			maxConcurrency: 16,
+			expected:       1,
+		},
+		{
+			name:           "Single",
+			validators:     1,
+			maxConcurrency: 16,
+			expected:       1,
+		},
+		{
+			name:           "BelowMax",
+			validators:     10,
+			maxConcurrency: 16,
+			expected:       10,
+		},
+		{
+			name:           "AtMax",
+			validators:     16,
+			maxConcurrency: 16,
+			expected:       16,
+		},
+		{
+			name:           "AboveMax",
+			validators:     500,
+			maxConcurrency: 16,
+			expected:       16,
+		},
+		{
+			name:           "ZeroMax",
+			validators:     500,
+			maxConcurrency: 0,
+			expected:       1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, util.ValidatorProcessConcurrency(test.validators, test.maxConcurrency))
+		})
+	}
+}
+
+func TestDynamicProcessConcurrency(t *testing.T) {
+	log := zerolog.Nop()
+
+	// Fixed concurrency ignores the number of validators.
+	concurrency := util.NewDynamicProcessConcurrency(4, 0, nil, 0)
+	require.Equal(t, int64(4), concurrency.For(log, 1))
+	require.Equal(t, int64(4), concurrency.For(log, 100))
+
+	// Dynamic concurrency follows the number of validators, within bounds.
+	concurrency = util.NewDynamicProcessConcurrency(4, 8, nil, 0)
+	for _, step := range []struct {
+		validators int
+		expected   int64
+	}{
+		{validators: 0, expected: 1},
+		{validators: 3, expected: 3},
+		{validators: 8, expected: 8},
+		{validators: 200, expected: 8},
+		{validators: 5, expected: 5},
+	} {
+		require.Equal(t, step.expected, concurrency.For(log, step.validators))
+	}
+
+	// A degraded signer limits the concurrency.
+	signerLatency := util.NewSignerLatency(10 * time.Millisecond)
+	concurrency = util.NewDynamicProcessConcurrency(4, 8, signerLatency, 2)
+	require.Equal(t, int64(8), concurrency.For(log, 100))
+	signerLatency.Record(time.Second)
+	require.Equal(t, int64(2), concurrency.For(log, 100))
+	require.Equal(t, int64(1), concurrency.For(log, 1))
+}
@@ -0,0 +1,90 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// signerLatencyWeight is the weight given to each new latency in the average.
+const signerLatencyWeight = 0.25
+
+// SignerLatency tracks the average latency of signing operations, to detect
+// a signer that is degraded before it results in missed duties.
+type SignerLatency struct {
+	threshold time.Duration
+	mu        sync.Mutex
+	average   time.Duration
+	recorded  bool
+	degraded  bool
+}
+
+// NewSignerLatency creates a new signer latency tracker, which considers the
+// signer degraded when its average latency exceeds the given threshold.
+func NewSignerLatency(threshold time.Duration) *SignerLatency {
+	return &SignerLatency{
+		threshold: threshold,
+	}
+}
+
+// Record records the latency of a signing operation.  It returns the average
+// latency, if the signer is degraded, and if this changed whether the signer
+// is degraded.
+func (l *SignerLatency) Record(latency time.Duration) (time.Duration, bool, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.recorded {
+		l.average = time.Duration(signerLatencyWeight*float64(latency) + (1-signerLatencyWeight)*float64(l.average))
+	} else {
+		l.average = latency
+		l.recorded = true
+	}
+	degraded := l.average > l.threshold
+	changed := degraded != l.degraded
+	l.degraded = degraded
+
+	return l.average, degraded, changed
+}
+
+// Degraded returns true if the signer is degraded.
+func (l *SignerLatency) Degraded() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.degraded
+}
+
+// Observe records the latency of a signing operation for the given duty, and
+// logs and reports if the signer has become degraded or has recovered.  It
+// does nothing if the tracker is nil, as is the case when tracking is disabled.
+func (l *SignerLatency) Observe(log zerolog.Logger, duty string, latency time.Duration, report func(degraded bool)) {
+	if l == nil {
+		return
+	}
+
+	average, degraded, changed := l.Record(latency)
+	if !changed {
+		return
+	}
+	if degraded {
+		log.Warn().Str("duty", duty).Dur("average_latency", average).Msg("Signer latency above threshold; signer degraded")
+	} else {
+		log.Info().Str("duty", duty).Dur("average_latency", average).Msg("Signer latency below threshold; signer recovered")
+	}
+	report(degraded)
+}
@@ -0,0 +1,97 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/attestantio/vouch/testing/logger"
+	"github.com/attestantio/vouch/util"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignerLatency(t *testing.T) {
+	latency := util.NewSignerLatency(100 * time.Millisecond)
+	require.False(t, latency.Degraded())
+
+	average, degraded, changed := latency.Record(50 * time.Millisecond)
+	require.Equal(t, 50*time.Millisecond, average)
+	require.False(t, degraded)
+	require.False(t, changed)
+
+	// A single slow operation is smoothed by the average.
+	average, degraded, changed = latency.Record(150 * time.Millisecond)
+	require.Equal(t, 75*time.Millisecond, average)
+	require.False(t, degraded)
+	require.False(t, changed)
+
+	// Continued slow operations mark the signer as degraded, once.
+	_, degraded, changed = latency.Record(500 * time.Millisecond)
+	require.True(t, degraded)
+	require.True(t, changed)
+	require.True(t, latency.Degraded())
+	_, degraded, changed = latency.Record(500 * time.Millisecond)
+	require.True(t, degraded)
+	require.False(t, changed)
+
+	// The signer recovers once the average falls below the threshold.
+	for i := 0; i < 20; i++ {
+		_, degraded, changed = latency.Record(10 * time.Millisecond)
+		if changed {
+			break
+		}
+	}
+	require.False(t, degraded)
+	require.True(t, changed)
+	require.False(t, latency.Degraded())
+}
+
+func TestSignerLatencyObserve(t *testing.T) {
+	log := zerolog.Nop()
+	capture := logger.NewModuleLogCapture(t, &log)
+
+	reports := make([]bool, 0)
+	report := func(degraded bool) {
+		reports = append(reports, degraded)
+	}
+
+	// A nil tracker does nothing.
+	var disabled *util.SignerLatency
+	disabled.Observe(log, "test duties", time.Second, report)
+	require.Empty(t, reports)
+
+	latency := util.NewSignerLatency(100 * time.Millisecond)
+	latency.Observe(log, "test duties", 50*time.Millisecond, report)
+	require.Empty(t, reports)
+
+	// Changes in degradation are logged and reported, once.
+	latency.Observe(log, "test duties", time.Second, report)
+	latency.Observe(log, "test duties", time.Second, report)
+	require.Equal(t, []bool{true}, reports)
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"message": "Signer latency above threshold; signer degraded",
+		"duty":    "test duties",
+	}))
+
+	for i := 0; i < 20; i++ {
+		latency.Observe(log, "test duties", 0, report)
+	}
+	require.Equal(t, []bool{true, false}, reports)
+	require.True(t, capture.HasLog(map[string]interface{}{
+		"message": "Signer latency below threshold; signer recovered",
+		"duty":    "test duties",
+	}))
+}